@@ -0,0 +1,120 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const maxHostnameLength = 253
+
+var (
+	schemePattern        = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+\-.]*$`)
+	hostnameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9\-_]{0,61}[a-zA-Z0-9_])?$`)
+)
+
+// Address is a parsed device address of the form [scheme://]host:port
+type Address struct {
+	// Scheme is the optional scheme of the address, e.g. grpc
+	Scheme string
+
+	// Host is the IP address or DNS name of the device. IPv6 addresses are not bracketed.
+	Host string
+
+	// Port is the port of the device
+	Port uint16
+}
+
+// ParseAddress parses a device address of the form [scheme://]host:port. The host may be an IPv4
+// address, a bracketed IPv6 address with an optional zone, or a DNS name. The port must be in the
+// range 1-65535.
+func ParseAddress(address string) (*Address, error) {
+	hostPort := address
+	var scheme string
+	if i := strings.Index(address, "://"); i >= 0 {
+		scheme, hostPort = address[:i], address[i+3:]
+		if !schemePattern.MatchString(scheme) {
+			return nil, fmt.Errorf("address '%s' has an invalid scheme", address)
+		}
+	}
+
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, fmt.Errorf("address '%s' is invalid: %v", address, err)
+	}
+	if err := validateHost(host); err != nil {
+		return nil, fmt.Errorf("address '%s' is invalid: %v", address, err)
+	}
+
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNum == 0 {
+		return nil, fmt.Errorf("address '%s' has an invalid port '%s'", address, port)
+	}
+	return &Address{
+		Scheme: scheme,
+		Host:   host,
+		Port:   uint16(portNum),
+	}, nil
+}
+
+// validateHost validates the given host as an IP address or DNS name
+func validateHost(host string) error {
+	if host == "" {
+		return fmt.Errorf("host is required")
+	}
+
+	// IPv6 addresses may include a zone, e.g. fe80::1%eth0
+	ip := host
+	if i := strings.LastIndex(host, "%"); i >= 0 && strings.Contains(host, ":") {
+		if i == len(host)-1 {
+			return fmt.Errorf("host '%s' has an empty zone", host)
+		}
+		ip = host[:i]
+	}
+	if net.ParseIP(ip) != nil {
+		return nil
+	}
+	if strings.Contains(host, ":") {
+		return fmt.Errorf("host '%s' is not a valid IPv6 address", host)
+	}
+
+	name := strings.TrimSuffix(host, ".")
+	if len(name) > maxHostnameLength {
+		return fmt.Errorf("host '%s' is too long", host)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !hostnameLabelPattern.MatchString(label) {
+			return fmt.Errorf("host '%s' is not a valid DNS name", host)
+		}
+	}
+	return nil
+}
+
+// HostPort returns the host:port of the address, bracketing IPv6 hosts
+func (a *Address) HostPort() string {
+	return net.JoinHostPort(a.Host, strconv.Itoa(int(a.Port)))
+}
+
+// String returns the address in the form [scheme://]host:port
+func (a *Address) String() string {
+	if a.Scheme != "" {
+		return a.Scheme + "://" + a.HostPort()
+	}
+	return a.HostPort()
+}
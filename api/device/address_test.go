@@ -0,0 +1,81 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	valid := map[string]Address{
+		"device-1:10161":                        {Host: "device-1", Port: 10161},
+		"leaf_1.fabric.local.:9339":             {Host: "leaf_1.fabric.local.", Port: 9339},
+		"10.11.12.13:1":                         {Host: "10.11.12.13", Port: 1},
+		"[2001:db8::1]:9339":                    {Host: "2001:db8::1", Port: 9339},
+		"[fe80::1%eth0]:65535":                  {Host: "fe80::1%eth0", Port: 65535},
+		"grpc://[2001:db8::1]:9559":             {Scheme: "grpc", Host: "2001:db8::1", Port: 9559},
+		"dns+srv://stratum-1:9559":              {Scheme: "dns+srv", Host: "stratum-1", Port: 9559},
+		"stratum-1.onos.svc.cluster.local:9559": {Host: "stratum-1.onos.svc.cluster.local", Port: 9559},
+	}
+	for s, expected := range valid {
+		address, err := ParseAddress(s)
+		assert.NoError(t, err, s)
+		if assert.NotNil(t, address, s) {
+			assert.Equal(t, expected, *address, s)
+			assert.Equal(t, s, address.String())
+		}
+	}
+
+	invalid := []string{
+		"",
+		"device-1",
+		"device-1:",
+		":1234",
+		"device-1:0",
+		"device-1:99999",
+		"device-1:-1",
+		"device-1:http",
+		"2001:db8::1:9339",
+		"[2001:db8::zz]:9339",
+		"[fe80::1%]:9339",
+		"device 1:1234",
+		"-device:1234",
+		"device..local:1234",
+		"1grpc://device-1:1234",
+		"grpc://device-1",
+		longLabelHostname() + ":1234",
+	}
+	for _, s := range invalid {
+		_, err := ParseAddress(s)
+		assert.Error(t, err, s)
+	}
+}
+
+// longLabelHostname returns a DNS name with a label longer than 63 characters
+func longLabelHostname() string {
+	label := make([]byte, 64)
+	for i := range label {
+		label[i] = 'a'
+	}
+	return string(label) + ".local"
+}
+
+func TestAddressHostPort(t *testing.T) {
+	address := &Address{Scheme: "grpc", Host: "2001:db8::1", Port: 9339}
+	assert.Equal(t, "[2001:db8::1]:9339", address.HostPort())
+	assert.Equal(t, "grpc://[2001:db8::1]:9339", address.String())
+}
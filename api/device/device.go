@@ -28,6 +28,9 @@ type Role string
 // Revision is the device revision number
 type Revision uint64
 
+// SubscribedHeader is the header metadata key sent by List once a subscription has been established
+const SubscribedHeader = "onos-topo-subscribed"
+
 // DeviceServiceClientFactory : Default DeviceServiceClient creation.
 var DeviceServiceClientFactory = func(cc *grpc.ClientConn) DeviceServiceClient {
 	return NewDeviceServiceClient(cc)
@@ -37,3 +40,28 @@ var DeviceServiceClientFactory = func(cc *grpc.ClientConn) DeviceServiceClient {
 func CreateDeviceServiceClient(cc *grpc.ClientConn) DeviceServiceClient {
 	return DeviceServiceClientFactory(cc)
 }
+
+// DeviceTypeServiceClientFactory : Default DeviceTypeServiceClient creation.
+var DeviceTypeServiceClientFactory = func(cc *grpc.ClientConn) DeviceTypeServiceClient {
+	return NewDeviceTypeServiceClient(cc)
+}
+
+// CreateDeviceTypeServiceClient creates and returns a new topo device type client
+func CreateDeviceTypeServiceClient(cc *grpc.ClientConn) DeviceTypeServiceClient {
+	return DeviceTypeServiceClientFactory(cc)
+}
+
+// GetConnectivityState returns a summary of the connectivity state of the device.
+// A device is reachable if any of its protocols is reachable.
+func (m *Device) GetConnectivityState() ConnectivityState {
+	state := ConnectivityState_UNKNOWN_CONNECTIVITY_STATE
+	for _, protocol := range m.Protocols {
+		switch protocol.ConnectivityState {
+		case ConnectivityState_REACHABLE:
+			return ConnectivityState_REACHABLE
+		case ConnectivityState_UNREACHABLE:
+			state = ConnectivityState_UNREACHABLE
+		}
+	}
+	return state
+}
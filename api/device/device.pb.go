@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -69,7 +70,7 @@ func (Protocol) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_95f133998963e93b, []int{0}
 }
 
-//ConnectivityState represents the L3 reachability of a device from the service container (e.g. enos-config), independently of gRPC or the service itself (e.g. gNMI)
+// ConnectivityState represents the L3 reachability of a device from the service container (e.g. enos-config), independently of gRPC or the service itself (e.g. gNMI)
 type ConnectivityState int32
 
 const (
@@ -101,7 +102,7 @@ func (ConnectivityState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_95f133998963e93b, []int{1}
 }
 
-//ConnectivityState represents the state of a gRPC channel to the device from the service container
+// ConnectivityState represents the state of a gRPC channel to the device from the service container
 type ChannelState int32
 
 const (
@@ -133,7 +134,7 @@ func (ChannelState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_95f133998963e93b, []int{2}
 }
 
-//ServiceState represents the state of the gRPC service (e.g. gNMI) to the device from the service container
+// ServiceState represents the state of the gRPC service (e.g. gNMI) to the device from the service container
 type ServiceState int32
 
 const (
@@ -169,6 +170,82 @@ func (ServiceState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_95f133998963e93b, []int{3}
 }
 
+// AttributeType is the type of the value of a device attribute
+type AttributeType int32
+
+const (
+	// STRING indicates the attribute may have any value
+	AttributeType_STRING AttributeType = 0
+	// INTEGER indicates the attribute value must be a base 10 integer
+	AttributeType_INTEGER AttributeType = 1
+	// BOOLEAN indicates the attribute value must be true or false
+	AttributeType_BOOLEAN AttributeType = 2
+	// FLOAT indicates the attribute value must be a floating point number
+	AttributeType_FLOAT AttributeType = 3
+)
+
+var AttributeType_name = map[int32]string{
+	0: "STRING",
+	1: "INTEGER",
+	2: "BOOLEAN",
+	3: "FLOAT",
+}
+
+var AttributeType_value = map[string]int32{
+	"STRING":  0,
+	"INTEGER": 1,
+	"BOOLEAN": 2,
+	"FLOAT":   3,
+}
+
+func (x AttributeType) String() string {
+	return proto.EnumName(AttributeType_name, int32(x))
+}
+
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{4}
+}
+
+// LifecycleState is the administrative lifecycle state of a device
+type LifecycleState int32
+
+const (
+	// IN_SERVICE indicates the device is in service; devices added without a lifecycle state are in service
+	LifecycleState_IN_SERVICE LifecycleState = 0
+	// PLANNED indicates the device is planned but not yet deployed
+	LifecycleState_PLANNED LifecycleState = 1
+	// PROVISIONING indicates the device is deployed and being provisioned
+	LifecycleState_PROVISIONING LifecycleState = 2
+	// MAINTENANCE indicates the device is temporarily out of service for maintenance
+	LifecycleState_MAINTENANCE LifecycleState = 3
+	// DECOMMISSIONED indicates the device is permanently out of service
+	LifecycleState_DECOMMISSIONED LifecycleState = 4
+)
+
+var LifecycleState_name = map[int32]string{
+	0: "IN_SERVICE",
+	1: "PLANNED",
+	2: "PROVISIONING",
+	3: "MAINTENANCE",
+	4: "DECOMMISSIONED",
+}
+
+var LifecycleState_value = map[string]int32{
+	"IN_SERVICE":     0,
+	"PLANNED":        1,
+	"PROVISIONING":   2,
+	"MAINTENANCE":    3,
+	"DECOMMISSIONED": 4,
+}
+
+func (x LifecycleState) String() string {
+	return proto.EnumName(LifecycleState_name, int32(x))
+}
+
+func (LifecycleState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{5}
+}
+
 // Device event type
 type ListResponse_Type int32
 
@@ -490,6 +567,11 @@ type ListRequest struct {
 	// subscribe indicates whether to subscribe to events (e.g. ADD, UPDATE, and REMOVE) that occur
 	// after all devices have been streamed to the client
 	Subscribe bool `protobuf:"varint,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	// versions is a version range to which the versions of listed devices must belong, e.g. "<2.0.0"
+	// or ">=1.0.0 <1.2.0"; devices of any version are listed if empty
+	Versions string `protobuf:"bytes,2,opt,name=versions,proto3" json:"versions,omitempty"`
+	// lifecycleStates are the lifecycle states of listed devices; devices in any state are listed if empty
+	LifecycleStates []LifecycleState `protobuf:"varint,3,rep,packed,name=lifecycleStates,proto3,enum=topo.device.LifecycleState" json:"lifecycleStates,omitempty"`
 }
 
 func (m *ListRequest) Reset()         { *m = ListRequest{} }
@@ -532,6 +614,20 @@ func (m *ListRequest) GetSubscribe() bool {
 	return false
 }
 
+func (m *ListRequest) GetVersions() string {
+	if m != nil {
+		return m.Versions
+	}
+	return ""
+}
+
+func (m *ListRequest) GetLifecycleStates() []LifecycleState {
+	if m != nil {
+		return m.LifecycleStates
+	}
+	return nil
+}
+
 // ListResponse carries a single device event
 type ListResponse struct {
 	// type is the type of the event
@@ -672,11 +768,11 @@ var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
 
 // Device contains information about a device
 type Device struct {
-	// id is a globally unique device identifier
+	// id is a device identifier, unique within the namespace of the device
 	ID ID `protobuf:"bytes,1,opt,name=id,proto3,casttype=ID" json:"id,omitempty"`
 	// revision is the revision of the device
 	Revision Revision `protobuf:"varint,2,opt,name=revision,proto3,casttype=Revision" json:"revision,omitempty"`
-	// address is the host:port of the device
+	// address is the [scheme://]host:port of the device; IPv6 hosts must be bracketed
 	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	// target is the device target
 	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
@@ -695,6 +791,13 @@ type Device struct {
 	// attributes is an arbitrary mapping of attribute keys/values
 	Attributes map[string]string `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Protocols  []*ProtocolState  `protobuf:"bytes,12,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	// endpoints are the per-protocol endpoints of the device. Settings that are not specified by an
+	// endpoint default to the address, timeout, credentials and tls of the device.
+	Endpoints []*Endpoint `protobuf:"bytes,13,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// lifecycleState is the administrative lifecycle state of the device
+	LifecycleState LifecycleState `protobuf:"varint,14,opt,name=lifecycleState,proto3,enum=topo.device.LifecycleState" json:"lifecycleState,omitempty"`
+	// namespace is the namespace of the device, set by the service from the namespace of the request
+	Namespace string `protobuf:"bytes,15,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *Device) Reset()         { *m = Device{} }
@@ -814,6 +917,173 @@ func (m *Device) GetProtocols() []*ProtocolState {
 	return nil
 }
 
+func (m *Device) GetEndpoints() []*Endpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *Device) GetLifecycleState() LifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return LifecycleState_IN_SERVICE
+}
+
+func (m *Device) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// Endpoint is the endpoint and connection settings of a protocol on a device
+type Endpoint struct {
+	// protocol is the protocol served by the endpoint
+	Protocol Protocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=topo.device.Protocol" json:"protocol,omitempty"`
+	// address is the [scheme://]host:port of the endpoint; defaults to the device address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// timeout is the request timeout of the endpoint; defaults to the device timeout
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// credentials are the credentials for connecting to the endpoint; default to the device credentials
+	Credentials *Credentials `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// tls is the endpoint TLS configuration; defaults to the device TLS configuration
+	TLS *TlsConfig `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// p4runtime is the P4Runtime configuration of P4RUNTIME endpoints
+	P4Runtime *P4RuntimeConfig `protobuf:"bytes,6,opt,name=p4runtime,proto3" json:"p4runtime,omitempty"`
+}
+
+func (m *Endpoint) Reset()         { *m = Endpoint{} }
+func (m *Endpoint) String() string { return proto.CompactTextString(m) }
+func (*Endpoint) ProtoMessage()    {}
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{11}
+}
+func (m *Endpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Endpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Endpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Endpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Endpoint.Merge(m, src)
+}
+func (m *Endpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Endpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Endpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Endpoint proto.InternalMessageInfo
+
+func (m *Endpoint) GetProtocol() Protocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return Protocol_UNKNOWN_PROTOCOL
+}
+
+func (m *Endpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Endpoint) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *Endpoint) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *Endpoint) GetTLS() *TlsConfig {
+	if m != nil {
+		return m.TLS
+	}
+	return nil
+}
+
+func (m *Endpoint) GetP4Runtime() *P4RuntimeConfig {
+	if m != nil {
+		return m.P4Runtime
+	}
+	return nil
+}
+
+// P4RuntimeConfig is the configuration of a P4Runtime endpoint
+type P4RuntimeConfig struct {
+	// deviceId is the P4Runtime device ID of the device
+	DeviceID uint64 `protobuf:"varint,1,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	// electionId is the election ID with which to arbitrate for mastership of the device
+	ElectionID uint64 `protobuf:"varint,2,opt,name=electionId,proto3" json:"electionId,omitempty"`
+}
+
+func (m *P4RuntimeConfig) Reset()         { *m = P4RuntimeConfig{} }
+func (m *P4RuntimeConfig) String() string { return proto.CompactTextString(m) }
+func (*P4RuntimeConfig) ProtoMessage()    {}
+func (*P4RuntimeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{12}
+}
+func (m *P4RuntimeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *P4RuntimeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_P4RuntimeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *P4RuntimeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P4RuntimeConfig.Merge(m, src)
+}
+func (m *P4RuntimeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *P4RuntimeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_P4RuntimeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P4RuntimeConfig proto.InternalMessageInfo
+
+func (m *P4RuntimeConfig) GetDeviceID() uint64 {
+	if m != nil {
+		return m.DeviceID
+	}
+	return 0
+}
+
+func (m *P4RuntimeConfig) GetElectionID() uint64 {
+	if m != nil {
+		return m.ElectionID
+	}
+	return 0
+}
+
 // Credentials is the device credentials
 type Credentials struct {
 	// user is the user with which to connect to the device
@@ -826,7 +1096,7 @@ func (m *Credentials) Reset()         { *m = Credentials{} }
 func (m *Credentials) String() string { return proto.CompactTextString(m) }
 func (*Credentials) ProtoMessage()    {}
 func (*Credentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f133998963e93b, []int{11}
+	return fileDescriptor_95f133998963e93b, []int{13}
 }
 func (m *Credentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +1157,7 @@ func (m *TlsConfig) Reset()         { *m = TlsConfig{} }
 func (m *TlsConfig) String() string { return proto.CompactTextString(m) }
 func (*TlsConfig) ProtoMessage()    {}
 func (*TlsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f133998963e93b, []int{12}
+	return fileDescriptor_95f133998963e93b, []int{14}
 }
 func (m *TlsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,13 +1231,17 @@ type ProtocolState struct {
 	ChannelState ChannelState `protobuf:"varint,3,opt,name=channelState,proto3,enum=topo.device.ChannelState" json:"channelState,omitempty"`
 	//ServiceState indicates the availability of the gRPC servic on top of the channel
 	ServiceState ServiceState `protobuf:"varint,4,opt,name=serviceState,proto3,enum=topo.device.ServiceState" json:"serviceState,omitempty"`
+	// lastStateChange is the time at which the channel or service state last changed
+	LastStateChange *time.Time `protobuf:"bytes,5,opt,name=lastStateChange,proto3,stdtime" json:"lastStateChange,omitempty"`
+	// lastError is the error returned by the last failed channel or service probe
+	LastError string `protobuf:"bytes,6,opt,name=lastError,proto3" json:"lastError,omitempty"`
 }
 
 func (m *ProtocolState) Reset()         { *m = ProtocolState{} }
 func (m *ProtocolState) String() string { return proto.CompactTextString(m) }
 func (*ProtocolState) ProtoMessage()    {}
 func (*ProtocolState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f133998963e93b, []int{13}
+	return fileDescriptor_95f133998963e93b, []int{15}
 }
 func (m *ProtocolState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,1246 +1298,4898 @@ func (m *ProtocolState) GetServiceState() ServiceState {
 	return ServiceState_UNKNOWN_SERVICE_STATE
 }
 
-func init() {
-	proto.RegisterEnum("topo.device.Protocol", Protocol_name, Protocol_value)
-	proto.RegisterEnum("topo.device.ConnectivityState", ConnectivityState_name, ConnectivityState_value)
-	proto.RegisterEnum("topo.device.ChannelState", ChannelState_name, ChannelState_value)
-	proto.RegisterEnum("topo.device.ServiceState", ServiceState_name, ServiceState_value)
-	proto.RegisterEnum("topo.device.ListResponse_Type", ListResponse_Type_name, ListResponse_Type_value)
-	proto.RegisterType((*AddRequest)(nil), "topo.device.AddRequest")
-	proto.RegisterType((*AddResponse)(nil), "topo.device.AddResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "topo.device.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "topo.device.UpdateResponse")
-	proto.RegisterType((*GetRequest)(nil), "topo.device.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "topo.device.GetResponse")
-	proto.RegisterType((*ListRequest)(nil), "topo.device.ListRequest")
-	proto.RegisterType((*ListResponse)(nil), "topo.device.ListResponse")
-	proto.RegisterType((*RemoveRequest)(nil), "topo.device.RemoveRequest")
-	proto.RegisterType((*RemoveResponse)(nil), "topo.device.RemoveResponse")
-	proto.RegisterType((*Device)(nil), "topo.device.Device")
-	proto.RegisterMapType((map[string]string)(nil), "topo.device.Device.AttributesEntry")
-	proto.RegisterType((*Credentials)(nil), "topo.device.Credentials")
-	proto.RegisterType((*TlsConfig)(nil), "topo.device.TlsConfig")
-	proto.RegisterType((*ProtocolState)(nil), "topo.device.ProtocolState")
+func (m *ProtocolState) GetLastStateChange() *time.Time {
+	if m != nil {
+		return m.LastStateChange
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("api/device/device.proto", fileDescriptor_95f133998963e93b) }
+func (m *ProtocolState) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
 
-var fileDescriptor_95f133998963e93b = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x1d, 0x37, 0x75, 0x9e, 0xd3, 0xae, 0x77, 0xd8, 0x3f, 0x6e, 0xa8, 0x92, 0x55, 0xb8,
-	0x54, 0x5d, 0x29, 0x85, 0xc0, 0x4a, 0xbb, 0x15, 0x15, 0x38, 0x8e, 0xd5, 0x0d, 0xa4, 0x4e, 0x35,
-	0x49, 0x8b, 0xf6, 0xb4, 0x72, 0xe3, 0xd9, 0x60, 0x91, 0xb5, 0x83, 0x3d, 0x09, 0xaa, 0xf8, 0x0e,
-	0x88, 0x23, 0x9f, 0x80, 0xcf, 0xb2, 0xe2, 0xb4, 0xe2, 0xc4, 0xa9, 0xa0, 0xf6, 0x5b, 0xf4, 0x84,
-	0x66, 0xc6, 0x4e, 0xec, 0xb4, 0x80, 0xe8, 0xa5, 0xf1, 0x7b, 0xef, 0xf7, 0x7b, 0xff, 0xe6, 0xcd,
-	0xbc, 0xc2, 0x63, 0x77, 0xea, 0xef, 0x79, 0x64, 0xee, 0x8f, 0x48, 0xf2, 0xd3, 0x9c, 0x46, 0x21,
-	0x0d, 0x91, 0x46, 0xc3, 0x69, 0xd8, 0x14, 0xaa, 0x6a, 0x6d, 0x1c, 0x86, 0xe3, 0x09, 0xd9, 0xe3,
-	0xa6, 0xb3, 0xd9, 0x9b, 0x3d, 0x6f, 0x16, 0xb9, 0xd4, 0x0f, 0x03, 0x01, 0xae, 0x3e, 0x18, 0x87,
-	0xe3, 0x90, 0x7f, 0xee, 0xb1, 0x2f, 0xa1, 0x6d, 0xbc, 0x00, 0x30, 0x3d, 0x0f, 0x93, 0xef, 0x67,
-	0x24, 0xa6, 0xe8, 0x29, 0x94, 0x84, 0x37, 0x43, 0x7a, 0x22, 0xed, 0x68, 0xad, 0x0f, 0x9a, 0x99,
-	0x08, 0xcd, 0x0e, 0xff, 0xc1, 0x09, 0xa4, 0xb1, 0x0f, 0x1a, 0xa7, 0xc6, 0xd3, 0x30, 0x88, 0xc9,
-	0xff, 0xe3, 0x7e, 0x0e, 0x1b, 0x27, 0x53, 0xcf, 0xa5, 0xe4, 0x4e, 0x91, 0x0f, 0x60, 0x33, 0x65,
-	0xdf, 0x25, 0xf8, 0x2e, 0xc0, 0x21, 0xa1, 0x69, 0xe4, 0x6d, 0x90, 0x7d, 0x8f, 0xd3, 0xca, 0xed,
-	0xca, 0xe5, 0x45, 0x5d, 0xee, 0x76, 0xae, 0xf9, 0x5f, 0x2c, 0xfb, 0x1e, 0x2b, 0x92, 0x63, 0xef,
-	0x12, 0xe7, 0x29, 0x68, 0x3d, 0x3f, 0xce, 0x04, 0x2a, 0xc7, 0xb3, 0xb3, 0x78, 0x14, 0xf9, 0x67,
-	0x82, 0xae, 0xe2, 0xa5, 0xa2, 0xf1, 0xab, 0x04, 0x15, 0x81, 0x4e, 0x42, 0xb5, 0x40, 0xa1, 0xe7,
-	0x53, 0x81, 0xdc, 0x6c, 0xd5, 0x72, 0x81, 0xb2, 0xc0, 0xe6, 0xf0, 0x7c, 0x4a, 0x30, 0xc7, 0x66,
-	0xd2, 0x93, 0xff, 0x3b, 0xbd, 0x67, 0xa0, 0x30, 0x2a, 0x52, 0x41, 0x71, 0xfa, 0x8e, 0xad, 0x17,
-	0x50, 0x19, 0xd6, 0xcc, 0x4e, 0xc7, 0xee, 0xe8, 0x12, 0xd2, 0x60, 0xfd, 0xe4, 0xb8, 0x63, 0x0e,
-	0xed, 0x8e, 0x2e, 0x33, 0x01, 0xdb, 0x47, 0xfd, 0x53, 0xbb, 0xa3, 0x17, 0xd9, 0xd1, 0x61, 0xf2,
-	0x36, 0x9c, 0xdf, 0xed, 0xe8, 0x74, 0xd8, 0x4c, 0xd9, 0x22, 0xfd, 0xc6, 0x6f, 0x0a, 0x94, 0x04,
-	0xe8, 0xdf, 0x8f, 0x02, 0xed, 0x80, 0x1a, 0x91, 0xb9, 0x1f, 0xfb, 0x61, 0xc0, 0xcb, 0x53, 0xda,
-	0x95, 0xeb, 0x8b, 0xba, 0x8a, 0x13, 0x1d, 0x5e, 0x58, 0x91, 0x01, 0xeb, 0xae, 0xe7, 0x45, 0x24,
-	0x8e, 0x8d, 0x22, 0x73, 0x86, 0x53, 0x11, 0x3d, 0x82, 0x12, 0x75, 0xa3, 0x31, 0xa1, 0x86, 0xc2,
-	0x0d, 0x89, 0xc4, 0x18, 0x73, 0x12, 0x71, 0xd7, 0x6b, 0x82, 0x91, 0x88, 0xe8, 0x05, 0xac, 0x53,
-	0xff, 0x2d, 0x09, 0x67, 0xd4, 0x28, 0xf1, 0xf2, 0xb6, 0x9a, 0xe2, 0xa2, 0x35, 0xd3, 0x8b, 0xd6,
-	0xec, 0x24, 0x17, 0xad, 0xad, 0xfc, 0xf2, 0x67, 0x5d, 0xc2, 0x29, 0x1e, 0x7d, 0x09, 0xda, 0x28,
-	0x22, 0x1e, 0x09, 0xa8, 0xef, 0x4e, 0x62, 0x63, 0x9d, 0xd3, 0x8d, 0x5c, 0x77, 0xac, 0xa5, 0xbd,
-	0xad, 0xbc, 0xbb, 0xa8, 0x17, 0x70, 0x96, 0x82, 0x9e, 0x41, 0x91, 0x4e, 0x62, 0x43, 0xe5, 0xcc,
-	0x47, 0x39, 0xe6, 0x70, 0x12, 0x5b, 0x61, 0xf0, 0xc6, 0x1f, 0xb7, 0x35, 0xc6, 0xbb, 0xbc, 0xa8,
-	0x17, 0x87, 0xbd, 0x01, 0x66, 0x78, 0xb4, 0x9d, 0x8c, 0x4e, 0x99, 0x77, 0x52, 0xbd, 0xbe, 0xa8,
-	0x2b, 0x99, 0x21, 0xd9, 0x06, 0x25, 0x0a, 0x27, 0xc4, 0x80, 0xa5, 0x15, 0x87, 0x13, 0x82, 0xb9,
-	0x16, 0x59, 0x00, 0x2e, 0xa5, 0x91, 0x7f, 0x36, 0xa3, 0x24, 0x36, 0xb4, 0x27, 0xc5, 0x1d, 0xad,
-	0xf5, 0xd1, 0x2d, 0x27, 0xda, 0x34, 0x17, 0x28, 0x3b, 0xa0, 0xd1, 0x39, 0xce, 0xd0, 0xd0, 0x73,
-	0x28, 0xf3, 0xee, 0x8c, 0xc2, 0x49, 0x6c, 0x54, 0xb8, 0x8f, 0x6a, 0xce, 0xc7, 0x71, 0x62, 0x1d,
-	0x50, 0x76, 0x8b, 0x97, 0xe0, 0xea, 0x01, 0xdc, 0x5b, 0x71, 0x8c, 0x74, 0x28, 0x7e, 0x47, 0xce,
-	0xc5, 0x58, 0x60, 0xf6, 0x89, 0x1e, 0xc0, 0xda, 0xdc, 0x9d, 0xcc, 0xc4, 0x94, 0x97, 0xb1, 0x10,
-	0xf6, 0xe5, 0xe7, 0x52, 0xe3, 0x00, 0xb4, 0x4c, 0x4b, 0x11, 0x02, 0x65, 0x16, 0x93, 0x28, 0xe1,
-	0xf2, 0x6f, 0x54, 0x05, 0x75, 0xea, 0xc6, 0xf1, 0x0f, 0x61, 0xe4, 0x25, 0xfc, 0x85, 0xdc, 0xf8,
-	0x11, 0xca, 0x8b, 0xbe, 0xb2, 0x59, 0x19, 0xb9, 0x16, 0x89, 0x68, 0x32, 0x44, 0x89, 0xc4, 0x9c,
-	0x8e, 0x98, 0x56, 0x4c, 0x10, 0xff, 0x4e, 0x73, 0x5c, 0xcb, 0xe5, 0x38, 0x9d, 0xb8, 0x7e, 0xc0,
-	0xa7, 0x46, 0xc5, 0x42, 0x60, 0xc1, 0xfd, 0x20, 0x26, 0xa3, 0x59, 0x44, 0xf8, 0x3c, 0xa8, 0x78,
-	0x21, 0x37, 0x7e, 0x92, 0x61, 0x23, 0xd7, 0x17, 0xf4, 0x09, 0xa8, 0x69, 0x67, 0x92, 0x67, 0xe0,
-	0xe1, 0xad, 0x5d, 0xc4, 0x0b, 0x18, 0xea, 0xc1, 0xfd, 0x51, 0x18, 0x04, 0x64, 0x44, 0xfd, 0xb9,
-	0x4f, 0xcf, 0xb9, 0x1f, 0x5e, 0xe6, 0xea, 0x13, 0x62, 0xad, 0xa2, 0xf0, 0x4d, 0x22, 0x3a, 0x80,
-	0xca, 0xe8, 0x5b, 0x37, 0x08, 0x88, 0x48, 0x88, 0x37, 0x62, 0xb3, 0xb5, 0x95, 0x77, 0x94, 0x01,
-	0xe0, 0x1c, 0x9c, 0xd1, 0x63, 0x12, 0x31, 0x94, 0xa0, 0x2b, 0xb7, 0xd0, 0x07, 0x19, 0x00, 0xce,
-	0xc1, 0x77, 0x2d, 0x50, 0xd3, 0x0a, 0xd1, 0x03, 0xd0, 0x4f, 0x9c, 0xaf, 0x9d, 0xfe, 0x37, 0xce,
-	0xeb, 0x63, 0xdc, 0x1f, 0xf6, 0xad, 0x7e, 0x4f, 0x2f, 0xb0, 0xa7, 0xeb, 0xd0, 0x39, 0xea, 0xea,
-	0x12, 0xda, 0x80, 0xf2, 0xf1, 0x67, 0xf8, 0xc4, 0x19, 0x76, 0x8f, 0x6c, 0x5d, 0x16, 0x86, 0x7e,
-	0x57, 0x2f, 0xee, 0x0e, 0xe0, 0xfe, 0x8d, 0x52, 0x51, 0x0d, 0xaa, 0xa9, 0x37, 0xab, 0xef, 0x38,
-	0xb6, 0x35, 0xec, 0x9e, 0x76, 0x87, 0xaf, 0x5e, 0x0f, 0x86, 0xe6, 0x90, 0x3d, 0x84, 0x1b, 0x50,
-	0xc6, 0xb6, 0x69, 0xbd, 0x34, 0xdb, 0x3d, 0x5b, 0x97, 0xd0, 0x3d, 0xd0, 0x4e, 0x9c, 0xa5, 0x42,
-	0xde, 0xfd, 0x0a, 0x2a, 0xd9, 0xb2, 0xd1, 0x16, 0x3c, 0x5c, 0xf8, 0x7b, 0x69, 0x3a, 0x8e, 0xdd,
-	0xcb, 0xba, 0x4a, 0x42, 0xf0, 0x77, 0x55, 0x87, 0x4a, 0xa7, 0x3b, 0x58, 0x6a, 0xe4, 0xdd, 0x57,
-	0x50, 0xc9, 0xf6, 0x20, 0xeb, 0x6b, 0x60, 0xe3, 0xd3, 0xae, 0x65, 0x67, 0x7d, 0x99, 0xa7, 0x66,
-	0xb7, 0x97, 0x4d, 0x6b, 0xa9, 0x90, 0xd1, 0x26, 0x40, 0x5a, 0x8e, 0x73, 0xa8, 0x17, 0x5b, 0xbf,
-	0xcb, 0xb0, 0x21, 0x6e, 0x6b, 0x12, 0x01, 0xed, 0x43, 0xd1, 0xf4, 0x3c, 0xf4, 0x38, 0x77, 0x04,
-	0xcb, 0x7f, 0x00, 0xaa, 0xc6, 0x4d, 0x43, 0xf2, 0x4c, 0x17, 0x90, 0x05, 0x25, 0xb1, 0x75, 0x51,
-	0xfe, 0x2e, 0xe7, 0x16, 0x79, 0xf5, 0xc3, 0x5b, 0x6d, 0x0b, 0x27, 0xfb, 0x50, 0x3c, 0x24, 0x74,
-	0x25, 0x81, 0xe5, 0x36, 0x5e, 0x49, 0x20, 0xb3, 0x7a, 0x1b, 0x05, 0xf4, 0x05, 0x28, 0x6c, 0xf1,
-	0x21, 0xe3, 0x96, 0x5d, 0x28, 0xd8, 0x5b, 0xff, 0xb8, 0x25, 0x1b, 0x85, 0x8f, 0x25, 0x56, 0x81,
-	0x58, 0x3e, 0x2b, 0x15, 0xe4, 0xf6, 0xd9, 0x4a, 0x05, 0x2b, 0xdb, 0xaa, 0xd0, 0x36, 0xde, 0x5d,
-	0xd6, 0xa4, 0xf7, 0x97, 0x35, 0xe9, 0xaf, 0xcb, 0x9a, 0xf4, 0xf3, 0x55, 0xad, 0xf0, 0xfe, 0xaa,
-	0x56, 0xf8, 0xe3, 0xaa, 0x56, 0x38, 0x2b, 0xf1, 0x5b, 0xf8, 0xe9, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x69, 0x60, 0x53, 0x46, 0xb0, 0x09, 0x00, 0x00,
+// DeviceType describes a type of device, the schema to which devices of the type must conform, and the
+// defaults applied to devices of the type
+type DeviceType struct {
+	// name is the name of the type, matching the type of devices
+	Name Type `protobuf:"bytes,1,opt,name=name,proto3,casttype=Type" json:"name,omitempty"`
+	// revision is the revision of the device type
+	Revision Revision `protobuf:"varint,2,opt,name=revision,proto3,casttype=Revision" json:"revision,omitempty"`
+	// versions is the semantic version range to which device versions must belong, e.g. ">=1.0.0 <2.0.0"
+	// or "1.x"; devices may have any version if empty
+	Versions string `protobuf:"bytes,3,opt,name=versions,proto3" json:"versions,omitempty"`
+	// attributes is the schema of the device attributes
+	Attributes []*AttributeSchema `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// protocols are the protocols of devices that do not declare any protocol
+	Protocols []Protocol `protobuf:"varint,5,rep,packed,name=protocols,proto3,enum=topo.device.Protocol" json:"protocols,omitempty"`
+	// timeout is the request timeout of devices that do not specify a timeout
+	Timeout *time.Duration `protobuf:"bytes,6,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *DeviceType) Reset()         { *m = DeviceType{} }
+func (m *DeviceType) String() string { return proto.CompactTextString(m) }
+func (*DeviceType) ProtoMessage()    {}
+func (*DeviceType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{16}
+}
+func (m *DeviceType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceType.Merge(m, src)
+}
+func (m *DeviceType) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceType) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceType.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_DeviceType proto.InternalMessageInfo
 
-// DeviceServiceClient is the client API for DeviceService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DeviceServiceClient interface {
-	// Add adds a device to the topology
-	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
-	// Update updates a device
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Get gets a device by ID
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// List gets a stream of device add/update/remove events
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (DeviceService_ListClient, error)
-	// Remove removes a device from the topology
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+func (m *DeviceType) GetName() Type {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type deviceServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DeviceType) GetRevision() Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-func NewDeviceServiceClient(cc *grpc.ClientConn) DeviceServiceClient {
-	return &deviceServiceClient{cc}
+func (m *DeviceType) GetVersions() string {
+	if m != nil {
+		return m.Versions
+	}
+	return ""
 }
 
-func (c *deviceServiceClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
-	out := new(AddResponse)
-	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Add", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeviceType) GetAttributes() []*AttributeSchema {
+	if m != nil {
+		return m.Attributes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *deviceServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeviceType) GetProtocols() []Protocol {
+	if m != nil {
+		return m.Protocols
 	}
-	return out, nil
+	return nil
 }
 
-func (c *deviceServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeviceType) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	return out, nil
+	return nil
 }
 
-func (c *deviceServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (DeviceService_ListClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[0], "/topo.device.DeviceService/List", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &deviceServiceListClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+// AttributeSchema describes a device attribute
+type AttributeSchema struct {
+	// name is the attribute key
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the type of the attribute value
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=topo.device.AttributeType" json:"type,omitempty"`
+	// required indicates whether devices must have the attribute
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// pattern is a regular expression the whole attribute value must match, if any
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// description is a human readable description of the attribute
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *AttributeSchema) Reset()         { *m = AttributeSchema{} }
+func (m *AttributeSchema) String() string { return proto.CompactTextString(m) }
+func (*AttributeSchema) ProtoMessage()    {}
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{17}
+}
+func (m *AttributeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
+}
+func (m *AttributeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeSchema.Merge(m, src)
+}
+func (m *AttributeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeSchema.DiscardUnknown(m)
 }
 
-type DeviceService_ListClient interface {
-	Recv() (*ListResponse, error)
-	grpc.ClientStream
+var xxx_messageInfo_AttributeSchema proto.InternalMessageInfo
+
+func (m *AttributeSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type deviceServiceListClient struct {
-	grpc.ClientStream
+func (m *AttributeSchema) GetType() AttributeType {
+	if m != nil {
+		return m.Type
+	}
+	return AttributeType_STRING
 }
 
-func (x *deviceServiceListClient) Recv() (*ListResponse, error) {
-	m := new(ListResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *AttributeSchema) GetRequired() bool {
+	if m != nil {
+		return m.Required
 	}
-	return m, nil
+	return false
 }
 
-func (c *deviceServiceClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
-	out := new(RemoveResponse)
-	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AttributeSchema) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return out, nil
+	return ""
 }
 
-// DeviceServiceServer is the server API for DeviceService service.
-type DeviceServiceServer interface {
-	// Add adds a device to the topology
-	Add(context.Context, *AddRequest) (*AddResponse, error)
-	// Update updates a device
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Get gets a device by ID
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// List gets a stream of device add/update/remove events
-	List(*ListRequest, DeviceService_ListServer) error
-	// Remove removes a device from the topology
-	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+func (m *AttributeSchema) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-// UnimplementedDeviceServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDeviceServiceServer struct {
+// AddDeviceTypeRequest adds a device type to the catalog
+type AddDeviceTypeRequest struct {
+	// deviceType is the device type to add
+	DeviceType *DeviceType `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
 }
 
-func (*UnimplementedDeviceServiceServer) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+func (m *AddDeviceTypeRequest) Reset()         { *m = AddDeviceTypeRequest{} }
+func (m *AddDeviceTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceTypeRequest) ProtoMessage()    {}
+func (*AddDeviceTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{18}
 }
-func (*UnimplementedDeviceServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (m *AddDeviceTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDeviceServiceServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *AddDeviceTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddDeviceTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDeviceServiceServer) List(req *ListRequest, srv DeviceService_ListServer) error {
-	return status.Errorf(codes.Unimplemented, "method List not implemented")
+func (m *AddDeviceTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDeviceTypeRequest.Merge(m, src)
 }
-func (*UnimplementedDeviceServiceServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+func (m *AddDeviceTypeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterDeviceServiceServer(s *grpc.Server, srv DeviceServiceServer) {
-	s.RegisterService(&_DeviceService_serviceDesc, srv)
+func (m *AddDeviceTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDeviceTypeRequest.DiscardUnknown(m)
 }
 
-func _DeviceService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DeviceServiceServer).Add(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/topo.device.DeviceService/Add",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceServiceServer).Add(ctx, req.(*AddRequest))
+var xxx_messageInfo_AddDeviceTypeRequest proto.InternalMessageInfo
+
+func (m *AddDeviceTypeRequest) GetDeviceType() *DeviceType {
+	if m != nil {
+		return m.DeviceType
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DeviceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DeviceServiceServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/topo.device.DeviceService/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceServiceServer).Update(ctx, req.(*UpdateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// AddDeviceTypeResponse is sent in response to an AddDeviceTypeRequest
+type AddDeviceTypeResponse struct {
+	// deviceType is the device type with a revision number
+	DeviceType *DeviceType `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
 }
 
-func _DeviceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DeviceServiceServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/topo.device.DeviceService/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceServiceServer).Get(ctx, req.(*GetRequest))
+func (m *AddDeviceTypeResponse) Reset()         { *m = AddDeviceTypeResponse{} }
+func (m *AddDeviceTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceTypeResponse) ProtoMessage()    {}
+func (*AddDeviceTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{19}
+}
+func (m *AddDeviceTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddDeviceTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddDeviceTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AddDeviceTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDeviceTypeResponse.Merge(m, src)
+}
+func (m *AddDeviceTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddDeviceTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDeviceTypeResponse.DiscardUnknown(m)
 }
 
-func _DeviceService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_AddDeviceTypeResponse proto.InternalMessageInfo
+
+func (m *AddDeviceTypeResponse) GetDeviceType() *DeviceType {
+	if m != nil {
+		return m.DeviceType
 	}
-	return srv.(DeviceServiceServer).List(m, &deviceServiceListServer{stream})
+	return nil
 }
 
-type DeviceService_ListServer interface {
-	Send(*ListResponse) error
-	grpc.ServerStream
+// UpdateDeviceTypeRequest updates a device type
+type UpdateDeviceTypeRequest struct {
+	// deviceType is the updated device type
+	DeviceType *DeviceType `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
 }
 
-type deviceServiceListServer struct {
-	grpc.ServerStream
+func (m *UpdateDeviceTypeRequest) Reset()         { *m = UpdateDeviceTypeRequest{} }
+func (m *UpdateDeviceTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceTypeRequest) ProtoMessage()    {}
+func (*UpdateDeviceTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{20}
 }
-
-func (x *deviceServiceListServer) Send(m *ListResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *UpdateDeviceTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _DeviceService_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DeviceServiceServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/topo.device.DeviceService/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DeviceServiceServer).Remove(ctx, req.(*RemoveRequest))
+func (m *UpdateDeviceTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDeviceTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _DeviceService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "topo.device.DeviceService",
-	HandlerType: (*DeviceServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Add",
-			Handler:    _DeviceService_Add_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _DeviceService_Update_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _DeviceService_Get_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _DeviceService_Remove_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "List",
-			Handler:       _DeviceService_List_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "api/device/device.proto",
+func (m *UpdateDeviceTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceTypeRequest.Merge(m, src)
+}
+func (m *UpdateDeviceTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDeviceTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceTypeRequest.DiscardUnknown(m)
 }
 
-func (m *AddRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateDeviceTypeRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceTypeRequest) GetDeviceType() *DeviceType {
+	if m != nil {
+		return m.DeviceType
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AddRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateDeviceTypeResponse is sent in response to an UpdateDeviceTypeRequest
+type UpdateDeviceTypeResponse struct {
+	// deviceType is the device type with updated revision
+	DeviceType *DeviceType `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
 }
 
-func (m *AddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
+func (m *UpdateDeviceTypeResponse) Reset()         { *m = UpdateDeviceTypeResponse{} }
+func (m *UpdateDeviceTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceTypeResponse) ProtoMessage()    {}
+func (*UpdateDeviceTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{21}
+}
+func (m *UpdateDeviceTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDeviceTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDeviceTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateDeviceTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceTypeResponse.Merge(m, src)
+}
+func (m *UpdateDeviceTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDeviceTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceTypeResponse.DiscardUnknown(m)
 }
 
-func (m *AddResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateDeviceTypeResponse proto.InternalMessageInfo
+
+func (m *UpdateDeviceTypeResponse) GetDeviceType() *DeviceType {
+	if m != nil {
+		return m.DeviceType
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AddResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetDeviceTypeRequest gets a device type by name
+type GetDeviceTypeRequest struct {
+	// name is the name of the device type
+	Name Type `protobuf:"bytes,1,opt,name=name,proto3,casttype=Type" json:"name,omitempty"`
 }
 
-func (m *AddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
+func (m *GetDeviceTypeRequest) Reset()         { *m = GetDeviceTypeRequest{} }
+func (m *GetDeviceTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTypeRequest) ProtoMessage()    {}
+func (*GetDeviceTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{22}
+}
+func (m *GetDeviceTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDeviceTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDeviceTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetDeviceTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTypeRequest.Merge(m, src)
+}
+func (m *GetDeviceTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDeviceTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTypeRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDeviceTypeRequest proto.InternalMessageInfo
+
+func (m *GetDeviceTypeRequest) GetName() Type {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetDeviceTypeResponse carries a device type
+type GetDeviceTypeResponse struct {
+	// deviceType is the device type
+	DeviceType *DeviceType `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
 }
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
+func (m *GetDeviceTypeResponse) Reset()         { *m = GetDeviceTypeResponse{} }
+func (m *GetDeviceTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTypeResponse) ProtoMessage()    {}
+func (*GetDeviceTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{23}
+}
+func (m *GetDeviceTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDeviceTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDeviceTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetDeviceTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTypeResponse.Merge(m, src)
+}
+func (m *GetDeviceTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDeviceTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTypeResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDeviceTypeResponse proto.InternalMessageInfo
+
+func (m *GetDeviceTypeResponse) GetDeviceType() *DeviceType {
+	if m != nil {
+		return m.DeviceType
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ListDeviceTypesRequest requests all device types in the catalog
+type ListDeviceTypesRequest struct {
 }
 
-func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
+func (m *ListDeviceTypesRequest) Reset()         { *m = ListDeviceTypesRequest{} }
+func (m *ListDeviceTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceTypesRequest) ProtoMessage()    {}
+func (*ListDeviceTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{24}
+}
+func (m *ListDeviceTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDeviceTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDeviceTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListDeviceTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceTypesRequest.Merge(m, src)
+}
+func (m *ListDeviceTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDeviceTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceTypesRequest.DiscardUnknown(m)
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ListDeviceTypesRequest proto.InternalMessageInfo
+
+// ListDeviceTypesResponse carries all device types in the catalog
+type ListDeviceTypesResponse struct {
+	// deviceTypes are the device types
+	DeviceTypes []*DeviceType `protobuf:"bytes,1,rep,name=deviceTypes,proto3" json:"deviceTypes,omitempty"`
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+func (m *ListDeviceTypesResponse) Reset()         { *m = ListDeviceTypesResponse{} }
+func (m *ListDeviceTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceTypesResponse) ProtoMessage()    {}
+func (*ListDeviceTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{25}
+}
+func (m *ListDeviceTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDeviceTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDeviceTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListDeviceTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceTypesResponse.Merge(m, src)
+}
+func (m *ListDeviceTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDeviceTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceTypesResponse.DiscardUnknown(m)
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDeviceTypesResponse proto.InternalMessageInfo
+
+func (m *ListDeviceTypesResponse) GetDeviceTypes() []*DeviceType {
+	if m != nil {
+		return m.DeviceTypes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RemoveDeviceTypeRequest removes a device type
+type RemoveDeviceTypeRequest struct {
+	// deviceType is the device type to remove
+	DeviceType *DeviceType `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
+func (m *RemoveDeviceTypeRequest) Reset()         { *m = RemoveDeviceTypeRequest{} }
+func (m *RemoveDeviceTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceTypeRequest) ProtoMessage()    {}
+func (*RemoveDeviceTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{26}
+}
+func (m *RemoveDeviceTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDeviceTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDeviceTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RemoveDeviceTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDeviceTypeRequest.Merge(m, src)
+}
+func (m *RemoveDeviceTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDeviceTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDeviceTypeRequest.DiscardUnknown(m)
 }
 
-func (m *ListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveDeviceTypeRequest proto.InternalMessageInfo
+
+func (m *RemoveDeviceTypeRequest) GetDeviceType() *DeviceType {
+	if m != nil {
+		return m.DeviceType
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RemoveDeviceTypeResponse is sent in response to a RemoveDeviceTypeRequest
+type RemoveDeviceTypeResponse struct {
 }
 
-func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Subscribe {
-		i--
-		if m.Subscribe {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *RemoveDeviceTypeResponse) Reset()         { *m = RemoveDeviceTypeResponse{} }
+func (m *RemoveDeviceTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceTypeResponse) ProtoMessage()    {}
+func (*RemoveDeviceTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f133998963e93b, []int{27}
+}
+func (m *RemoveDeviceTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDeviceTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDeviceTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RemoveDeviceTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDeviceTypeResponse.Merge(m, src)
+}
+func (m *RemoveDeviceTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDeviceTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDeviceTypeResponse.DiscardUnknown(m)
 }
 
-func (m *ListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RemoveDeviceTypeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("topo.device.Protocol", Protocol_name, Protocol_value)
+	proto.RegisterEnum("topo.device.ConnectivityState", ConnectivityState_name, ConnectivityState_value)
+	proto.RegisterEnum("topo.device.ChannelState", ChannelState_name, ChannelState_value)
+	proto.RegisterEnum("topo.device.ServiceState", ServiceState_name, ServiceState_value)
+	proto.RegisterEnum("topo.device.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterEnum("topo.device.LifecycleState", LifecycleState_name, LifecycleState_value)
+	proto.RegisterEnum("topo.device.ListResponse_Type", ListResponse_Type_name, ListResponse_Type_value)
+	proto.RegisterType((*AddRequest)(nil), "topo.device.AddRequest")
+	proto.RegisterType((*AddResponse)(nil), "topo.device.AddResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "topo.device.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "topo.device.UpdateResponse")
+	proto.RegisterType((*GetRequest)(nil), "topo.device.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "topo.device.GetResponse")
+	proto.RegisterType((*ListRequest)(nil), "topo.device.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "topo.device.ListResponse")
+	proto.RegisterType((*RemoveRequest)(nil), "topo.device.RemoveRequest")
+	proto.RegisterType((*RemoveResponse)(nil), "topo.device.RemoveResponse")
+	proto.RegisterType((*Device)(nil), "topo.device.Device")
+	proto.RegisterMapType((map[string]string)(nil), "topo.device.Device.AttributesEntry")
+	proto.RegisterType((*Endpoint)(nil), "topo.device.Endpoint")
+	proto.RegisterType((*P4RuntimeConfig)(nil), "topo.device.P4RuntimeConfig")
+	proto.RegisterType((*Credentials)(nil), "topo.device.Credentials")
+	proto.RegisterType((*TlsConfig)(nil), "topo.device.TlsConfig")
+	proto.RegisterType((*ProtocolState)(nil), "topo.device.ProtocolState")
+	proto.RegisterType((*DeviceType)(nil), "topo.device.DeviceType")
+	proto.RegisterType((*AttributeSchema)(nil), "topo.device.AttributeSchema")
+	proto.RegisterType((*AddDeviceTypeRequest)(nil), "topo.device.AddDeviceTypeRequest")
+	proto.RegisterType((*AddDeviceTypeResponse)(nil), "topo.device.AddDeviceTypeResponse")
+	proto.RegisterType((*UpdateDeviceTypeRequest)(nil), "topo.device.UpdateDeviceTypeRequest")
+	proto.RegisterType((*UpdateDeviceTypeResponse)(nil), "topo.device.UpdateDeviceTypeResponse")
+	proto.RegisterType((*GetDeviceTypeRequest)(nil), "topo.device.GetDeviceTypeRequest")
+	proto.RegisterType((*GetDeviceTypeResponse)(nil), "topo.device.GetDeviceTypeResponse")
+	proto.RegisterType((*ListDeviceTypesRequest)(nil), "topo.device.ListDeviceTypesRequest")
+	proto.RegisterType((*ListDeviceTypesResponse)(nil), "topo.device.ListDeviceTypesResponse")
+	proto.RegisterType((*RemoveDeviceTypeRequest)(nil), "topo.device.RemoveDeviceTypeRequest")
+	proto.RegisterType((*RemoveDeviceTypeResponse)(nil), "topo.device.RemoveDeviceTypeResponse")
+}
+
+func init() { proto.RegisterFile("api/device/device.proto", fileDescriptor_95f133998963e93b) }
+
+var fileDescriptor_95f133998963e93b = []byte{
+	// 1871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x49, 0xd9, 0x91, 0x9e, 0x6c, 0x59, 0x99, 0x3a, 0x31, 0x4d, 0xbb, 0x96, 0x97, 0xc9,
+	0x16, 0x86, 0x17, 0x95, 0x1b, 0x27, 0x8b, 0x6e, 0x8c, 0x0d, 0xb0, 0xb2, 0xc4, 0x7a, 0xb9, 0x95,
+	0x29, 0x63, 0x24, 0xbb, 0xc8, 0x69, 0x41, 0x8b, 0x13, 0x2f, 0xb1, 0x32, 0xa9, 0x90, 0x94, 0x0b,
+	0x23, 0xd8, 0x4b, 0x81, 0x9e, 0x0a, 0x14, 0x5b, 0xf4, 0xd2, 0x53, 0x8f, 0xbd, 0xf5, 0xff, 0xd8,
+	0x43, 0x0f, 0x0b, 0xf4, 0xd2, 0x93, 0x5b, 0x38, 0xfd, 0x0b, 0x7a, 0x2a, 0x72, 0x2a, 0xe6, 0x83,
+	0xe2, 0x87, 0x14, 0xbb, 0x71, 0xf6, 0x62, 0x6b, 0xde, 0xf7, 0xbc, 0xf7, 0x7b, 0x6f, 0x66, 0x08,
+	0xcb, 0xf6, 0xd0, 0xdd, 0x76, 0xc8, 0xb9, 0xdb, 0x27, 0xe2, 0x5f, 0x7d, 0x18, 0xf8, 0x91, 0x8f,
+	0xca, 0x91, 0x3f, 0xf4, 0xeb, 0x9c, 0xa4, 0xad, 0x9f, 0xfa, 0xfe, 0xe9, 0x80, 0x6c, 0x33, 0xd6,
+	0xc9, 0xe8, 0xc5, 0xb6, 0x33, 0x0a, 0xec, 0xc8, 0xf5, 0x3d, 0x2e, 0xac, 0xd5, 0xf2, 0xfc, 0xc8,
+	0x3d, 0x23, 0x61, 0x64, 0x9f, 0x0d, 0x85, 0xc0, 0x9a, 0x10, 0xa0, 0xde, 0x6c, 0xcf, 0xf3, 0x23,
+	0xa6, 0x1d, 0x0a, 0xee, 0xd2, 0xa9, 0x7f, 0xea, 0xb3, 0x9f, 0xdb, 0xf4, 0x17, 0xa7, 0xea, 0x4f,
+	0x01, 0x1a, 0x8e, 0x83, 0xc9, 0xcb, 0x11, 0x09, 0x23, 0xf4, 0x11, 0xcc, 0xf1, 0x60, 0x54, 0x69,
+	0x43, 0xda, 0x2c, 0xef, 0xfc, 0xa8, 0x9e, 0x0a, 0xb0, 0xde, 0x62, 0xff, 0xb0, 0x10, 0xd1, 0x77,
+	0xa1, 0xcc, 0x54, 0xc3, 0xa1, 0xef, 0x85, 0xe4, 0xdd, 0x74, 0x3f, 0x85, 0x85, 0xa3, 0xa1, 0x63,
+	0x47, 0xe4, 0x56, 0x9e, 0x9f, 0x41, 0x25, 0xd6, 0xbe, 0x8d, 0xf3, 0x2d, 0x80, 0x7d, 0x12, 0xc5,
+	0x9e, 0xd7, 0x40, 0x76, 0x1d, 0xa6, 0x56, 0xda, 0x9b, 0xbf, 0xba, 0xac, 0xc9, 0x66, 0xeb, 0x0d,
+	0xfb, 0x8b, 0x65, 0xd7, 0xa1, 0x9b, 0x64, 0xb2, 0xb7, 0xf1, 0xf3, 0x7b, 0x09, 0xca, 0x6d, 0x37,
+	0x4c, 0x79, 0x2a, 0x85, 0xa3, 0x93, 0xb0, 0x1f, 0xb8, 0x27, 0x5c, 0xbf, 0x88, 0x13, 0x02, 0xd2,
+	0xa0, 0x78, 0x4e, 0x82, 0x90, 0x56, 0x4c, 0x95, 0x69, 0x34, 0x78, 0xbc, 0x46, 0x06, 0x2c, 0x0e,
+	0xdc, 0x17, 0xa4, 0x7f, 0xd1, 0x1f, 0x90, 0x6e, 0x64, 0x47, 0x24, 0x54, 0x95, 0x0d, 0x65, 0xb3,
+	0xb2, 0xb3, 0x9a, 0xf1, 0xdf, 0xce, 0xc8, 0xe0, 0xbc, 0x8e, 0xfe, 0x17, 0x09, 0xe6, 0x79, 0x40,
+	0x62, 0x3b, 0x3b, 0x50, 0x88, 0x2e, 0x86, 0x3c, 0x98, 0xca, 0xce, 0x7a, 0xce, 0x58, 0x22, 0x58,
+	0xef, 0x5d, 0x0c, 0x09, 0x66, 0xb2, 0xa9, 0x14, 0xc8, 0x37, 0xa7, 0xe0, 0x63, 0x28, 0x50, 0x55,
+	0x54, 0x84, 0x82, 0xd5, 0xb1, 0x8c, 0xea, 0x0c, 0x2a, 0xc1, 0x6c, 0xa3, 0xd5, 0x32, 0x5a, 0x55,
+	0x09, 0x95, 0xe1, 0xce, 0xd1, 0x61, 0xab, 0xd1, 0x33, 0x5a, 0x55, 0x99, 0x2e, 0xb0, 0x71, 0xd0,
+	0x39, 0x36, 0x5a, 0x55, 0x85, 0xc2, 0x03, 0x93, 0x33, 0xff, 0xfc, 0x76, 0xf0, 0xa8, 0x42, 0x25,
+	0xd6, 0xe6, 0xe1, 0xeb, 0x57, 0xb3, 0x30, 0xc7, 0x85, 0xae, 0x2f, 0x37, 0xda, 0x84, 0x62, 0x40,
+	0xce, 0x5d, 0x9a, 0x75, 0xb6, 0xbd, 0xc2, 0xde, 0xfc, 0x9b, 0xcb, 0x5a, 0x11, 0x0b, 0x1a, 0x1e,
+	0x73, 0x91, 0x0a, 0x77, 0x6c, 0xc7, 0x09, 0x48, 0x48, 0x4b, 0x41, 0xab, 0x15, 0x2f, 0xd1, 0x7d,
+	0x98, 0x8b, 0xec, 0xe0, 0x94, 0x44, 0x6a, 0x81, 0x31, 0xc4, 0x8a, 0x6a, 0x88, 0x82, 0xaa, 0xb3,
+	0x5c, 0x43, 0x2c, 0xd1, 0x53, 0xb8, 0x43, 0x7b, 0xd9, 0x1f, 0x45, 0xea, 0x1c, 0xdb, 0xde, 0x4a,
+	0x9d, 0xb7, 0x72, 0x3d, 0xee, 0xf5, 0x7a, 0x4b, 0xcc, 0x82, 0xbd, 0xc2, 0x9f, 0xfe, 0x59, 0x93,
+	0x70, 0x2c, 0x8f, 0x3e, 0x83, 0x72, 0x3f, 0x20, 0x0e, 0xf1, 0x22, 0xd7, 0x1e, 0x84, 0xea, 0x1d,
+	0xa6, 0xae, 0x66, 0xb2, 0xd3, 0x4c, 0xf8, 0x7b, 0x85, 0xef, 0x2e, 0x6b, 0x33, 0x38, 0xad, 0x82,
+	0x3e, 0x06, 0x25, 0x1a, 0x84, 0x6a, 0x91, 0x69, 0xde, 0xcf, 0x68, 0xf6, 0x06, 0x61, 0xd3, 0xf7,
+	0x5e, 0xb8, 0xa7, 0x7b, 0x65, 0xaa, 0x77, 0x75, 0x59, 0x53, 0x7a, 0xed, 0x2e, 0xa6, 0xf2, 0x68,
+	0x4d, 0x40, 0xa7, 0xc4, 0x32, 0x59, 0x7c, 0x73, 0x59, 0x2b, 0xa4, 0x40, 0xb2, 0x06, 0x85, 0xc0,
+	0x1f, 0x10, 0x15, 0x12, 0x2e, 0xf6, 0x07, 0x04, 0x33, 0x2a, 0x6a, 0x02, 0xd8, 0x51, 0x14, 0xb8,
+	0x27, 0x23, 0x8a, 0xe4, 0xf2, 0x86, 0xb2, 0x59, 0xde, 0x79, 0x30, 0xa5, 0xa2, 0xf5, 0xc6, 0x58,
+	0xca, 0xf0, 0xa2, 0xe0, 0x02, 0xa7, 0xd4, 0xd0, 0x27, 0x50, 0x62, 0xd9, 0xe9, 0xfb, 0x83, 0x50,
+	0x9d, 0x67, 0x36, 0xb4, 0x8c, 0x8d, 0x43, 0xc1, 0xe5, 0xcd, 0x90, 0x08, 0xa3, 0xc7, 0x50, 0x22,
+	0x9e, 0x33, 0xf4, 0x5d, 0x2f, 0x0a, 0xd5, 0x05, 0xa6, 0x79, 0x2f, 0xa3, 0x69, 0x08, 0x2e, 0x4e,
+	0xe4, 0x50, 0x13, 0x2a, 0xd9, 0x76, 0x52, 0x2b, 0x1b, 0xd2, 0x4d, 0x1d, 0x98, 0x53, 0xa1, 0x13,
+	0xc0, 0xb3, 0xcf, 0x48, 0x38, 0xb4, 0xfb, 0x44, 0x5d, 0x64, 0x20, 0x48, 0x08, 0xda, 0x33, 0x58,
+	0xcc, 0x6d, 0x18, 0x55, 0x41, 0xf9, 0x9a, 0x5c, 0x70, 0xb8, 0x62, 0xfa, 0x13, 0x2d, 0xc1, 0xec,
+	0xb9, 0x3d, 0x18, 0x11, 0x31, 0x23, 0xf8, 0x62, 0x57, 0xfe, 0x44, 0xd2, 0xff, 0x26, 0x43, 0x31,
+	0x8e, 0x1c, 0x3d, 0x82, 0x62, 0xbc, 0x61, 0xd1, 0xdd, 0xf7, 0xa6, 0x26, 0x07, 0x8f, 0xc5, 0xd2,
+	0x88, 0x96, 0xb3, 0x88, 0x4e, 0xe1, 0x53, 0x79, 0x47, 0x7c, 0xee, 0x66, 0xf1, 0x59, 0xb8, 0x1e,
+	0x9f, 0x59, 0x64, 0x3e, 0xe2, 0xc8, 0x9c, 0xbd, 0x16, 0x99, 0x77, 0x32, 0xa8, 0x34, 0xa1, 0x34,
+	0x7c, 0x12, 0x8c, 0x3c, 0xea, 0x5e, 0xf4, 0xd2, 0x5a, 0x76, 0xdf, 0x4f, 0x30, 0xe7, 0x0a, 0xf5,
+	0x85, 0xab, 0xcb, 0x5a, 0x69, 0x4c, 0xc4, 0x89, 0xb6, 0xfe, 0x35, 0x2c, 0xe6, 0x84, 0xe9, 0x74,
+	0xe0, 0x66, 0x4c, 0x3e, 0x41, 0x0a, 0x6c, 0x82, 0x14, 0x39, 0x58, 0xcd, 0x16, 0x1e, 0x73, 0x51,
+	0x1d, 0x80, 0x0c, 0x48, 0x9f, 0x66, 0xc4, 0x74, 0xc4, 0x24, 0xa9, 0x5c, 0x5d, 0xd6, 0xc0, 0x88,
+	0xa9, 0x2d, 0x9c, 0x92, 0xd0, 0x9f, 0x41, 0x39, 0x95, 0x06, 0x84, 0xa0, 0x30, 0x0a, 0x49, 0x20,
+	0xea, 0xce, 0x7e, 0xd3, 0xf3, 0x61, 0x68, 0x87, 0xe1, 0xaf, 0xfd, 0xc0, 0x89, 0xcf, 0x87, 0x78,
+	0xad, 0xbf, 0x82, 0xd2, 0x38, 0x23, 0x74, 0xfe, 0xf4, 0xed, 0x26, 0x09, 0x22, 0x31, 0x98, 0xc4,
+	0x8a, 0x1a, 0xed, 0x53, 0x2a, 0x9f, 0x4a, 0xec, 0x77, 0x8c, 0xaf, 0xd9, 0x0c, 0xbe, 0x86, 0x03,
+	0xdb, 0xf5, 0x58, 0xf6, 0x8a, 0x98, 0x2f, 0xa8, 0x73, 0xd7, 0x0b, 0x49, 0x7f, 0x14, 0x10, 0x36,
+	0x63, 0x8a, 0x78, 0xbc, 0xd6, 0xff, 0x2b, 0xc3, 0x42, 0xa6, 0xd7, 0x6e, 0x03, 0xbe, 0x36, 0xdc,
+	0xed, 0xfb, 0x9e, 0x47, 0x13, 0x72, 0xee, 0x46, 0x17, 0xbc, 0xc3, 0xe4, 0x29, 0xc7, 0x52, 0x33,
+	0x2f, 0x85, 0x27, 0x15, 0xd1, 0x33, 0x98, 0xef, 0x7f, 0x65, 0x7b, 0x1e, 0xe1, 0x01, 0xb1, 0x44,
+	0x54, 0x76, 0x56, 0xb2, 0x86, 0x52, 0x02, 0x38, 0x23, 0x4e, 0xd5, 0x43, 0x12, 0x50, 0x29, 0xae,
+	0x5e, 0x98, 0xa2, 0xde, 0x4d, 0x09, 0xe0, 0x8c, 0x38, 0xfa, 0x02, 0x16, 0x07, 0x76, 0x18, 0xb1,
+	0x05, 0xf5, 0x72, 0x4a, 0x04, 0x86, 0xb5, 0x89, 0xb6, 0xe9, 0xc5, 0x57, 0xb8, 0xbd, 0xc2, 0xb7,
+	0xb4, 0x6f, 0xf2, 0x8a, 0x74, 0x62, 0x50, 0x92, 0x11, 0x04, 0x7e, 0xc0, 0x4a, 0x52, 0xc2, 0x09,
+	0x41, 0xff, 0xb3, 0x0c, 0xc0, 0xd1, 0xd7, 0x13, 0x53, 0x97, 0x4e, 0x13, 0x55, 0x4a, 0xa6, 0x2e,
+	0x9f, 0xc9, 0x94, 0xfa, 0x0e, 0x67, 0x5b, 0xfa, 0x2a, 0xa2, 0xe4, 0xae, 0x22, 0x9f, 0x66, 0x66,
+	0x77, 0x61, 0x43, 0x99, 0x68, 0xb1, 0xf1, 0x0c, 0xeb, 0xf6, 0xbf, 0x22, 0x67, 0x76, 0x66, 0x68,
+	0x3f, 0x4e, 0x0f, 0xed, 0xd9, 0x0d, 0xe5, 0xed, 0xd0, 0x48, 0xe4, 0xde, 0xe3, 0x78, 0xd4, 0xff,
+	0x2a, 0xc1, 0x62, 0x2e, 0x1e, 0xda, 0x07, 0x49, 0x96, 0x44, 0x6e, 0xea, 0xe2, 0x34, 0xe3, 0x88,
+	0xd3, 0xa6, 0xef, 0x27, 0x75, 0xbe, 0x69, 0x34, 0x97, 0x2f, 0x47, 0x6e, 0x40, 0x1c, 0x96, 0xa1,
+	0x22, 0x1e, 0xaf, 0xe9, 0x1c, 0x1d, 0xda, 0x51, 0x44, 0x02, 0x4f, 0xb4, 0x5a, 0xbc, 0x44, 0x1b,
+	0x50, 0x76, 0x08, 0xbd, 0xee, 0x0d, 0xa3, 0xe4, 0x16, 0x90, 0x26, 0xe9, 0x1d, 0x58, 0x6a, 0x38,
+	0x4e, 0x52, 0xd2, 0xf8, 0xfe, 0xf3, 0x73, 0x00, 0x67, 0x4c, 0x14, 0x77, 0xa0, 0xe5, 0x29, 0x27,
+	0x26, 0xd3, 0x49, 0x89, 0xea, 0x87, 0x70, 0x2f, 0x67, 0x50, 0x5c, 0xfd, 0x6e, 0x6d, 0x11, 0xc3,
+	0x32, 0xbf, 0x7c, 0xff, 0x80, 0x51, 0x76, 0x41, 0x9d, 0xb4, 0xf9, 0xbe, 0x81, 0x3e, 0x81, 0xa5,
+	0x7d, 0x12, 0x4d, 0x46, 0x79, 0x6d, 0x97, 0xd0, 0x84, 0xe5, 0xb4, 0xde, 0x37, 0x0e, 0x15, 0xee,
+	0xd3, 0xbb, 0x74, 0xc2, 0x0d, 0x45, 0x24, 0x7a, 0x0f, 0x96, 0x27, 0x38, 0xc2, 0xdb, 0x53, 0x0a,
+	0x95, 0x31, 0x59, 0x95, 0x36, 0x94, 0xeb, 0xdc, 0xa5, 0x65, 0x69, 0x81, 0xf8, 0xf5, 0xf7, 0x07,
+	0x2c, 0x90, 0x06, 0xea, 0xa4, 0x4d, 0x1e, 0xea, 0x56, 0x13, 0x8a, 0x71, 0xd7, 0xa2, 0x25, 0xa8,
+	0x1e, 0x59, 0xbf, 0xb4, 0x3a, 0xbf, 0xb2, 0xbe, 0x3c, 0xc4, 0x9d, 0x5e, 0xa7, 0xd9, 0x69, 0x57,
+	0x67, 0xe8, 0xed, 0x7f, 0xdf, 0x3a, 0x30, 0xab, 0x12, 0x5a, 0x00, 0x7a, 0xd8, 0x1e, 0x59, 0x3d,
+	0xf3, 0xc0, 0xa8, 0xca, 0x9c, 0xd1, 0x31, 0xab, 0xca, 0x56, 0x17, 0xee, 0x4e, 0x4c, 0x76, 0xb4,
+	0x0e, 0x5a, 0x6c, 0xad, 0xd9, 0xb1, 0x2c, 0xa3, 0xd9, 0x33, 0x8f, 0xcd, 0xde, 0xf3, 0x2f, 0xbb,
+	0xbd, 0x46, 0x8f, 0xbe, 0x25, 0x16, 0xa0, 0x84, 0x8d, 0x46, 0xf3, 0xf3, 0xc6, 0x5e, 0xdb, 0xa8,
+	0x4a, 0x68, 0x11, 0xca, 0x47, 0x56, 0x42, 0x90, 0xb7, 0xbe, 0x80, 0xf9, 0xf4, 0x94, 0x47, 0x2b,
+	0x70, 0x6f, 0x6c, 0xef, 0xf3, 0x86, 0x65, 0x19, 0xed, 0xb4, 0x29, 0xe1, 0x82, 0x3d, 0x4d, 0xaa,
+	0x30, 0xdf, 0x32, 0xbb, 0x09, 0x45, 0xde, 0x7a, 0x0e, 0xf3, 0xe9, 0x91, 0x9f, 0xb6, 0xd5, 0x35,
+	0xf0, 0xb1, 0xd9, 0x34, 0xd2, 0xb6, 0x1a, 0xc7, 0x0d, 0xb3, 0x9d, 0x0e, 0x2b, 0x21, 0xc8, 0xa8,
+	0x02, 0x10, 0x6f, 0xc7, 0xda, 0xaf, 0x2a, 0x5b, 0x9f, 0xc1, 0x42, 0x66, 0xc6, 0x20, 0x80, 0xb9,
+	0x6e, 0x0f, 0x53, 0xe6, 0x0c, 0x7d, 0x17, 0x99, 0x56, 0xcf, 0xd8, 0x37, 0x30, 0x7f, 0x31, 0xed,
+	0x75, 0x3a, 0x6d, 0xa3, 0x61, 0x55, 0x65, 0xfa, 0x92, 0xfa, 0x45, 0xbb, 0xd3, 0xe8, 0x55, 0x95,
+	0x2d, 0x07, 0x2a, 0xd9, 0x9b, 0x27, 0xf5, 0x61, 0x8e, 0x23, 0xe3, 0x66, 0x0e, 0xdb, 0x74, 0xcb,
+	0x62, 0x77, 0x87, 0xb8, 0x73, 0x6c, 0x76, 0xcd, 0x8e, 0x45, 0xbd, 0xc8, 0x34, 0xc6, 0x83, 0x06,
+	0xf5, 0x63, 0x35, 0xac, 0xa6, 0x51, 0x55, 0x10, 0x82, 0x4a, 0xcb, 0x68, 0x76, 0x0e, 0x0e, 0xcc,
+	0x2e, 0x95, 0x32, 0x5a, 0xd5, 0xc2, 0xce, 0x7f, 0x14, 0x58, 0xe0, 0xf5, 0x17, 0x99, 0x40, 0xcf,
+	0x41, 0x69, 0x38, 0x0e, 0xca, 0x42, 0x28, 0xf9, 0x9e, 0xa0, 0xa9, 0x93, 0x0c, 0xf1, 0x22, 0xab,
+	0xfd, 0xe6, 0xef, 0xff, 0xfe, 0xa3, 0xbc, 0xb2, 0x1b, 0xbf, 0xd9, 0x16, 0xd9, 0x57, 0x8b, 0xf3,
+	0x47, 0xe2, 0xfb, 0x48, 0x88, 0x3c, 0x98, 0xe3, 0x23, 0x01, 0x65, 0xa7, 0x71, 0xe6, 0xb3, 0x81,
+	0xb6, 0x3a, 0x95, 0x27, 0x7c, 0x7c, 0xc4, 0x7c, 0x7c, 0x18, 0xfb, 0xd0, 0x56, 0x73, 0x3e, 0xb6,
+	0x5f, 0x09, 0x3d, 0xd7, 0xf9, 0x06, 0x1d, 0x83, 0xb2, 0x4f, 0xa2, 0xdc, 0x56, 0x92, 0xcf, 0x04,
+	0x9a, 0x3a, 0xc9, 0x10, 0x6e, 0xd6, 0x98, 0x9b, 0xfb, 0x68, 0x69, 0xc2, 0x3c, 0xb7, 0x5b, 0xa0,
+	0x3d, 0x8e, 0xd4, 0x29, 0x8f, 0x6b, 0x6e, 0x79, 0xe5, 0xad, 0xcf, 0x6e, 0x7d, 0x99, 0x99, 0xbe,
+	0x8b, 0xf2, 0xd9, 0xf9, 0x99, 0x84, 0x5e, 0xc0, 0x1c, 0xef, 0xc8, 0x5c, 0x7e, 0x32, 0xef, 0x66,
+	0x6d, 0x75, 0x2a, 0x4f, 0x58, 0x7f, 0xc0, 0xac, 0xff, 0x78, 0xeb, 0xba, 0xbc, 0xec, 0xfc, 0x6e,
+	0x16, 0xee, 0x26, 0x4d, 0x1f, 0x17, 0xfe, 0x9c, 0x17, 0xfe, 0x83, 0x7c, 0x7d, 0x27, 0x46, 0x8e,
+	0xa6, 0x5f, 0x27, 0x22, 0x02, 0xd9, 0x64, 0x81, 0xe8, 0xbb, 0xe9, 0x89, 0x93, 0xcb, 0xe6, 0x4f,
+	0xe9, 0xb1, 0x1b, 0xa2, 0x3f, 0x48, 0x63, 0x58, 0x3c, 0x9c, 0x52, 0xfa, 0x49, 0xf7, 0x1f, 0xde,
+	0x20, 0x25, 0x22, 0xd8, 0x65, 0x11, 0x3c, 0x49, 0x47, 0xa0, 0xfd, 0x64, 0x5a, 0x04, 0xdb, 0xaf,
+	0x12, 0x89, 0x3a, 0x3d, 0x30, 0xbe, 0x41, 0x2f, 0x39, 0x72, 0x3e, 0xc8, 0x03, 0xe4, 0xa6, 0x5c,
+	0x4c, 0x3d, 0x66, 0xe2, 0xa2, 0xa0, 0xd5, 0xe9, 0xde, 0xb9, 0x4b, 0x5f, 0x80, 0xea, 0xc1, 0x04,
+	0x74, 0x26, 0x4f, 0x19, 0xed, 0xe1, 0xf5, 0x42, 0xd7, 0xa3, 0x58, 0xe4, 0xfd, 0xb7, 0xd2, 0x18,
+	0x6e, 0x0f, 0xa7, 0x40, 0xea, 0xa6, 0xbc, 0xbf, 0xed, 0xec, 0xd0, 0xeb, 0xcc, 0xeb, 0xe6, 0xd6,
+	0xff, 0x99, 0xeb, 0x3d, 0xf5, 0xbb, 0xab, 0x75, 0xe9, 0xfb, 0xab, 0x75, 0xe9, 0x5f, 0x57, 0xeb,
+	0xd2, 0xb7, 0xaf, 0xd7, 0x67, 0xbe, 0x7f, 0xbd, 0x3e, 0xf3, 0x8f, 0xd7, 0xeb, 0x33, 0x27, 0x73,
+	0xec, 0x2e, 0xf8, 0xf8, 0x7f, 0x03, 0x00, 0x57, 0x5a, 0x9e, 0x5c, 0x6c, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceServiceClient is the client API for DeviceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceServiceClient interface {
+	// Add adds a device to the topology
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	// Update updates a device
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Get gets a device by ID
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// List gets a stream of device add/update/remove events
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (DeviceService_ListClient, error)
+	// Remove removes a device from the topology
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+}
+
+type deviceServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceServiceClient(cc *grpc.ClientConn) DeviceServiceClient {
+	return &deviceServiceClient{cc}
+}
+
+func (c *deviceServiceClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Add", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *deviceServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintDevice(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+func (c *deviceServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *deviceServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (DeviceService_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[0], "/topo.device.DeviceService/List", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &deviceServiceListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeviceService_ListClient interface {
+	Recv() (*ListResponse, error)
+	grpc.ClientStream
 }
 
-func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Device != nil {
-		{
-			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDevice(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+type deviceServiceListClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceServiceListClient) Recv() (*ListResponse, error) {
+	m := new(ListResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *deviceServiceClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceService/Remove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeviceServiceServer is the server API for DeviceService service.
+type DeviceServiceServer interface {
+	// Add adds a device to the topology
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	// Update updates a device
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Get gets a device by ID
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// List gets a stream of device add/update/remove events
+	List(*ListRequest, DeviceService_ListServer) error
+	// Remove removes a device from the topology
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
 }
 
-func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedDeviceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDeviceServiceServer struct {
 }
 
-func (m *Device) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedDeviceServiceServer) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedDeviceServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedDeviceServiceServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedDeviceServiceServer) List(req *ListRequest, srv DeviceService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedDeviceServiceServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
 }
 
-func (m *Device) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterDeviceServiceServer(s *grpc.Server, srv DeviceServiceServer) {
+	s.RegisterService(&_DeviceService_serviceDesc, srv)
 }
 
-func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Protocols) > 0 {
-		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Protocols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDevice(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+func _DeviceService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintDevice(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDevice(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDevice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x5a
-		}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Add(ctx, in)
 	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceService/Add",
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x4a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Add(ctx, req.(*AddRequest))
 	}
-	{
-		size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintDevice(dAtA, i, uint64(size))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.Credentials.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintDevice(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Update(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.Timeout != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintDevice(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceService/Update",
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Update(ctx, req.(*UpdateRequest))
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Get(ctx, in)
 	}
-	if m.Revision != 0 {
-		i = encodeVarintDevice(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceService/Get",
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Get(ctx, req.(*GetRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Credentials) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _DeviceService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(DeviceServiceServer).List(m, &deviceServiceListServer{stream})
 }
 
-func (m *Credentials) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeviceService_ListServer interface {
+	Send(*ListResponse) error
+	grpc.ServerStream
 }
 
-func (m *Credentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
+type deviceServiceListServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceServiceListServer) Send(m *ListResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceService_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Remove(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceService/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TlsConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var _DeviceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topo.device.DeviceService",
+	HandlerType: (*DeviceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _DeviceService_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DeviceService_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceService_Get_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _DeviceService_Remove_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _DeviceService_List_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/device/device.proto",
+}
+
+// DeviceTypeServiceClient is the client API for DeviceTypeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceTypeServiceClient interface {
+	// Add adds a device type to the catalog
+	Add(ctx context.Context, in *AddDeviceTypeRequest, opts ...grpc.CallOption) (*AddDeviceTypeResponse, error)
+	// Update updates a device type
+	Update(ctx context.Context, in *UpdateDeviceTypeRequest, opts ...grpc.CallOption) (*UpdateDeviceTypeResponse, error)
+	// Get gets a device type by name
+	Get(ctx context.Context, in *GetDeviceTypeRequest, opts ...grpc.CallOption) (*GetDeviceTypeResponse, error)
+	// List gets all device types in the catalog
+	List(ctx context.Context, in *ListDeviceTypesRequest, opts ...grpc.CallOption) (*ListDeviceTypesResponse, error)
+	// Remove removes a device type from the catalog
+	Remove(ctx context.Context, in *RemoveDeviceTypeRequest, opts ...grpc.CallOption) (*RemoveDeviceTypeResponse, error)
+}
+
+type deviceTypeServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceTypeServiceClient(cc *grpc.ClientConn) DeviceTypeServiceClient {
+	return &deviceTypeServiceClient{cc}
+}
+
+func (c *deviceTypeServiceClient) Add(ctx context.Context, in *AddDeviceTypeRequest, opts ...grpc.CallOption) (*AddDeviceTypeResponse, error) {
+	out := new(AddDeviceTypeResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceTypeService/Add", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TlsConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *deviceTypeServiceClient) Update(ctx context.Context, in *UpdateDeviceTypeRequest, opts ...grpc.CallOption) (*UpdateDeviceTypeResponse, error) {
+	out := new(UpdateDeviceTypeResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceTypeService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TlsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Insecure {
-		i--
-		if m.Insecure {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+func (c *deviceTypeServiceClient) Get(ctx context.Context, in *GetDeviceTypeRequest, opts ...grpc.CallOption) (*GetDeviceTypeResponse, error) {
+	out := new(GetDeviceTypeResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceTypeService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Plain {
-		i--
-		if m.Plain {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	return out, nil
+}
+
+func (c *deviceTypeServiceClient) List(ctx context.Context, in *ListDeviceTypesRequest, opts ...grpc.CallOption) (*ListDeviceTypesResponse, error) {
+	out := new(ListDeviceTypesResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceTypeService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Cert) > 0 {
-		i -= len(m.Cert)
-		copy(dAtA[i:], m.Cert)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.Cert)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CaCert) > 0 {
-		i -= len(m.CaCert)
-		copy(dAtA[i:], m.CaCert)
-		i = encodeVarintDevice(dAtA, i, uint64(len(m.CaCert)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ProtocolState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *deviceTypeServiceClient) Remove(ctx context.Context, in *RemoveDeviceTypeRequest, opts ...grpc.CallOption) (*RemoveDeviceTypeResponse, error) {
+	out := new(RemoveDeviceTypeResponse)
+	err := c.cc.Invoke(ctx, "/topo.device.DeviceTypeService/Remove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ProtocolState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DeviceTypeServiceServer is the server API for DeviceTypeService service.
+type DeviceTypeServiceServer interface {
+	// Add adds a device type to the catalog
+	Add(context.Context, *AddDeviceTypeRequest) (*AddDeviceTypeResponse, error)
+	// Update updates a device type
+	Update(context.Context, *UpdateDeviceTypeRequest) (*UpdateDeviceTypeResponse, error)
+	// Get gets a device type by name
+	Get(context.Context, *GetDeviceTypeRequest) (*GetDeviceTypeResponse, error)
+	// List gets all device types in the catalog
+	List(context.Context, *ListDeviceTypesRequest) (*ListDeviceTypesResponse, error)
+	// Remove removes a device type from the catalog
+	Remove(context.Context, *RemoveDeviceTypeRequest) (*RemoveDeviceTypeResponse, error)
 }
 
-func (m *ProtocolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ServiceState != 0 {
-		i = encodeVarintDevice(dAtA, i, uint64(m.ServiceState))
-		i--
-		dAtA[i] = 0x20
+// UnimplementedDeviceTypeServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDeviceTypeServiceServer struct {
+}
+
+func (*UnimplementedDeviceTypeServiceServer) Add(ctx context.Context, req *AddDeviceTypeRequest) (*AddDeviceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedDeviceTypeServiceServer) Update(ctx context.Context, req *UpdateDeviceTypeRequest) (*UpdateDeviceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedDeviceTypeServiceServer) Get(ctx context.Context, req *GetDeviceTypeRequest) (*GetDeviceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedDeviceTypeServiceServer) List(ctx context.Context, req *ListDeviceTypesRequest) (*ListDeviceTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedDeviceTypeServiceServer) Remove(ctx context.Context, req *RemoveDeviceTypeRequest) (*RemoveDeviceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+
+func RegisterDeviceTypeServiceServer(s *grpc.Server, srv DeviceTypeServiceServer) {
+	s.RegisterService(&_DeviceTypeService_serviceDesc, srv)
+}
+
+func _DeviceTypeService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeviceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChannelState != 0 {
-		i = encodeVarintDevice(dAtA, i, uint64(m.ChannelState))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(DeviceTypeServiceServer).Add(ctx, in)
 	}
-	if m.ConnectivityState != 0 {
-		i = encodeVarintDevice(dAtA, i, uint64(m.ConnectivityState))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceTypeService/Add",
 	}
-	if m.Protocol != 0 {
-		i = encodeVarintDevice(dAtA, i, uint64(m.Protocol))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceTypeServiceServer).Add(ctx, req.(*AddDeviceTypeRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintDevice(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDevice(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _DeviceTypeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AddRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(DeviceTypeServiceServer).Update(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceTypeService/Update",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceTypeServiceServer).Update(ctx, req.(*UpdateDeviceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DeviceTypeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if interceptor == nil {
+		return srv.(DeviceTypeServiceServer).Get(ctx, in)
 	}
-	return n
-}
-
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceTypeService/Get",
 	}
-	var l int
-	_ = l
-	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceTypeServiceServer).Get(ctx, req.(*GetDeviceTypeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DeviceTypeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if interceptor == nil {
+		return srv.(DeviceTypeServiceServer).List(ctx, in)
 	}
-	return n
-}
-
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceTypeService/List",
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceTypeServiceServer).List(ctx, req.(*ListDeviceTypesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _DeviceTypeService_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
+	if interceptor == nil {
+		return srv.(DeviceTypeServiceServer).Remove(ctx, in)
 	}
-	return n
-}
-
-func (m *ListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.device.DeviceTypeService/Remove",
 	}
-	var l int
-	_ = l
-	if m.Subscribe {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceTypeServiceServer).Remove(ctx, req.(*RemoveDeviceTypeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovDevice(uint64(m.Type))
-	}
-	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
-	}
-	return n
+var _DeviceTypeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topo.device.DeviceTypeService",
+	HandlerType: (*DeviceTypeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _DeviceTypeService_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DeviceTypeService_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceTypeService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceTypeService_List_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _DeviceTypeService_Remove_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/device/device.proto",
 }
 
-func (m *RemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Device != nil {
-		l = m.Device.Size()
-		n += 1 + l + sovDevice(uint64(l))
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Device) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
-	}
-	if m.Revision != 0 {
-		n += 1 + sovDevice(uint64(m.Revision))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
-	}
-	if m.Timeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
-		n += 1 + l + sovDevice(uint64(l))
-	}
-	l = m.Credentials.Size()
-	n += 1 + l + sovDevice(uint64(l))
-	l = m.TLS.Size()
-	n += 1 + l + sovDevice(uint64(l))
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if m.Device != nil {
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDevice(uint64(len(k))) + 1 + len(v) + sovDevice(uint64(len(v)))
-			n += mapEntrySize + 1 + sovDevice(uint64(mapEntrySize))
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Device != nil {
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Protocols) > 0 {
-		for _, e := range m.Protocols {
-			l = e.Size()
-			n += 1 + l + sovDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Device != nil {
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Credentials) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TlsConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Device != nil {
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CaCert)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if len(m.LifecycleStates) > 0 {
+		dAtA7 := make([]byte, len(m.LifecycleStates)*10)
+		var j6 int
+		for _, num := range m.LifecycleStates {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintDevice(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Cert)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if len(m.Versions) > 0 {
+		i -= len(m.Versions)
+		copy(dAtA[i:], m.Versions)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Versions)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDevice(uint64(l))
+	if m.Subscribe {
+		i--
+		if m.Subscribe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Plain {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *ListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Insecure {
+	return dAtA[:n], nil
+}
+
+func (m *ListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Device != nil {
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Device != nil {
+		{
+			size, err := m.Device.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.LifecycleState != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.LifecycleState))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Protocols) > 0 {
+		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Protocols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDevice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDevice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDevice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Credentials.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDevice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Timeout != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintDevice(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Endpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Endpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Endpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.P4Runtime != nil {
+		{
+			size, err := m.P4Runtime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Credentials != nil {
+		{
+			size, err := m.Credentials.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintDevice(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Protocol != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.Protocol))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *P4RuntimeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *P4RuntimeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *P4RuntimeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ElectionID != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.ElectionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DeviceID != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.DeviceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Credentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Credentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Credentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TlsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TlsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TlsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Insecure {
+		i--
+		if m.Insecure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Plain {
+		i--
+		if m.Plain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Cert) > 0 {
+		i -= len(m.Cert)
+		copy(dAtA[i:], m.Cert)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Cert)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CaCert) > 0 {
+		i -= len(m.CaCert)
+		copy(dAtA[i:], m.CaCert)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.CaCert)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastStateChange != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStateChange, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStateChange):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintDevice(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ServiceState != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.ServiceState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelState != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.ChannelState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConnectivityState != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.ConnectivityState))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Protocol != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.Protocol))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeviceType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintDevice(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Protocols) > 0 {
+		dAtA20 := make([]byte, len(m.Protocols)*10)
+		var j19 int
+		for _, num := range m.Protocols {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintDevice(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Versions) > 0 {
+		i -= len(m.Versions)
+		copy(dAtA[i:], m.Versions)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Versions)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintDevice(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddDeviceTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddDeviceTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddDeviceTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		{
+			size, err := m.DeviceType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddDeviceTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddDeviceTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddDeviceTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		{
+			size, err := m.DeviceType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDeviceTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDeviceTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDeviceTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		{
+			size, err := m.DeviceType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDeviceTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDeviceTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDeviceTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		{
+			size, err := m.DeviceType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDeviceTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDeviceTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDeviceTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDeviceTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDeviceTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDeviceTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		{
+			size, err := m.DeviceType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDeviceTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDeviceTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDeviceTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDeviceTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDeviceTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDeviceTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceTypes) > 0 {
+		for iNdEx := len(m.DeviceTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeviceTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDeviceTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDeviceTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDeviceTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		{
+			size, err := m.DeviceType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDeviceTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDeviceTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDeviceTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDevice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDevice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *AddResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subscribe {
+		n += 2
+	}
+	l = len(m.Versions)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if len(m.LifecycleStates) > 0 {
+		l = 0
+		for _, e := range m.LifecycleStates {
+			l += sovDevice(uint64(e))
+		}
+		n += 1 + sovDevice(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDevice(uint64(m.Type))
+	}
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDevice(uint64(m.Revision))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = m.Credentials.Size()
+	n += 1 + l + sovDevice(uint64(l))
+	l = m.TLS.Size()
+	n += 1 + l + sovDevice(uint64(l))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDevice(uint64(len(k))) + 1 + len(v) + sovDevice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDevice(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Protocols) > 0 {
+		for _, e := range m.Protocols {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	if m.LifecycleState != 0 {
+		n += 1 + sovDevice(uint64(m.LifecycleState))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *Endpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Protocol != 0 {
+		n += 1 + sovDevice(uint64(m.Protocol))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.P4Runtime != nil {
+		l = m.P4Runtime.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *P4RuntimeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceID != 0 {
+		n += 1 + sovDevice(uint64(m.DeviceID))
+	}
+	if m.ElectionID != 0 {
+		n += 1 + sovDevice(uint64(m.ElectionID))
+	}
+	return n
+}
+
+func (m *Credentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *TlsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CaCert)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Cert)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Plain {
+		n += 2
+	}
+	if m.Insecure {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProtocolState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Protocol != 0 {
+		n += 1 + sovDevice(uint64(m.Protocol))
+	}
+	if m.ConnectivityState != 0 {
+		n += 1 + sovDevice(uint64(m.ConnectivityState))
+	}
+	if m.ChannelState != 0 {
+		n += 1 + sovDevice(uint64(m.ChannelState))
+	}
+	if m.ServiceState != 0 {
+		n += 1 + sovDevice(uint64(m.ServiceState))
+	}
+	if m.LastStateChange != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStateChange)
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDevice(uint64(m.Revision))
+	}
+	l = len(m.Versions)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	if len(m.Protocols) > 0 {
+		l = 0
+		for _, e := range m.Protocols {
+			l += sovDevice(uint64(e))
+		}
+		n += 1 + sovDevice(uint64(l)) + l
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovDevice(uint64(m.Type))
+	}
+	if m.Required {
 		n += 2
 	}
-	return n
-}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *AddDeviceTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		l = m.DeviceType.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *AddDeviceTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		l = m.DeviceType.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateDeviceTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		l = m.DeviceType.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateDeviceTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		l = m.DeviceType.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDeviceTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDeviceTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		l = m.DeviceType.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ListDeviceTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListDeviceTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeviceTypes) > 0 {
+		for _, e := range m.DeviceTypes {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveDeviceTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeviceType != nil {
+		l = m.DeviceType.Size()
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveDeviceTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovDevice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDevice(x uint64) (n int) {
+	return sovDevice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribe", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Subscribe = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v LifecycleState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= LifecycleState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LifecycleStates = append(m.LifecycleStates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.LifecycleStates) == 0 {
+					m.LifecycleStates = make([]LifecycleState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v LifecycleState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= LifecycleState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LifecycleStates = append(m.LifecycleStates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleStates", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ListResponse_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Device) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= Revision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = Type(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = Role(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDevice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDevice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDevice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDevice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDevice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDevice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocols = append(m.Protocols, &ProtocolState{})
+			if err := m.Protocols[len(m.Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleState", wireType)
+			}
+			m.LifecycleState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleState |= LifecycleState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Endpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Endpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Endpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= Protocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TlsConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P4Runtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.P4Runtime == nil {
+				m.P4Runtime = &P4RuntimeConfig{}
+			}
+			if err := m.P4Runtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ProtocolState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Protocol != 0 {
-		n += 1 + sovDevice(uint64(m.Protocol))
-	}
-	if m.ConnectivityState != 0 {
-		n += 1 + sovDevice(uint64(m.ConnectivityState))
-	}
-	if m.ChannelState != 0 {
-		n += 1 + sovDevice(uint64(m.ChannelState))
-	}
-	if m.ServiceState != 0 {
-		n += 1 + sovDevice(uint64(m.ServiceState))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDevice(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDevice(x uint64) (n int) {
-	return sovDevice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AddRequest) Unmarshal(dAtA []byte) error {
+func (m *P4RuntimeConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2286,17 +6212,17 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: P4RuntimeConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: P4RuntimeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
 			}
-			var msglen int
+			m.DeviceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -2306,28 +6232,30 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DeviceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Device == nil {
-				m.Device = &Device{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionID", wireType)
 			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ElectionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -2352,7 +6280,7 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddResponse) Unmarshal(dAtA []byte) error {
+func (m *Credentials) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2375,17 +6303,17 @@ func (m *AddResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Credentials: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Credentials: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -2395,27 +6323,55 @@ func (m *AddResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Device == nil {
-				m.Device = &Device{}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2441,7 +6397,7 @@ func (m *AddResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *TlsConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2464,17 +6420,133 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TlsConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TlsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CaCert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaCert = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cert = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			m.Plain = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -2484,28 +6556,12 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Device == nil {
-				m.Device = &Device{}
-			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -2530,7 +6586,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *ProtocolState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2553,15 +6609,91 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtocolState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtocolState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= Protocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectivityState", wireType)
+			}
+			m.ConnectivityState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectivityState |= ConnectivityState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			m.ChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelState |= ChannelState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceState", wireType)
+			}
+			m.ServiceState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceState |= ServiceState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStateChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2588,13 +6720,45 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Device == nil {
-				m.Device = &Device{}
+			if m.LastStateChange == nil {
+				m.LastStateChange = new(time.Time)
 			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastStateChange, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -2619,7 +6783,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *DeviceType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,15 +6806,15 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2678,64 +6842,165 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = ID(dAtA[iNdEx:postIndex])
+			m.Name = Type(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if skippy < 0 {
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= Revision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDevice
+			m.Versions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attributes = append(m.Attributes, &AttributeSchema{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v Protocol
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Protocol(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Protocols = append(m.Protocols, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Protocols) == 0 {
+					m.Protocols = make([]Protocol, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Protocol
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Protocol(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Protocols = append(m.Protocols, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2762,10 +7027,10 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Device == nil {
-				m.Device = &Device{}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
 			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2793,7 +7058,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
+func (m *AttributeSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2816,17 +7081,17 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscribe", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -2836,66 +7101,25 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Subscribe = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDevice
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
@@ -2909,16 +7133,36 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ListResponse_Type(b&0x7F) << shift
+				m.Type |= AttributeType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -2928,27 +7172,55 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Device == nil {
-				m.Device = &Device{}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevice
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2974,7 +7246,7 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *AddDeviceTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2997,15 +7269,15 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddDeviceTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddDeviceTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3032,66 +7304,13 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Device == nil {
-				m.Device = &Device{}
-			}
-			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.DeviceType == nil {
+				m.DeviceType = &DeviceType{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDevice(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.DeviceType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDevice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -3116,7 +7335,7 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Device) Unmarshal(dAtA []byte) error {
+func (m *AddDeviceTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3139,17 +7358,17 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddDeviceTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddDeviceTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -3159,112 +7378,86 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = ID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			m.Revision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revision |= Revision(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.DeviceType == nil {
+				m.DeviceType = &DeviceType{}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if err := m.DeviceType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDevice
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDeviceTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDeviceTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDeviceTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -3274,63 +7467,84 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if m.DeviceType == nil {
+				m.DeviceType = &DeviceType{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.DeviceType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = new(time.Duration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDeviceTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDeviceTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDeviceTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3357,46 +7571,69 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DeviceType == nil {
+				m.DeviceType = &DeviceType{}
+			}
+			if err := m.DeviceType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDeviceTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDeviceTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDeviceTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3424,43 +7661,64 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = Type(dAtA[iNdEx:postIndex])
+			m.Name = Type(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = Role(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDeviceTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDeviceTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDeviceTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3487,138 +7745,66 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
+			if m.DeviceType == nil {
+				m.DeviceType = &DeviceType{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDevice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDevice
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDevice
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDevice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthDevice
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthDevice
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDevice(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthDevice
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.DeviceType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDevice
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocols = append(m.Protocols, &ProtocolState{})
-			if err := m.Protocols[len(m.Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDeviceTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDeviceTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDeviceTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -3643,7 +7829,7 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Credentials) Unmarshal(dAtA []byte) error {
+func (m *ListDeviceTypesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3666,17 +7852,17 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Credentials: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDeviceTypesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Credentials: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDeviceTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -3686,55 +7872,25 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DeviceTypes = append(m.DeviceTypes, &DeviceType{})
+			if err := m.DeviceTypes[len(m.DeviceTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3760,7 +7916,7 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TlsConfig) Unmarshal(dAtA []byte) error {
+func (m *RemoveDeviceTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3783,49 +7939,17 @@ func (m *TlsConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TlsConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDeviceTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TlsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDeviceTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaCert", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CaCert = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDevice
@@ -3835,96 +7959,28 @@ func (m *TlsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cert = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDevice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDevice
+			if m.DeviceType == nil {
+				m.DeviceType = &DeviceType{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.DeviceType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Plain = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -3949,7 +8005,7 @@ func (m *TlsConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtocolState) Unmarshal(dAtA []byte) error {
+func (m *RemoveDeviceTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3972,88 +8028,12 @@ func (m *ProtocolState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDeviceTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDeviceTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
-			}
-			m.Protocol = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Protocol |= Protocol(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectivityState", wireType)
-			}
-			m.ConnectivityState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConnectivityState |= ConnectivityState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
-			}
-			m.ChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelState |= ChannelState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceState", wireType)
-			}
-			m.ServiceState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ServiceState |= ServiceState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
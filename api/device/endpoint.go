@@ -0,0 +1,53 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+// FindEndpoint returns the device's endpoint for the given protocol, or nil if the device does not
+// declare an endpoint for the protocol
+func (m *Device) FindEndpoint(protocol Protocol) *Endpoint {
+	for _, endpoint := range m.Endpoints {
+		if endpoint.Protocol == protocol {
+			return endpoint
+		}
+	}
+	return nil
+}
+
+// ResolveEndpoint returns the endpoint for the given protocol with the settings the endpoint does not
+// specify defaulted to the device's address, timeout, credentials and TLS configuration. The device's
+// endpoints are not modified.
+func (m *Device) ResolveEndpoint(protocol Protocol) *Endpoint {
+	resolved := &Endpoint{
+		Protocol: protocol,
+	}
+	if endpoint := m.FindEndpoint(protocol); endpoint != nil {
+		*resolved = *endpoint
+	}
+	if resolved.Address == "" {
+		resolved.Address = m.Address
+	}
+	if resolved.Timeout == nil {
+		resolved.Timeout = m.Timeout
+	}
+	if resolved.Credentials == nil {
+		credentials := m.Credentials
+		resolved.Credentials = &credentials
+	}
+	if resolved.TLS == nil {
+		tls := m.TLS
+		resolved.TLS = &tls
+	}
+	return resolved
+}
@@ -0,0 +1,66 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveEndpoint(t *testing.T) {
+	deviceTimeout := 5 * time.Second
+	p4rtTimeout := 10 * time.Second
+	device := &Device{
+		ID:          "stratum-1",
+		Address:     "stratum-1:9339",
+		Timeout:     &deviceTimeout,
+		Credentials: Credentials{User: "admin", Password: "admin"},
+		TLS:         TlsConfig{CaCert: "ca.crt"},
+		Endpoints: []*Endpoint{
+			{
+				Protocol: Protocol_P4RUNTIME,
+				Address:  "stratum-1:9559",
+				Timeout:  &p4rtTimeout,
+				TLS:      &TlsConfig{Plain: true},
+				P4Runtime: &P4RuntimeConfig{
+					DeviceID:   1,
+					ElectionID: 10,
+				},
+			},
+		},
+	}
+
+	gnmi := device.ResolveEndpoint(Protocol_GNMI)
+	assert.Equal(t, Protocol_GNMI, gnmi.Protocol)
+	assert.Equal(t, "stratum-1:9339", gnmi.Address)
+	assert.Equal(t, deviceTimeout, *gnmi.Timeout)
+	assert.Equal(t, "admin", gnmi.Credentials.User)
+	assert.Equal(t, "ca.crt", gnmi.TLS.CaCert)
+	assert.Nil(t, gnmi.P4Runtime)
+
+	p4rt := device.ResolveEndpoint(Protocol_P4RUNTIME)
+	assert.Equal(t, "stratum-1:9559", p4rt.Address)
+	assert.Equal(t, p4rtTimeout, *p4rt.Timeout)
+	assert.Equal(t, "admin", p4rt.Credentials.User)
+	assert.True(t, p4rt.TLS.Plain)
+	assert.Equal(t, "", p4rt.TLS.CaCert)
+	assert.Equal(t, uint64(1), p4rt.P4Runtime.DeviceID)
+
+	// Resolving does not modify the device's endpoints
+	assert.Nil(t, device.Endpoints[0].Credentials)
+	assert.Nil(t, device.FindEndpoint(Protocol_GNMI))
+}
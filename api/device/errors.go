@@ -0,0 +1,69 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevisionViolationType is the type of the precondition failure violation attached to revision
+// conflict errors. The subject of the violation is the ID or name of the conflicting resource and
+// the description is its current revision.
+const RevisionViolationType = "REVISION"
+
+// NewRevisionConflict returns an Aborted error indicating the named resource was modified concurrently,
+// with the current revision of the resource attached as a precondition failure
+func NewRevisionConflict(kind string, name string, revision Revision, current Revision) error {
+	st := status.Newf(codes.Aborted, "%s '%s' revision %d does not match the current revision %d", kind, name, revision, current)
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{
+				Type:        RevisionViolationType,
+				Subject:     name,
+				Description: strconv.FormatUint(uint64(current), 10),
+			},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// GetCurrentRevision returns the current revision attached to a revision conflict error
+func GetCurrentRevision(err error) (Revision, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		if failure, ok := detail.(*errdetails.PreconditionFailure); ok {
+			for _, violation := range failure.Violations {
+				if violation.Type != RevisionViolationType {
+					continue
+				}
+				revision, err := strconv.ParseUint(violation.Description, 10, 64)
+				if err == nil {
+					return Revision(revision), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
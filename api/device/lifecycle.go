@@ -0,0 +1,45 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+// lifecycleTransitions are the lifecycle states to which a device may transition from each state.
+// Decommissioned devices cannot transition to any other state and must be removed.
+var lifecycleTransitions = map[LifecycleState][]LifecycleState{
+	LifecycleState_PLANNED:        {LifecycleState_PROVISIONING, LifecycleState_DECOMMISSIONED},
+	LifecycleState_PROVISIONING:   {LifecycleState_IN_SERVICE, LifecycleState_PLANNED, LifecycleState_DECOMMISSIONED},
+	LifecycleState_IN_SERVICE:     {LifecycleState_MAINTENANCE, LifecycleState_DECOMMISSIONED},
+	LifecycleState_MAINTENANCE:    {LifecycleState_IN_SERVICE, LifecycleState_PROVISIONING, LifecycleState_DECOMMISSIONED},
+	LifecycleState_DECOMMISSIONED: {},
+}
+
+// CanTransitionTo returns whether a device in the lifecycle state may transition to the given state
+func (x LifecycleState) CanTransitionTo(state LifecycleState) bool {
+	if x == state {
+		return true
+	}
+	for _, next := range lifecycleTransitions[x] {
+		if next == state {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive returns whether devices in the lifecycle state are expected to be reachable. Subsystems
+// that monitor or configure devices should skip devices that are not active, e.g. devices in
+// maintenance.
+func (x LifecycleState) IsActive() bool {
+	return x == LifecycleState_IN_SERVICE || x == LifecycleState_PROVISIONING
+}
@@ -0,0 +1,43 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifecycleTransitions(t *testing.T) {
+	assert.True(t, LifecycleState_PLANNED.CanTransitionTo(LifecycleState_PLANNED))
+	assert.True(t, LifecycleState_PLANNED.CanTransitionTo(LifecycleState_PROVISIONING))
+	assert.False(t, LifecycleState_PLANNED.CanTransitionTo(LifecycleState_IN_SERVICE))
+	assert.True(t, LifecycleState_PROVISIONING.CanTransitionTo(LifecycleState_IN_SERVICE))
+	assert.True(t, LifecycleState_IN_SERVICE.CanTransitionTo(LifecycleState_MAINTENANCE))
+	assert.False(t, LifecycleState_IN_SERVICE.CanTransitionTo(LifecycleState_PLANNED))
+	assert.True(t, LifecycleState_MAINTENANCE.CanTransitionTo(LifecycleState_IN_SERVICE))
+	for state := range LifecycleState_name {
+		assert.True(t, LifecycleState(state).CanTransitionTo(LifecycleState_DECOMMISSIONED))
+		if LifecycleState(state) != LifecycleState_DECOMMISSIONED {
+			assert.False(t, LifecycleState_DECOMMISSIONED.CanTransitionTo(LifecycleState(state)))
+		}
+	}
+
+	assert.True(t, LifecycleState_IN_SERVICE.IsActive())
+	assert.True(t, LifecycleState_PROVISIONING.IsActive())
+	assert.False(t, LifecycleState_MAINTENANCE.IsActive())
+	assert.False(t, LifecycleState_PLANNED.IsActive())
+	assert.False(t, LifecycleState_DECOMMISSIONED.IsActive())
+}
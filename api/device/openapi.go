@@ -0,0 +1,983 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by embed-openapi.sh. DO NOT EDIT.
+
+package device
+
+// OpenAPI is the OpenAPI document describing the REST mapping of the service,
+// generated from api/device/device.swagger.json
+const OpenAPI = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "api/device/device.proto",
+    "description": "Package topo.device defines interfaces for managing devices.",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/api/v1/device-types": {
+      "get": {
+        "summary": "List gets a stream of device add/update/remove events",
+        "operationId": "List",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceListDeviceTypesResponse"
+            }
+          }
+        },
+        "tags": [
+          "DeviceTypeService"
+        ]
+      },
+      "post": {
+        "summary": "Add adds a device to the topology",
+        "operationId": "Add",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceAddDeviceTypeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "description": "deviceType is the device type to add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/deviceDeviceType"
+            }
+          }
+        ],
+        "tags": [
+          "DeviceTypeService"
+        ]
+      }
+    },
+    "/api/v1/device-types/{deviceType.name}": {
+      "delete": {
+        "summary": "Remove removes a device from the topology",
+        "operationId": "Remove",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceRemoveDeviceTypeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "deviceType.name",
+            "description": "name is the name of the type, matching the type of devices",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "deviceType.revision",
+            "description": "revision is the revision of the device type.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "deviceType.versions",
+            "description": "versions is the semantic version range to which device versions must belong, e.g. \"\u003e=1.0.0 \u003c2.0.0\"\nor \"1.x\"; devices may have any version if empty.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "deviceType.protocols",
+            "description": "protocols are the protocols of devices that do not declare any protocol.\n\n - UNKNOWN_PROTOCOL: UNKNOWN_PROTOCOL constant needed to go around proto3 nullifying the 0 values\n - GNMI: GNMI protocol reference\n - P4RUNTIME: P4RUNTIME protocol reference\n - GNOI: GNOI protocol reference",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string",
+              "enum": [
+                "UNKNOWN_PROTOCOL",
+                "GNMI",
+                "P4RUNTIME",
+                "GNOI"
+              ]
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "deviceType.timeout",
+            "description": "timeout is the request timeout of devices that do not specify a timeout.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "DeviceTypeService"
+        ]
+      },
+      "put": {
+        "summary": "Update updates a device",
+        "operationId": "Update",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceUpdateDeviceTypeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "deviceType.name",
+            "description": "name is the name of the type, matching the type of devices",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "description": "deviceType is the updated device type",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/deviceDeviceType"
+            }
+          }
+        ],
+        "tags": [
+          "DeviceTypeService"
+        ]
+      }
+    },
+    "/api/v1/device-types/{name}": {
+      "get": {
+        "summary": "Get gets a device by ID",
+        "operationId": "Get",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceGetDeviceTypeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name is the name of the device type",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "DeviceTypeService"
+        ]
+      }
+    },
+    "/api/v1/devices": {
+      "get": {
+        "summary": "List gets a stream of device add/update/remove events",
+        "operationId": "List",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "$ref": "#/x-stream-definitions/deviceListResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "subscribe",
+            "description": "subscribe indicates whether to subscribe to events (e.g. ADD, UPDATE, and REMOVE) that occur\nafter all devices have been streamed to the client.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "versions",
+            "description": "versions is a version range to which the versions of listed devices must belong, e.g. \"\u003c2.0.0\"\nor \"\u003e=1.0.0 \u003c1.2.0\"; devices of any version are listed if empty.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "lifecycleStates",
+            "description": "lifecycleStates are the lifecycle states of listed devices; devices in any state are listed if empty.\n\n - IN_SERVICE: IN_SERVICE indicates the device is in service; devices added without a lifecycle state are in service\n - PLANNED: PLANNED indicates the device is planned but not yet deployed\n - PROVISIONING: PROVISIONING indicates the device is deployed and being provisioned\n - MAINTENANCE: MAINTENANCE indicates the device is temporarily out of service for maintenance\n - DECOMMISSIONED: DECOMMISSIONED indicates the device is permanently out of service",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string",
+              "enum": [
+                "IN_SERVICE",
+                "PLANNED",
+                "PROVISIONING",
+                "MAINTENANCE",
+                "DECOMMISSIONED"
+              ]
+            },
+            "collectionFormat": "multi"
+          }
+        ],
+        "tags": [
+          "DeviceService"
+        ]
+      },
+      "post": {
+        "summary": "Add adds a device to the topology",
+        "operationId": "Add",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceAddResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "description": "device is the device to add",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/deviceDevice"
+            }
+          }
+        ],
+        "tags": [
+          "DeviceService"
+        ]
+      }
+    },
+    "/api/v1/devices/{device.id}": {
+      "delete": {
+        "summary": "Remove removes a device from the topology",
+        "operationId": "Remove",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceRemoveResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "device.id",
+            "description": "id is a device identifier, unique within the namespace of the device",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "device.revision",
+            "description": "revision is the revision of the device.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "uint64"
+          },
+          {
+            "name": "device.address",
+            "description": "address is the [scheme://]host:port of the device; IPv6 hosts must be bracketed.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.target",
+            "description": "target is the device target.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.version",
+            "description": "version is the device software version.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.timeout",
+            "description": "timeout indicates the device request timeout.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.credentials.user",
+            "description": "user is the user with which to connect to the device.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.credentials.password",
+            "description": "password is the password for connecting to the device.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.tls.caCert",
+            "description": "caCert is the name of the device's CA certificate.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.tls.cert",
+            "description": "cert is the name of the device's certificate.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.tls.key",
+            "description": "key is the name of the device's TLS key.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.tls.plain",
+            "description": "plain indicates whether to connect to the device over plaintext.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "device.tls.insecure",
+            "description": "insecure indicates whether to connect to the device with insecure communication.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "device.type",
+            "description": "type is the type of the device.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.role",
+            "description": "role is a role for the device.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "device.lifecycleState",
+            "description": "lifecycleState is the administrative lifecycle state of the device.\n\n - IN_SERVICE: IN_SERVICE indicates the device is in service; devices added without a lifecycle state are in service\n - PLANNED: PLANNED indicates the device is planned but not yet deployed\n - PROVISIONING: PROVISIONING indicates the device is deployed and being provisioned\n - MAINTENANCE: MAINTENANCE indicates the device is temporarily out of service for maintenance\n - DECOMMISSIONED: DECOMMISSIONED indicates the device is permanently out of service",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "IN_SERVICE",
+              "PLANNED",
+              "PROVISIONING",
+              "MAINTENANCE",
+              "DECOMMISSIONED"
+            ],
+            "default": "IN_SERVICE"
+          },
+          {
+            "name": "device.namespace",
+            "description": "namespace is the namespace of the device, set by the service from the namespace of the request.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "DeviceService"
+        ]
+      },
+      "put": {
+        "summary": "Update updates a device",
+        "operationId": "Update",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceUpdateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "device.id",
+            "description": "id is a device identifier, unique within the namespace of the device",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "description": "device is the updated device",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/deviceDevice"
+            }
+          }
+        ],
+        "tags": [
+          "DeviceService"
+        ]
+      }
+    },
+    "/api/v1/devices/{id}": {
+      "get": {
+        "summary": "Get gets a device by ID",
+        "operationId": "Get",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deviceGetResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "description": "id is the unique device ID with which to lookup the device",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "DeviceService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "deviceAddDeviceTypeResponse": {
+      "type": "object",
+      "properties": {
+        "deviceType": {
+          "$ref": "#/definitions/deviceDeviceType",
+          "title": "deviceType is the device type with a revision number"
+        }
+      },
+      "title": "AddDeviceTypeResponse is sent in response to an AddDeviceTypeRequest"
+    },
+    "deviceAddResponse": {
+      "type": "object",
+      "properties": {
+        "device": {
+          "$ref": "#/definitions/deviceDevice",
+          "title": "device is the device with a revision number"
+        }
+      },
+      "title": "AddResponse is sent in response to an AddDeviceRequest"
+    },
+    "deviceAttributeSchema": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name is the attribute key"
+        },
+        "type": {
+          "$ref": "#/definitions/deviceAttributeType",
+          "title": "type is the type of the attribute value"
+        },
+        "required": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "required indicates whether devices must have the attribute"
+        },
+        "pattern": {
+          "type": "string",
+          "title": "pattern is a regular expression the whole attribute value must match, if any"
+        },
+        "description": {
+          "type": "string",
+          "title": "description is a human readable description of the attribute"
+        }
+      },
+      "title": "AttributeSchema describes a device attribute"
+    },
+    "deviceAttributeType": {
+      "type": "string",
+      "enum": [
+        "STRING",
+        "INTEGER",
+        "BOOLEAN",
+        "FLOAT"
+      ],
+      "default": "STRING",
+      "description": "- STRING: STRING indicates the attribute may have any value\n - INTEGER: INTEGER indicates the attribute value must be a base 10 integer\n - BOOLEAN: BOOLEAN indicates the attribute value must be true or false\n - FLOAT: FLOAT indicates the attribute value must be a floating point number",
+      "title": "AttributeType is the type of the value of a device attribute"
+    },
+    "deviceChannelState": {
+      "type": "string",
+      "enum": [
+        "UNKNOWN_CHANNEL_STATE",
+        "CONNECTED",
+        "DISCONNECTED"
+      ],
+      "default": "UNKNOWN_CHANNEL_STATE",
+      "description": "- UNKNOWN_CHANNEL_STATE: UNKNOWN_CHANNEL_STATE constant needed to go around proto3 nullifying the 0 values\n - CONNECTED: CONNECTED indicates the corresponding grpc channel is connected on this device\n - DISCONNECTED: DISCONNECTED indicates the corresponding grpc channel is not connected on this device",
+      "title": "ConnectivityState represents the state of a gRPC channel to the device from the service container"
+    },
+    "deviceConnectivityState": {
+      "type": "string",
+      "enum": [
+        "UNKNOWN_CONNECTIVITY_STATE",
+        "REACHABLE",
+        "UNREACHABLE"
+      ],
+      "default": "UNKNOWN_CONNECTIVITY_STATE",
+      "description": "- UNKNOWN_CONNECTIVITY_STATE: UNKNOWN_CONNECTIVITY_STATE constant needed to go around proto3 nullifying the 0 values\n - REACHABLE: REACHABLE indicates the the service can reach the device at L3\n - UNREACHABLE: UNREACHABLE indicates the the service can't reach the device at L3",
+      "title": "ConnectivityState represents the L3 reachability of a device from the service container (e.g. enos-config), independently of gRPC or the service itself (e.g. gNMI)"
+    },
+    "deviceCredentials": {
+      "type": "object",
+      "properties": {
+        "user": {
+          "type": "string",
+          "title": "user is the user with which to connect to the device"
+        },
+        "password": {
+          "type": "string",
+          "title": "password is the password for connecting to the device"
+        }
+      },
+      "title": "Credentials is the device credentials"
+    },
+    "deviceDevice": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "title": "id is a device identifier, unique within the namespace of the device"
+        },
+        "revision": {
+          "type": "string",
+          "format": "uint64",
+          "title": "revision is the revision of the device"
+        },
+        "address": {
+          "type": "string",
+          "title": "address is the [scheme://]host:port of the device; IPv6 hosts must be bracketed"
+        },
+        "target": {
+          "type": "string",
+          "title": "target is the device target"
+        },
+        "version": {
+          "type": "string",
+          "title": "version is the device software version"
+        },
+        "timeout": {
+          "type": "string",
+          "title": "timeout indicates the device request timeout"
+        },
+        "credentials": {
+          "$ref": "#/definitions/deviceCredentials",
+          "title": "credentials contains the credentials for connecting to the device"
+        },
+        "tls": {
+          "$ref": "#/definitions/deviceTlsConfig",
+          "title": "tls is the device TLS configuration"
+        },
+        "type": {
+          "type": "string",
+          "title": "type is the type of the device"
+        },
+        "role": {
+          "type": "string",
+          "title": "role is a role for the device"
+        },
+        "attributes": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "attributes is an arbitrary mapping of attribute keys/values"
+        },
+        "protocols": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deviceProtocolState"
+          }
+        },
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deviceEndpoint"
+          },
+          "description": "endpoints are the per-protocol endpoints of the device. Settings that are not specified by an\nendpoint default to the address, timeout, credentials and tls of the device."
+        },
+        "lifecycleState": {
+          "$ref": "#/definitions/deviceLifecycleState",
+          "title": "lifecycleState is the administrative lifecycle state of the device"
+        },
+        "namespace": {
+          "type": "string",
+          "title": "namespace is the namespace of the device, set by the service from the namespace of the request"
+        }
+      },
+      "title": "Device contains information about a device"
+    },
+    "deviceDeviceType": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name is the name of the type, matching the type of devices"
+        },
+        "revision": {
+          "type": "string",
+          "format": "uint64",
+          "title": "revision is the revision of the device type"
+        },
+        "versions": {
+          "type": "string",
+          "title": "versions is the semantic version range to which device versions must belong, e.g. \"\u003e=1.0.0 \u003c2.0.0\"\nor \"1.x\"; devices may have any version if empty"
+        },
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deviceAttributeSchema"
+          },
+          "title": "attributes is the schema of the device attributes"
+        },
+        "protocols": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deviceProtocol"
+          },
+          "title": "protocols are the protocols of devices that do not declare any protocol"
+        },
+        "timeout": {
+          "type": "string",
+          "title": "timeout is the request timeout of devices that do not specify a timeout"
+        }
+      },
+      "title": "DeviceType describes a type of device, the schema to which devices of the type must conform, and the\ndefaults applied to devices of the type"
+    },
+    "deviceEndpoint": {
+      "type": "object",
+      "properties": {
+        "protocol": {
+          "$ref": "#/definitions/deviceProtocol",
+          "title": "protocol is the protocol served by the endpoint"
+        },
+        "address": {
+          "type": "string",
+          "title": "address is the [scheme://]host:port of the endpoint; defaults to the device address"
+        },
+        "timeout": {
+          "type": "string",
+          "title": "timeout is the request timeout of the endpoint; defaults to the device timeout"
+        },
+        "credentials": {
+          "$ref": "#/definitions/deviceCredentials",
+          "title": "credentials are the credentials for connecting to the endpoint; default to the device credentials"
+        },
+        "tls": {
+          "$ref": "#/definitions/deviceTlsConfig",
+          "title": "tls is the endpoint TLS configuration; defaults to the device TLS configuration"
+        },
+        "p4runtime": {
+          "$ref": "#/definitions/deviceP4RuntimeConfig",
+          "title": "p4runtime is the P4Runtime configuration of P4RUNTIME endpoints"
+        }
+      },
+      "title": "Endpoint is the endpoint and connection settings of a protocol on a device"
+    },
+    "deviceGetDeviceTypeResponse": {
+      "type": "object",
+      "properties": {
+        "deviceType": {
+          "$ref": "#/definitions/deviceDeviceType",
+          "title": "deviceType is the device type"
+        }
+      },
+      "title": "GetDeviceTypeResponse carries a device type"
+    },
+    "deviceGetResponse": {
+      "type": "object",
+      "properties": {
+        "device": {
+          "$ref": "#/definitions/deviceDevice",
+          "title": "device is the device object"
+        }
+      },
+      "title": "GetResponse carries a device"
+    },
+    "deviceLifecycleState": {
+      "type": "string",
+      "enum": [
+        "IN_SERVICE",
+        "PLANNED",
+        "PROVISIONING",
+        "MAINTENANCE",
+        "DECOMMISSIONED"
+      ],
+      "default": "IN_SERVICE",
+      "description": "- IN_SERVICE: IN_SERVICE indicates the device is in service; devices added without a lifecycle state are in service\n - PLANNED: PLANNED indicates the device is planned but not yet deployed\n - PROVISIONING: PROVISIONING indicates the device is deployed and being provisioned\n - MAINTENANCE: MAINTENANCE indicates the device is temporarily out of service for maintenance\n - DECOMMISSIONED: DECOMMISSIONED indicates the device is permanently out of service",
+      "title": "LifecycleState is the administrative lifecycle state of a device"
+    },
+    "deviceListDeviceTypesResponse": {
+      "type": "object",
+      "properties": {
+        "deviceTypes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deviceDeviceType"
+          },
+          "title": "deviceTypes are the device types"
+        }
+      },
+      "title": "ListDeviceTypesResponse carries all device types in the catalog"
+    },
+    "deviceListResponse": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/deviceListResponseType",
+          "title": "type is the type of the event"
+        },
+        "device": {
+          "$ref": "#/definitions/deviceDevice",
+          "title": "device is the device on which the event occurred"
+        }
+      },
+      "title": "ListResponse carries a single device event"
+    },
+    "deviceListResponseType": {
+      "type": "string",
+      "enum": [
+        "NONE",
+        "ADDED",
+        "UPDATED",
+        "REMOVED"
+      ],
+      "default": "NONE",
+      "description": "- NONE: NONE indicates this response does not represent a state change\n - ADDED: ADDED is an event which occurs when a device is added to the topology\n - UPDATED: UPDATED is an event which occurs when a device is updated\n - REMOVED: REMOVED is an event which occurs when a device is removed from the topology",
+      "title": "Device event type"
+    },
+    "deviceP4RuntimeConfig": {
+      "type": "object",
+      "properties": {
+        "deviceId": {
+          "type": "string",
+          "format": "uint64",
+          "title": "deviceId is the P4Runtime device ID of the device"
+        },
+        "electionId": {
+          "type": "string",
+          "format": "uint64",
+          "title": "electionId is the election ID with which to arbitrate for mastership of the device"
+        }
+      },
+      "title": "P4RuntimeConfig is the configuration of a P4Runtime endpoint"
+    },
+    "deviceProtocol": {
+      "type": "string",
+      "enum": [
+        "UNKNOWN_PROTOCOL",
+        "GNMI",
+        "P4RUNTIME",
+        "GNOI"
+      ],
+      "default": "UNKNOWN_PROTOCOL",
+      "description": "- UNKNOWN_PROTOCOL: UNKNOWN_PROTOCOL constant needed to go around proto3 nullifying the 0 values\n - GNMI: GNMI protocol reference\n - P4RUNTIME: P4RUNTIME protocol reference\n - GNOI: GNOI protocol reference",
+      "title": "Protocol to interact with a device"
+    },
+    "deviceProtocolState": {
+      "type": "object",
+      "properties": {
+        "protocol": {
+          "$ref": "#/definitions/deviceProtocol",
+          "title": "The protocol to which state relates"
+        },
+        "connectivityState": {
+          "$ref": "#/definitions/deviceConnectivityState",
+          "title": "ConnectivityState contains the L3 connectivity information"
+        },
+        "channelState": {
+          "$ref": "#/definitions/deviceChannelState",
+          "title": "ChannelState relates to the availability of the gRPC channel"
+        },
+        "serviceState": {
+          "$ref": "#/definitions/deviceServiceState",
+          "title": "ServiceState indicates the availability of the gRPC servic on top of the channel"
+        },
+        "lastStateChange": {
+          "type": "string",
+          "format": "date-time",
+          "title": "lastStateChange is the time at which the channel or service state last changed"
+        },
+        "lastError": {
+          "type": "string",
+          "title": "lastError is the error returned by the last failed channel or service probe"
+        }
+      },
+      "title": "ProtocolState contains information related to service and connectivity to a device"
+    },
+    "deviceRemoveDeviceTypeResponse": {
+      "type": "object",
+      "title": "RemoveDeviceTypeResponse is sent in response to a RemoveDeviceTypeRequest"
+    },
+    "deviceRemoveResponse": {
+      "type": "object",
+      "title": "RemoveResponse is sent in response to a RemoveDeviceRequest"
+    },
+    "deviceServiceState": {
+      "type": "string",
+      "enum": [
+        "UNKNOWN_SERVICE_STATE",
+        "AVAILABLE",
+        "UNAVAILABLE",
+        "CONNECTING"
+      ],
+      "default": "UNKNOWN_SERVICE_STATE",
+      "description": "- UNKNOWN_SERVICE_STATE: UNKNOWN_SERVICE_STATE constant needed to go around proto3 nullifying the 0 values\n - AVAILABLE: AVAILABLE indicates the corresponding grpc service is available\n - UNAVAILABLE: UNAVAILABLE indicates the corresponding grpc service is not available\n - CONNECTING: CONNECTING indicates the corresponding protocol is in the connecting phase on this device",
+      "title": "ServiceState represents the state of the gRPC service (e.g. gNMI) to the device from the service container"
+    },
+    "deviceTlsConfig": {
+      "type": "object",
+      "properties": {
+        "caCert": {
+          "type": "string",
+          "title": "caCert is the name of the device's CA certificate"
+        },
+        "cert": {
+          "type": "string",
+          "title": "cert is the name of the device's certificate"
+        },
+        "key": {
+          "type": "string",
+          "title": "key is the name of the device's TLS key"
+        },
+        "plain": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "plain indicates whether to connect to the device over plaintext"
+        },
+        "insecure": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "insecure indicates whether to connect to the device with insecure communication"
+        }
+      },
+      "title": "Device TLS configuration"
+    },
+    "deviceUpdateDeviceTypeResponse": {
+      "type": "object",
+      "properties": {
+        "deviceType": {
+          "$ref": "#/definitions/deviceDeviceType",
+          "title": "deviceType is the device type with updated revision"
+        }
+      },
+      "title": "UpdateDeviceTypeResponse is sent in response to an UpdateDeviceTypeRequest"
+    },
+    "deviceUpdateResponse": {
+      "type": "object",
+      "properties": {
+        "device": {
+          "$ref": "#/definitions/deviceDevice",
+          "title": "device is the device with updated revision"
+        }
+      },
+      "title": "UpdateResponse is sent in response to an UpdateDeviceRequest"
+    },
+    "protobufAny": {
+      "type": "object",
+      "properties": {
+        "type_url": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "runtimeStreamError": {
+      "type": "object",
+      "properties": {
+        "grpc_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "http_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "http_status": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          }
+        }
+      }
+    }
+  },
+  "x-stream-definitions": {
+    "deviceListResponse": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "$ref": "#/definitions/deviceListResponse"
+        },
+        "error": {
+          "$ref": "#/definitions/runtimeStreamError"
+        }
+      },
+      "title": "Stream result of deviceListResponse"
+    }
+  }
+}
+`
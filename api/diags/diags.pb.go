@@ -1,37 +1,1439 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: api/diags/diags.proto
 
+// Package topo.diags defines interfaces for obtaining diagnostic information about the topology service.
+
 package diags
 
-import (
-	context "context"
-	fmt "fmt"
-	proto "github.com/gogo/protobuf/proto"
-	grpc "google.golang.org/grpc"
-	math "math"
-)
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	device "github.com/onosproject/onos-topo/api/device"
+	github_com_onosproject_onos_topo_api_device "github.com/onosproject/onos-topo/api/device"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// SessionState is the state of a store session
+type SessionState int32
+
+const (
+	// UNKNOWN_SESSION_STATE indicates the store has not been used by the serving replica
+	SessionState_UNKNOWN_SESSION_STATE SessionState = 0
+	// ACTIVE indicates the store is responding to operations
+	SessionState_ACTIVE SessionState = 1
+	// SUSPENDED indicates the store is not responding but the session timeout has not yet elapsed
+	// since the store last responded
+	SessionState_SUSPENDED SessionState = 2
+	// EXPIRED indicates the store has not responded for longer than the session timeout
+	SessionState_EXPIRED SessionState = 3
+)
+
+var SessionState_name = map[int32]string{
+	0: "UNKNOWN_SESSION_STATE",
+	1: "ACTIVE",
+	2: "SUSPENDED",
+	3: "EXPIRED",
+}
+
+var SessionState_value = map[string]int32{
+	"UNKNOWN_SESSION_STATE": 0,
+	"ACTIVE":                1,
+	"SUSPENDED":             2,
+	"EXPIRED":               3,
+}
+
+func (x SessionState) String() string {
+	return proto.EnumName(SessionState_name, int32(x))
+}
+
+func (SessionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{0}
+}
+
+// GetServiceInfoRequest requests information describing the running service
+type GetServiceInfoRequest struct {
+}
+
+func (m *GetServiceInfoRequest) Reset()         { *m = GetServiceInfoRequest{} }
+func (m *GetServiceInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServiceInfoRequest) ProtoMessage()    {}
+func (*GetServiceInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{0}
+}
+func (m *GetServiceInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetServiceInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServiceInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetServiceInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceInfoRequest.Merge(m, src)
+}
+func (m *GetServiceInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetServiceInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceInfoRequest proto.InternalMessageInfo
+
+// GetServiceInfoResponse describes the running service
+type GetServiceInfoResponse struct {
+	// version is the service version
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// apiVersion is the version of the topology API served, e.g. v1
+	ApiVersion string `protobuf:"bytes,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	// build is the build information of the service binary
+	Build BuildInfo `protobuf:"bytes,3,opt,name=build,proto3" json:"build"`
+	// services is the list of fully qualified gRPC services enabled on the server
+	Services []string `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	// store describes the store backend
+	Store StoreInfo `protobuf:"bytes,5,opt,name=store,proto3" json:"store"`
+}
+
+func (m *GetServiceInfoResponse) Reset()         { *m = GetServiceInfoResponse{} }
+func (m *GetServiceInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServiceInfoResponse) ProtoMessage()    {}
+func (*GetServiceInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{1}
+}
+func (m *GetServiceInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetServiceInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServiceInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetServiceInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceInfoResponse.Merge(m, src)
+}
+func (m *GetServiceInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetServiceInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceInfoResponse proto.InternalMessageInfo
+
+func (m *GetServiceInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetServiceInfoResponse) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *GetServiceInfoResponse) GetBuild() BuildInfo {
+	if m != nil {
+		return m.Build
+	}
+	return BuildInfo{}
+}
+
+func (m *GetServiceInfoResponse) GetServices() []string {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *GetServiceInfoResponse) GetStore() StoreInfo {
+	if m != nil {
+		return m.Store
+	}
+	return StoreInfo{}
+}
+
+// BuildInfo describes how the service binary was built
+type BuildInfo struct {
+	// commit is the git commit from which the binary was built
+	Commit string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// date is the date at which the binary was built
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// goVersion is the version of Go with which the binary was built
+	GoVersion string `protobuf:"bytes,3,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+}
+
+func (m *BuildInfo) Reset()         { *m = BuildInfo{} }
+func (m *BuildInfo) String() string { return proto.CompactTextString(m) }
+func (*BuildInfo) ProtoMessage()    {}
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{2}
+}
+func (m *BuildInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuildInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildInfo.Merge(m, src)
+}
+func (m *BuildInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildInfo proto.InternalMessageInfo
+
+func (m *BuildInfo) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *BuildInfo) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *BuildInfo) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
+// StoreInfo describes the store backend
+type StoreInfo struct {
+	// type is the type of the store, e.g. atomix
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// controller is the address of the store controller
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// group is the name of the partition group in which the topology is stored
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// sessionTimeout is the timeout of the store sessions after which the store expires a session
+	// that has not been kept alive
+	SessionTimeout time.Duration `protobuf:"bytes,4,opt,name=sessionTimeout,proto3,stdduration" json:"sessionTimeout"`
+}
+
+func (m *StoreInfo) Reset()         { *m = StoreInfo{} }
+func (m *StoreInfo) String() string { return proto.CompactTextString(m) }
+func (*StoreInfo) ProtoMessage()    {}
+func (*StoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{3}
+}
+func (m *StoreInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo.Merge(m, src)
+}
+func (m *StoreInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreInfo proto.InternalMessageInfo
+
+func (m *StoreInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StoreInfo) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *StoreInfo) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *StoreInfo) GetSessionTimeout() time.Duration {
+	if m != nil {
+		return m.SessionTimeout
+	}
+	return 0
+}
+
+// GetLeadershipRequest requests the leadership state of the onos-topo replicas
+type GetLeadershipRequest struct {
+}
+
+func (m *GetLeadershipRequest) Reset()         { *m = GetLeadershipRequest{} }
+func (m *GetLeadershipRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLeadershipRequest) ProtoMessage()    {}
+func (*GetLeadershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{4}
+}
+func (m *GetLeadershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLeadershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLeadershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLeadershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeadershipRequest.Merge(m, src)
+}
+func (m *GetLeadershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLeadershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeadershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeadershipRequest proto.InternalMessageInfo
+
+// GetLeadershipResponse carries the leadership state of the onos-topo replicas as seen by the serving replica
+type GetLeadershipResponse struct {
+	// id is the ID of the serving replica
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// term is the current election term
+	Term uint64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	// leader is the ID of the current leader
+	Leader string `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	// candidates is the list of IDs of the replicas participating in the election
+	Candidates []string `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// isLeader indicates whether the serving replica is the leader
+	IsLeader bool `protobuf:"varint,5,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	// partitioned indicates whether background work on devices is partitioned across all candidates
+	// rather than performed by the leader alone
+	Partitioned bool `protobuf:"varint,6,opt,name=partitioned,proto3" json:"partitioned,omitempty"`
+}
+
+func (m *GetLeadershipResponse) Reset()         { *m = GetLeadershipResponse{} }
+func (m *GetLeadershipResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLeadershipResponse) ProtoMessage()    {}
+func (*GetLeadershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{5}
+}
+func (m *GetLeadershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLeadershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLeadershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLeadershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeadershipResponse.Merge(m, src)
+}
+func (m *GetLeadershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLeadershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeadershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeadershipResponse proto.InternalMessageInfo
+
+func (m *GetLeadershipResponse) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GetLeadershipResponse) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *GetLeadershipResponse) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *GetLeadershipResponse) GetCandidates() []string {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *GetLeadershipResponse) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *GetLeadershipResponse) GetPartitioned() bool {
+	if m != nil {
+		return m.Partitioned
+	}
+	return false
+}
+
+// GetStoreStatusRequest requests the health of the store backend
+type GetStoreStatusRequest struct {
+}
+
+func (m *GetStoreStatusRequest) Reset()         { *m = GetStoreStatusRequest{} }
+func (m *GetStoreStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStoreStatusRequest) ProtoMessage()    {}
+func (*GetStoreStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{6}
+}
+func (m *GetStoreStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStoreStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStoreStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStoreStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStoreStatusRequest.Merge(m, src)
+}
+func (m *GetStoreStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStoreStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStoreStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStoreStatusRequest proto.InternalMessageInfo
+
+// GetStoreStatusResponse carries the health of the store backend as seen by the serving replica
+type GetStoreStatusResponse struct {
+	// store describes the store backend
+	Store StoreInfo `protobuf:"bytes,1,opt,name=store,proto3" json:"store"`
+	// healthy indicates whether the store responded to a probe issued by the request
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// error is the error returned by the probe if the store is not healthy
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// probeLatency is the time taken by the probe
+	ProbeLatency time.Duration `protobuf:"bytes,4,opt,name=probeLatency,proto3,stdduration" json:"probeLatency"`
+	// session is the status of the store session
+	Session SessionStatus `protobuf:"bytes,5,opt,name=session,proto3" json:"session"`
+	// primitives is the status of each store primitive, e.g. the devices map
+	Primitives []*PrimitiveStatus `protobuf:"bytes,6,rep,name=primitives,proto3" json:"primitives,omitempty"`
+}
+
+func (m *GetStoreStatusResponse) Reset()         { *m = GetStoreStatusResponse{} }
+func (m *GetStoreStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStoreStatusResponse) ProtoMessage()    {}
+func (*GetStoreStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{7}
+}
+func (m *GetStoreStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStoreStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStoreStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStoreStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStoreStatusResponse.Merge(m, src)
+}
+func (m *GetStoreStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStoreStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStoreStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStoreStatusResponse proto.InternalMessageInfo
+
+func (m *GetStoreStatusResponse) GetStore() StoreInfo {
+	if m != nil {
+		return m.Store
+	}
+	return StoreInfo{}
+}
+
+func (m *GetStoreStatusResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *GetStoreStatusResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *GetStoreStatusResponse) GetProbeLatency() time.Duration {
+	if m != nil {
+		return m.ProbeLatency
+	}
+	return 0
+}
+
+func (m *GetStoreStatusResponse) GetSession() SessionStatus {
+	if m != nil {
+		return m.Session
+	}
+	return SessionStatus{}
+}
+
+func (m *GetStoreStatusResponse) GetPrimitives() []*PrimitiveStatus {
+	if m != nil {
+		return m.Primitives
+	}
+	return nil
+}
+
+// SessionStatus is the status of a store session
+type SessionStatus struct {
+	// state is the state of the session
+	State SessionState `protobuf:"varint,1,opt,name=state,proto3,enum=topo.diags.SessionState" json:"state,omitempty"`
+	// lastActive is the time at which the store last responded to an operation
+	LastActive *time.Time `protobuf:"bytes,2,opt,name=lastActive,proto3,stdtime" json:"lastActive,omitempty"`
+}
+
+func (m *SessionStatus) Reset()         { *m = SessionStatus{} }
+func (m *SessionStatus) String() string { return proto.CompactTextString(m) }
+func (*SessionStatus) ProtoMessage()    {}
+func (*SessionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{8}
+}
+func (m *SessionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionStatus.Merge(m, src)
+}
+func (m *SessionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionStatus proto.InternalMessageInfo
+
+func (m *SessionStatus) GetState() SessionState {
+	if m != nil {
+		return m.State
+	}
+	return SessionState_UNKNOWN_SESSION_STATE
+}
+
+func (m *SessionStatus) GetLastActive() *time.Time {
+	if m != nil {
+		return m.LastActive
+	}
+	return nil
+}
+
+// PrimitiveStatus is the status of a store primitive
+type PrimitiveStatus struct {
+	// name is the name of the primitive, e.g. devices
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// operations is the number of operations performed on the primitive
+	Operations uint64 `protobuf:"varint,2,opt,name=operations,proto3" json:"operations,omitempty"`
+	// errors is the number of operations that failed
+	Errors uint64 `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	// conflicts is the number of operations rejected by an optimistic lock
+	Conflicts uint64 `protobuf:"varint,4,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// lastSuccess is the time of the last operation to succeed
+	LastSuccess *time.Time `protobuf:"bytes,5,opt,name=lastSuccess,proto3,stdtime" json:"lastSuccess,omitempty"`
+	// lastFailure is the time of the last operation to fail
+	LastFailure *time.Time `protobuf:"bytes,6,opt,name=lastFailure,proto3,stdtime" json:"lastFailure,omitempty"`
+	// lastError is the error returned by the last operation to fail
+	LastError string `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`
+}
+
+func (m *PrimitiveStatus) Reset()         { *m = PrimitiveStatus{} }
+func (m *PrimitiveStatus) String() string { return proto.CompactTextString(m) }
+func (*PrimitiveStatus) ProtoMessage()    {}
+func (*PrimitiveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{9}
+}
+func (m *PrimitiveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrimitiveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrimitiveStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrimitiveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimitiveStatus.Merge(m, src)
+}
+func (m *PrimitiveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrimitiveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimitiveStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimitiveStatus proto.InternalMessageInfo
+
+func (m *PrimitiveStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PrimitiveStatus) GetOperations() uint64 {
+	if m != nil {
+		return m.Operations
+	}
+	return 0
+}
+
+func (m *PrimitiveStatus) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *PrimitiveStatus) GetConflicts() uint64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+func (m *PrimitiveStatus) GetLastSuccess() *time.Time {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return nil
+}
+
+func (m *PrimitiveStatus) GetLastFailure() *time.Time {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
+func (m *PrimitiveStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+// GetDeviceCountsRequest requests the number of devices in the topology
+type GetDeviceCountsRequest struct {
+}
+
+func (m *GetDeviceCountsRequest) Reset()         { *m = GetDeviceCountsRequest{} }
+func (m *GetDeviceCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceCountsRequest) ProtoMessage()    {}
+func (*GetDeviceCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{10}
+}
+func (m *GetDeviceCountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDeviceCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDeviceCountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDeviceCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceCountsRequest.Merge(m, src)
+}
+func (m *GetDeviceCountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDeviceCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceCountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceCountsRequest proto.InternalMessageInfo
+
+// GetDeviceCountsResponse carries the number of devices in the topology
+type GetDeviceCountsResponse struct {
+	// total is the total number of devices
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// counts is the number of devices for each combination of namespace, type, role and state
+	Counts []*DeviceCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (m *GetDeviceCountsResponse) Reset()         { *m = GetDeviceCountsResponse{} }
+func (m *GetDeviceCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceCountsResponse) ProtoMessage()    {}
+func (*GetDeviceCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{11}
+}
+func (m *GetDeviceCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDeviceCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDeviceCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDeviceCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceCountsResponse.Merge(m, src)
+}
+func (m *GetDeviceCountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDeviceCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceCountsResponse proto.InternalMessageInfo
+
+func (m *GetDeviceCountsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetDeviceCountsResponse) GetCounts() []*DeviceCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+// DeviceCount is the number of devices of a namespace with a combination of type, role and state
+type DeviceCount struct {
+	// type is the device type
+	Type github_com_onosproject_onos_topo_api_device.Type `protobuf:"bytes,1,opt,name=type,proto3,casttype=github.com/onosproject/onos-topo/api/device.Type" json:"type,omitempty"`
+	// role is the device role
+	Role github_com_onosproject_onos_topo_api_device.Role `protobuf:"bytes,2,opt,name=role,proto3,casttype=github.com/onosproject/onos-topo/api/device.Role" json:"role,omitempty"`
+	// connectivityState is the connectivity state of the devices; a device is reachable if any
+	// of its protocols is reachable
+	ConnectivityState device.ConnectivityState `protobuf:"varint,3,opt,name=connectivityState,proto3,enum=topo.device.ConnectivityState" json:"connectivityState,omitempty"`
+	// lifecycleState is the lifecycle state of the devices
+	LifecycleState device.LifecycleState `protobuf:"varint,4,opt,name=lifecycleState,proto3,enum=topo.device.LifecycleState" json:"lifecycleState,omitempty"`
+	// count is the number of devices
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// namespace is the namespace of the devices
+	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *DeviceCount) Reset()         { *m = DeviceCount{} }
+func (m *DeviceCount) String() string { return proto.CompactTextString(m) }
+func (*DeviceCount) ProtoMessage()    {}
+func (*DeviceCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{12}
+}
+func (m *DeviceCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceCount.Merge(m, src)
+}
+func (m *DeviceCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceCount proto.InternalMessageInfo
+
+func (m *DeviceCount) GetType() github_com_onosproject_onos_topo_api_device.Type {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DeviceCount) GetRole() github_com_onosproject_onos_topo_api_device.Role {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *DeviceCount) GetConnectivityState() device.ConnectivityState {
+	if m != nil {
+		return m.ConnectivityState
+	}
+	return device.ConnectivityState_UNKNOWN_CONNECTIVITY_STATE
+}
+
+func (m *DeviceCount) GetLifecycleState() device.LifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return device.LifecycleState_IN_SERVICE
+}
+
+func (m *DeviceCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DeviceCount) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// ListSubscribersRequest requests the active device List subscribers
+type ListSubscribersRequest struct {
+}
+
+func (m *ListSubscribersRequest) Reset()         { *m = ListSubscribersRequest{} }
+func (m *ListSubscribersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscribersRequest) ProtoMessage()    {}
+func (*ListSubscribersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{13}
+}
+func (m *ListSubscribersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSubscribersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSubscribersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSubscribersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscribersRequest.Merge(m, src)
+}
+func (m *ListSubscribersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSubscribersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscribersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscribersRequest proto.InternalMessageInfo
+
+// ListSubscribersResponse carries the active device List subscribers of the serving replica
+type ListSubscribersResponse struct {
+	// subscribers is the list of subscribers ordered by start time
+	Subscribers []*Subscriber `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+}
+
+func (m *ListSubscribersResponse) Reset()         { *m = ListSubscribersResponse{} }
+func (m *ListSubscribersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscribersResponse) ProtoMessage()    {}
+func (*ListSubscribersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{14}
+}
+func (m *ListSubscribersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSubscribersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSubscribersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSubscribersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscribersResponse.Merge(m, src)
+}
+func (m *ListSubscribersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSubscribersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscribersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscribersResponse proto.InternalMessageInfo
+
+func (m *ListSubscribersResponse) GetSubscribers() []*Subscriber {
+	if m != nil {
+		return m.Subscribers
+	}
+	return nil
+}
+
+// Subscriber describes an active device List subscription
+type Subscriber struct {
+	// id is the identifier of the subscription, unique within the serving replica
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// client identifies the subscribed client by its address, TLS certificate common name and user agent
+	Client string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	// filter is the request with which the subscription was opened
+	Filter *device.ListRequest `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// started is the time at which the subscription was opened
+	Started time.Time `protobuf:"bytes,4,opt,name=started,proto3,stdtime" json:"started"`
+	// eventsSent is the number of events sent to the subscriber
+	EventsSent uint64 `protobuf:"varint,5,opt,name=eventsSent,proto3" json:"eventsSent,omitempty"`
+	// lastEvent is the time at which the last event was sent to the subscriber
+	LastEvent *time.Time `protobuf:"bytes,6,opt,name=lastEvent,proto3,stdtime" json:"lastEvent,omitempty"`
+	// queueDepth is the number of events waiting to be sent to the subscriber
+	QueueDepth uint32 `protobuf:"varint,7,opt,name=queueDepth,proto3" json:"queueDepth,omitempty"`
+	// queueCapacity is the number of events that may wait to be sent before the store is blocked
+	QueueCapacity uint32 `protobuf:"varint,8,opt,name=queueCapacity,proto3" json:"queueCapacity,omitempty"`
+}
+
+func (m *Subscriber) Reset()         { *m = Subscriber{} }
+func (m *Subscriber) String() string { return proto.CompactTextString(m) }
+func (*Subscriber) ProtoMessage()    {}
+func (*Subscriber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{15}
+}
+func (m *Subscriber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscriber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscriber.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscriber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscriber.Merge(m, src)
+}
+func (m *Subscriber) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscriber) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscriber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscriber proto.InternalMessageInfo
+
+func (m *Subscriber) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Subscriber) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *Subscriber) GetFilter() *device.ListRequest {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *Subscriber) GetStarted() time.Time {
+	if m != nil {
+		return m.Started
+	}
+	return time.Time{}
+}
+
+func (m *Subscriber) GetEventsSent() uint64 {
+	if m != nil {
+		return m.EventsSent
+	}
+	return 0
+}
+
+func (m *Subscriber) GetLastEvent() *time.Time {
+	if m != nil {
+		return m.LastEvent
+	}
+	return nil
+}
+
+func (m *Subscriber) GetQueueDepth() uint32 {
+	if m != nil {
+		return m.QueueDepth
+	}
+	return 0
+}
+
+func (m *Subscriber) GetQueueCapacity() uint32 {
+	if m != nil {
+		return m.QueueCapacity
+	}
+	return 0
+}
+
+// GetLatencyRequest requests RPC latency summaries
+type GetLatencyRequest struct {
+}
+
+func (m *GetLatencyRequest) Reset()         { *m = GetLatencyRequest{} }
+func (m *GetLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLatencyRequest) ProtoMessage()    {}
+func (*GetLatencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{16}
+}
+func (m *GetLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatencyRequest.Merge(m, src)
+}
+func (m *GetLatencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLatencyRequest proto.InternalMessageInfo
+
+// GetLatencyResponse carries RPC latency summaries of the serving replica
+type GetLatencyResponse struct {
+	// methods is the list of latency summaries ordered by service and method
+	Methods []*MethodLatency `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (m *GetLatencyResponse) Reset()         { *m = GetLatencyResponse{} }
+func (m *GetLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLatencyResponse) ProtoMessage()    {}
+func (*GetLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{17}
+}
+func (m *GetLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatencyResponse.Merge(m, src)
+}
+func (m *GetLatencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLatencyResponse proto.InternalMessageInfo
+
+func (m *GetLatencyResponse) GetMethods() []*MethodLatency {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+// MethodLatency summarizes the latency of an RPC method. The latency of a streaming RPC is the
+// lifetime of the stream. Percentiles are computed over the most recent calls.
+type MethodLatency struct {
+	// service is the fully qualified gRPC service name
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// method is the method name
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// count is the number of calls
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// errors is the number of calls that returned an error
+	Errors uint64 `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	// mean is the mean latency of all calls
+	Mean time.Duration `protobuf:"bytes,5,opt,name=mean,proto3,stdduration" json:"mean"`
+	// p50 is the median latency of recent calls
+	P50 time.Duration `protobuf:"bytes,6,opt,name=p50,proto3,stdduration" json:"p50"`
+	// p90 is the 90th percentile latency of recent calls
+	P90 time.Duration `protobuf:"bytes,7,opt,name=p90,proto3,stdduration" json:"p90"`
+	// p99 is the 99th percentile latency of recent calls
+	P99 time.Duration `protobuf:"bytes,8,opt,name=p99,proto3,stdduration" json:"p99"`
+	// max is the maximum latency of all calls
+	Max time.Duration `protobuf:"bytes,9,opt,name=max,proto3,stdduration" json:"max"`
+}
+
+func (m *MethodLatency) Reset()         { *m = MethodLatency{} }
+func (m *MethodLatency) String() string { return proto.CompactTextString(m) }
+func (*MethodLatency) ProtoMessage()    {}
+func (*MethodLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf204ae8da722ebe, []int{18}
+}
+func (m *MethodLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MethodLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MethodLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MethodLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodLatency.Merge(m, src)
+}
+func (m *MethodLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *MethodLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodLatency proto.InternalMessageInfo
+
+func (m *MethodLatency) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *MethodLatency) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MethodLatency) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MethodLatency) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
+func (m *MethodLatency) GetMean() time.Duration {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+func (m *MethodLatency) GetP50() time.Duration {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *MethodLatency) GetP90() time.Duration {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *MethodLatency) GetP99() time.Duration {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+func (m *MethodLatency) GetMax() time.Duration {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("topo.diags.SessionState", SessionState_name, SessionState_value)
+	proto.RegisterType((*GetServiceInfoRequest)(nil), "topo.diags.GetServiceInfoRequest")
+	proto.RegisterType((*GetServiceInfoResponse)(nil), "topo.diags.GetServiceInfoResponse")
+	proto.RegisterType((*BuildInfo)(nil), "topo.diags.BuildInfo")
+	proto.RegisterType((*StoreInfo)(nil), "topo.diags.StoreInfo")
+	proto.RegisterType((*GetLeadershipRequest)(nil), "topo.diags.GetLeadershipRequest")
+	proto.RegisterType((*GetLeadershipResponse)(nil), "topo.diags.GetLeadershipResponse")
+	proto.RegisterType((*GetStoreStatusRequest)(nil), "topo.diags.GetStoreStatusRequest")
+	proto.RegisterType((*GetStoreStatusResponse)(nil), "topo.diags.GetStoreStatusResponse")
+	proto.RegisterType((*SessionStatus)(nil), "topo.diags.SessionStatus")
+	proto.RegisterType((*PrimitiveStatus)(nil), "topo.diags.PrimitiveStatus")
+	proto.RegisterType((*GetDeviceCountsRequest)(nil), "topo.diags.GetDeviceCountsRequest")
+	proto.RegisterType((*GetDeviceCountsResponse)(nil), "topo.diags.GetDeviceCountsResponse")
+	proto.RegisterType((*DeviceCount)(nil), "topo.diags.DeviceCount")
+	proto.RegisterType((*ListSubscribersRequest)(nil), "topo.diags.ListSubscribersRequest")
+	proto.RegisterType((*ListSubscribersResponse)(nil), "topo.diags.ListSubscribersResponse")
+	proto.RegisterType((*Subscriber)(nil), "topo.diags.Subscriber")
+	proto.RegisterType((*GetLatencyRequest)(nil), "topo.diags.GetLatencyRequest")
+	proto.RegisterType((*GetLatencyResponse)(nil), "topo.diags.GetLatencyResponse")
+	proto.RegisterType((*MethodLatency)(nil), "topo.diags.MethodLatency")
+}
 
 func init() { proto.RegisterFile("api/diags/diags.proto", fileDescriptor_bf204ae8da722ebe) }
 
 var fileDescriptor_bf204ae8da722ebe = []byte{
-	// 95 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x2c, 0xc8, 0xd4,
-	0x4f, 0xc9, 0x4c, 0x4c, 0x2f, 0x86, 0x90, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x5c, 0x25,
-	0xf9, 0x05, 0xf9, 0x7a, 0x60, 0x11, 0x23, 0x6e, 0x2e, 0xce, 0x90, 0xfc, 0x82, 0x7c, 0x17, 0x10,
-	0xc7, 0x49, 0xe2, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0x92, 0xd8, 0xc0, 0x3a,
-	0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xe3, 0xe8, 0xac, 0x52, 0x00, 0x00, 0x00,
+	// 1395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x1b, 0x3b, 0x7e, 0x6e, 0xd2, 0x74, 0xc8, 0x9f, 0xad, 0x0b, 0x4e, 0xba, 0xe5,
+	0x10, 0x21, 0x61, 0x07, 0x97, 0x0a, 0x22, 0xa4, 0x8a, 0x3a, 0x36, 0x6d, 0xd4, 0x90, 0x96, 0xb5,
+	0xdb, 0x22, 0x2e, 0x65, 0xbd, 0x9e, 0x38, 0x83, 0xd6, 0x3b, 0xdb, 0x9d, 0xd9, 0xa8, 0x3e, 0x22,
+	0xf1, 0x01, 0x7a, 0xec, 0x15, 0xbe, 0x05, 0x12, 0x1f, 0xa0, 0xc7, 0x1e, 0x7a, 0xe0, 0x54, 0x50,
+	0x7a, 0xe1, 0x33, 0x70, 0x42, 0x33, 0x3b, 0xeb, 0xfd, 0xe3, 0x34, 0x24, 0x17, 0x7b, 0xde, 0xbf,
+	0xdf, 0xce, 0x7b, 0xef, 0x37, 0x6f, 0x06, 0x56, 0x6d, 0x9f, 0x34, 0x87, 0xc4, 0x1e, 0xb1, 0xe8,
+	0xb7, 0xe1, 0x07, 0x94, 0x53, 0x04, 0x9c, 0xfa, 0xb4, 0x21, 0x35, 0xb5, 0xfa, 0x88, 0xd2, 0x91,
+	0x8b, 0x9b, 0xd2, 0x32, 0x08, 0x0f, 0x9b, 0xc3, 0x30, 0xb0, 0x39, 0xa1, 0x5e, 0xe4, 0x5b, 0xdb,
+	0xc8, 0xdb, 0x39, 0x19, 0x63, 0xc6, 0xed, 0xb1, 0xaf, 0x1c, 0x56, 0x46, 0x74, 0x44, 0xe5, 0xb2,
+	0x29, 0x56, 0x4a, 0xbb, 0x2e, 0xbf, 0x8c, 0x8f, 0x89, 0x83, 0xd5, 0x5f, 0x64, 0x30, 0xd7, 0x61,
+	0xf5, 0x2e, 0xe6, 0x3d, 0x1c, 0x08, 0xdd, 0x9e, 0x77, 0x48, 0x2d, 0xfc, 0x2c, 0xc4, 0x8c, 0x9b,
+	0x6f, 0x34, 0x58, 0xcb, 0x5b, 0x98, 0x4f, 0x3d, 0x86, 0x91, 0x01, 0xe5, 0x63, 0x1c, 0x30, 0x42,
+	0x3d, 0x43, 0xdb, 0xd4, 0xb6, 0x2a, 0x56, 0x2c, 0xa2, 0x3a, 0x80, 0xed, 0x93, 0xc7, 0xca, 0x58,
+	0x90, 0xc6, 0x94, 0x06, 0x7d, 0x06, 0xf3, 0x83, 0x90, 0xb8, 0x43, 0xa3, 0xb8, 0xa9, 0x6d, 0x55,
+	0x5b, 0xab, 0x8d, 0x24, 0xf3, 0x46, 0x5b, 0x18, 0xc4, 0x77, 0xda, 0xfa, 0xab, 0xb7, 0x1b, 0x73,
+	0x56, 0xe4, 0x89, 0x6a, 0xb0, 0xc0, 0xa2, 0x3d, 0x30, 0x43, 0xdf, 0x2c, 0x6e, 0x55, 0xac, 0xa9,
+	0x2c, 0xe0, 0x18, 0xa7, 0x01, 0x36, 0xe6, 0x67, 0xe1, 0x7a, 0xc2, 0x90, 0x86, 0x93, 0x9e, 0xe6,
+	0x23, 0xa8, 0x4c, 0x3f, 0x84, 0xd6, 0xa0, 0xe4, 0xd0, 0xf1, 0x98, 0x70, 0x95, 0x87, 0x92, 0x10,
+	0x02, 0x7d, 0x68, 0x73, 0xac, 0x12, 0x90, 0x6b, 0xf4, 0x21, 0x54, 0x46, 0x34, 0xce, 0xac, 0x28,
+	0x0d, 0x89, 0xc2, 0xfc, 0x4d, 0x83, 0xca, 0xf4, 0x8b, 0x22, 0x9e, 0x4f, 0x7c, 0xac, 0x50, 0xe5,
+	0x5a, 0x94, 0xc6, 0xa1, 0x1e, 0x0f, 0xa8, 0xeb, 0xe2, 0x20, 0x2e, 0x4d, 0xa2, 0x41, 0x2b, 0x30,
+	0x3f, 0x0a, 0x68, 0xe8, 0x2b, 0xec, 0x48, 0x40, 0xf7, 0x61, 0x89, 0x61, 0x26, 0x3e, 0xd1, 0x27,
+	0x63, 0x4c, 0x43, 0x6e, 0xe8, 0x32, 0xd5, 0xab, 0x8d, 0x88, 0x07, 0x8d, 0x98, 0x07, 0x8d, 0x8e,
+	0xe2, 0x49, 0x7b, 0x41, 0xa4, 0xfb, 0xf2, 0xaf, 0x0d, 0xcd, 0xca, 0x85, 0x9a, 0x6b, 0xb0, 0x72,
+	0x17, 0xf3, 0x7d, 0x6c, 0x0f, 0x71, 0xc0, 0x8e, 0x88, 0x1f, 0xb7, 0xfa, 0x77, 0x0d, 0x56, 0x73,
+	0x06, 0xd5, 0xe9, 0x35, 0x28, 0x90, 0x61, 0x94, 0x46, 0xbb, 0x74, 0xf2, 0x76, 0xa3, 0xb0, 0xd7,
+	0xb1, 0x0a, 0x64, 0x28, 0x13, 0xc4, 0xc1, 0x58, 0xa6, 0xa1, 0x5b, 0x72, 0x2d, 0x8a, 0xe9, 0x4a,
+	0x04, 0x95, 0x81, 0x92, 0x64, 0xe2, 0xb6, 0x37, 0x24, 0xa2, 0x8a, 0x71, 0x0b, 0x53, 0x1a, 0xd1,
+	0x60, 0xc2, 0xa2, 0x6f, 0xcb, 0x3e, 0x2e, 0x58, 0x53, 0x19, 0x6d, 0x42, 0xd5, 0xb7, 0x03, 0x4e,
+	0x44, 0x62, 0x78, 0x68, 0x94, 0xa4, 0x39, 0xad, 0x8a, 0xf9, 0x2b, 0x4a, 0xdf, 0xe3, 0x36, 0x0f,
+	0x59, 0x9c, 0xd4, 0x1f, 0x05, 0x58, 0xcb, 0x5b, 0x54, 0x56, 0x53, 0xda, 0x68, 0xe7, 0xa5, 0x8d,
+	0xa0, 0xfc, 0x11, 0xb6, 0x5d, 0x7e, 0x34, 0x91, 0x39, 0x2f, 0x58, 0xb1, 0x28, 0xfa, 0x86, 0x83,
+	0x80, 0xc6, 0x59, 0x47, 0x02, 0xba, 0x0b, 0x97, 0xfc, 0x80, 0x0e, 0xf0, 0xbe, 0xcd, 0xb1, 0xe7,
+	0x4c, 0x2e, 0xd2, 0xb5, 0x4c, 0x20, 0xda, 0x81, 0xb2, 0xea, 0xa2, 0x22, 0xf9, 0xd5, 0xcc, 0x6e,
+	0x23, 0x53, 0x94, 0x9f, 0xda, 0x71, 0xec, 0x8f, 0xbe, 0x02, 0xf0, 0x03, 0x32, 0x26, 0x9c, 0x1c,
+	0x63, 0x66, 0x94, 0x36, 0x8b, 0x5b, 0xd5, 0xd6, 0xb5, 0x74, 0xf4, 0xc3, 0xd8, 0xaa, 0xea, 0x93,
+	0x72, 0x37, 0x7f, 0xd6, 0x60, 0x31, 0x83, 0x8e, 0x1a, 0xa2, 0x6a, 0x36, 0x8f, 0xaa, 0xb6, 0xd4,
+	0x32, 0xde, 0xb3, 0x0f, 0x6c, 0x45, 0x6e, 0xe8, 0x6b, 0x00, 0xd7, 0x66, 0xfc, 0x8e, 0x23, 0x00,
+	0x65, 0xd5, 0xaa, 0xad, 0xda, 0x4c, 0x01, 0xfa, 0xf1, 0xf8, 0x6a, 0xeb, 0x2f, 0x44, 0xf6, 0xa9,
+	0x18, 0xf3, 0xd7, 0x02, 0x5c, 0xce, 0xed, 0x51, 0x30, 0xcf, 0xb3, 0xc7, 0xd3, 0xa3, 0x25, 0xd6,
+	0x82, 0x61, 0xd4, 0xc7, 0x51, 0x21, 0x99, 0xe2, 0x64, 0x4a, 0x23, 0x98, 0x29, 0xbb, 0xc2, 0x64,
+	0x8f, 0x74, 0x4b, 0x49, 0xe2, 0x48, 0x3b, 0xd4, 0x3b, 0x74, 0x89, 0xc3, 0x99, 0xec, 0x90, 0x6e,
+	0x25, 0x0a, 0xd4, 0x86, 0xaa, 0xd8, 0x4b, 0x2f, 0x74, 0x1c, 0xcc, 0x98, 0x31, 0x7f, 0xce, 0x04,
+	0xd2, 0x41, 0x31, 0xc6, 0x37, 0x36, 0x71, 0xc3, 0x00, 0x1b, 0xa5, 0x8b, 0x60, 0xa8, 0x20, 0xb1,
+	0x4b, 0x21, 0x76, 0x25, 0xc9, 0xca, 0xd1, 0xe0, 0x99, 0x2a, 0x4c, 0x43, 0xb2, 0xbc, 0x23, 0x47,
+	0xfa, 0x2e, 0x0d, 0x3d, 0x3e, 0x3d, 0x00, 0x3f, 0xc2, 0xfa, 0x8c, 0x45, 0x1d, 0x80, 0x15, 0x98,
+	0xe7, 0x94, 0xdb, 0xae, 0xac, 0xa2, 0x6e, 0x45, 0x02, 0x6a, 0x8a, 0x69, 0x28, 0xfc, 0x8c, 0x82,
+	0xe4, 0xca, 0x7a, 0xba, 0xc3, 0x29, 0x1c, 0x4b, 0xb9, 0x99, 0xff, 0x14, 0xa0, 0x9a, 0xd2, 0xa3,
+	0x7b, 0xe9, 0xb1, 0xd7, 0xfe, 0xfc, 0xdf, 0xb7, 0x1b, 0xdb, 0x23, 0xc2, 0x8f, 0xc2, 0x41, 0xc3,
+	0xa1, 0xe3, 0x26, 0xf5, 0x28, 0xf3, 0x03, 0xfa, 0x13, 0x76, 0xb8, 0x5c, 0x7f, 0x2a, 0xd0, 0x9b,
+	0xc9, 0xbd, 0xd4, 0xe8, 0x4f, 0x7c, 0xac, 0x86, 0xe5, 0x3d, 0xd0, 0x03, 0xea, 0xaa, 0x01, 0x7c,
+	0x71, 0x24, 0x8b, 0xba, 0xd8, 0x92, 0x08, 0x68, 0x1f, 0xae, 0x38, 0xd4, 0xf3, 0xb0, 0x60, 0x14,
+	0xe1, 0x13, 0xc9, 0x50, 0x49, 0x83, 0xa5, 0x56, 0x5d, 0xe5, 0x17, 0xc5, 0xec, 0xe6, 0xbd, 0xac,
+	0xd9, 0x40, 0xb4, 0x0b, 0x4b, 0x2e, 0x39, 0xc4, 0xce, 0xc4, 0x71, 0x71, 0x04, 0xa5, 0x4b, 0xa8,
+	0x6b, 0x19, 0xa8, 0xfd, 0x8c, 0x8b, 0x95, 0x0b, 0x11, 0xd5, 0x97, 0x05, 0x94, 0x94, 0xd2, 0xad,
+	0x48, 0x10, 0x6d, 0x16, 0x64, 0x66, 0xbe, 0xed, 0x44, 0x44, 0xa9, 0x58, 0x89, 0x42, 0xb4, 0x79,
+	0x9f, 0x08, 0x5e, 0x0d, 0x98, 0x13, 0x90, 0x01, 0x0e, 0xa6, 0x6d, 0xee, 0xc1, 0xfa, 0x8c, 0x45,
+	0xb5, 0xf9, 0x4b, 0xa8, 0xb2, 0x44, 0x6d, 0x68, 0xb2, 0xab, 0x6b, 0x99, 0x73, 0x3b, 0x35, 0x5b,
+	0x69, 0x57, 0xf3, 0x4d, 0x01, 0x20, 0xb1, 0xa5, 0xae, 0x01, 0x3d, 0x73, 0x0d, 0x88, 0xfb, 0xd3,
+	0x25, 0xd8, 0xe3, 0xea, 0x3e, 0x53, 0x12, 0xda, 0x86, 0xd2, 0x21, 0x71, 0xb9, 0xba, 0x0a, 0xaa,
+	0x2d, 0x23, 0x57, 0x1e, 0xc6, 0xd5, 0xee, 0x2d, 0xe5, 0x87, 0x6e, 0x43, 0x99, 0x71, 0x3b, 0xe0,
+	0x78, 0x68, 0xe8, 0xff, 0x7b, 0x48, 0xe4, 0xac, 0x94, 0x07, 0x25, 0x0e, 0x12, 0x23, 0x00, 0x1f,
+	0x63, 0x8f, 0xb3, 0x1e, 0x9e, 0x16, 0x36, 0xa5, 0x41, 0xb7, 0xd5, 0x21, 0x12, 0x9a, 0x73, 0x1f,
+	0xc3, 0x24, 0x44, 0xe0, 0x3f, 0x0b, 0x71, 0x88, 0x3b, 0xd8, 0xe7, 0x47, 0xf2, 0x14, 0x2e, 0x5a,
+	0x29, 0x0d, 0xfa, 0x18, 0x16, 0xa5, 0xb4, 0x6b, 0xfb, 0xb6, 0x43, 0xf8, 0xc4, 0x58, 0x90, 0x2e,
+	0x59, 0xa5, 0xf9, 0x01, 0x5c, 0x11, 0xf7, 0x6c, 0x34, 0xda, 0xe3, 0x06, 0xee, 0x01, 0x4a, 0x2b,
+	0x55, 0xef, 0x6e, 0x42, 0x79, 0x8c, 0xf9, 0x11, 0x1d, 0xc6, 0x7d, 0xcb, 0xcc, 0xfd, 0x6f, 0xa5,
+	0x29, 0x8e, 0x89, 0x3d, 0xcd, 0x5f, 0x8a, 0xb0, 0x98, 0x31, 0x89, 0x7b, 0x4b, 0xbd, 0x96, 0xe2,
+	0xa7, 0x9a, 0x12, 0x45, 0xef, 0xa2, 0xb0, 0xb8, 0x77, 0x91, 0x94, 0xb0, 0xb3, 0x98, 0x66, 0x67,
+	0x32, 0x42, 0xf5, 0xcc, 0x08, 0xfd, 0x02, 0xf4, 0x31, 0xb6, 0x93, 0xbb, 0xe9, 0x1c, 0xf7, 0x9b,
+	0x0c, 0x40, 0xb7, 0xa0, 0xe8, 0xdf, 0xda, 0x36, 0x4a, 0xe7, 0x8f, 0x13, 0xfe, 0x32, 0x6c, 0x67,
+	0xdb, 0x28, 0x5f, 0x24, 0x6c, 0x47, 0x85, 0xed, 0x18, 0x0b, 0x17, 0x0a, 0xdb, 0x11, 0x61, 0x63,
+	0xfb, 0xb9, 0x51, 0xb9, 0x40, 0xd8, 0xd8, 0x7e, 0xfe, 0xc9, 0x77, 0x70, 0x29, 0x7d, 0x21, 0xa2,
+	0xab, 0xb0, 0xfa, 0xe8, 0xe0, 0xfe, 0xc1, 0x83, 0x27, 0x07, 0x4f, 0x7b, 0xdd, 0x5e, 0x6f, 0xef,
+	0xc1, 0xc1, 0xd3, 0x5e, 0xff, 0x4e, 0xbf, 0xbb, 0x3c, 0x87, 0x00, 0x4a, 0x77, 0x76, 0xfb, 0x7b,
+	0x8f, 0xbb, 0xcb, 0x1a, 0x5a, 0x84, 0x4a, 0xef, 0x51, 0xef, 0x61, 0xf7, 0xa0, 0xd3, 0xed, 0x2c,
+	0x17, 0x50, 0x15, 0xca, 0xdd, 0xef, 0x1f, 0xee, 0x59, 0xdd, 0xce, 0x72, 0xb1, 0xf5, 0x52, 0x87,
+	0x4a, 0x9f, 0xfa, 0xb4, 0x23, 0xda, 0x8f, 0x9e, 0xc0, 0x52, 0xf6, 0x69, 0x8e, 0xae, 0xa7, 0xd9,
+	0x71, 0xea, 0x83, 0xbe, 0x66, 0x9e, 0xe5, 0xa2, 0x58, 0xd7, 0x87, 0xc5, 0xcc, 0x43, 0x10, 0x6d,
+	0xe6, 0x82, 0x66, 0x1e, 0x8f, 0xb5, 0xeb, 0x67, 0x78, 0x28, 0x54, 0xb5, 0xdd, 0xe4, 0x25, 0x36,
+	0xbb, 0xdd, 0x99, 0xf7, 0x5b, 0xcd, 0x3c, 0xcb, 0x45, 0x01, 0xff, 0x00, 0x97, 0x73, 0x57, 0x1c,
+	0xca, 0x87, 0x9d, 0x72, 0x33, 0xd6, 0x6e, 0x9c, 0xe9, 0x93, 0x60, 0xe7, 0xe6, 0x6a, 0x16, 0xfb,
+	0xf4, 0x71, 0x5c, 0xbb, 0x71, 0xa6, 0x8f, 0xc2, 0xbe, 0x0f, 0x90, 0x1c, 0x79, 0xf4, 0x51, 0xbe,
+	0x82, 0x99, 0xf9, 0x50, 0xab, 0xbf, 0xcf, 0x1c, 0x81, 0xb5, 0x8d, 0x57, 0x27, 0x75, 0xed, 0xf5,
+	0x49, 0x5d, 0xfb, 0xfb, 0xa4, 0xae, 0xbd, 0x78, 0x57, 0x9f, 0x7b, 0xfd, 0xae, 0x3e, 0xf7, 0xe7,
+	0xbb, 0xfa, 0xdc, 0xa0, 0x24, 0x99, 0x7a, 0xf3, 0xbf, 0x01, 0x00, 0xe8, 0x12, 0x12, 0xc4, 0x77,
+	0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -46,6 +1448,20 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type TopoDiagsClient interface {
+	// GetServiceInfo returns the version, build information, enabled services and store backend of the service
+	GetServiceInfo(ctx context.Context, in *GetServiceInfoRequest, opts ...grpc.CallOption) (*GetServiceInfoResponse, error)
+	// GetLeadership returns the leadership state of the onos-topo replicas
+	GetLeadership(ctx context.Context, in *GetLeadershipRequest, opts ...grpc.CallOption) (*GetLeadershipResponse, error)
+	// GetStoreStatus probes the store backend and returns its health and the status of its session and primitives
+	GetStoreStatus(ctx context.Context, in *GetStoreStatusRequest, opts ...grpc.CallOption) (*GetStoreStatusResponse, error)
+	// GetDeviceCounts returns the number of devices by namespace, type, role and state
+	GetDeviceCounts(ctx context.Context, in *GetDeviceCountsRequest, opts ...grpc.CallOption) (*GetDeviceCountsResponse, error)
+	// ListSubscribers returns the active device List subscribers of the serving replica. ListSubscribers is
+	// restricted to administrators.
+	ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	// GetLatency returns RPC latency summaries of the serving replica. GetLatency is restricted to
+	// administrators.
+	GetLatency(ctx context.Context, in *GetLatencyRequest, opts ...grpc.CallOption) (*GetLatencyResponse, error)
 }
 
 type topoDiagsClient struct {
@@ -56,22 +1472,4392 @@ func NewTopoDiagsClient(cc *grpc.ClientConn) TopoDiagsClient {
 	return &topoDiagsClient{cc}
 }
 
+func (c *topoDiagsClient) GetServiceInfo(ctx context.Context, in *GetServiceInfoRequest, opts ...grpc.CallOption) (*GetServiceInfoResponse, error) {
+	out := new(GetServiceInfoResponse)
+	err := c.cc.Invoke(ctx, "/topo.diags.TopoDiags/GetServiceInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoDiagsClient) GetLeadership(ctx context.Context, in *GetLeadershipRequest, opts ...grpc.CallOption) (*GetLeadershipResponse, error) {
+	out := new(GetLeadershipResponse)
+	err := c.cc.Invoke(ctx, "/topo.diags.TopoDiags/GetLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoDiagsClient) GetStoreStatus(ctx context.Context, in *GetStoreStatusRequest, opts ...grpc.CallOption) (*GetStoreStatusResponse, error) {
+	out := new(GetStoreStatusResponse)
+	err := c.cc.Invoke(ctx, "/topo.diags.TopoDiags/GetStoreStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoDiagsClient) GetDeviceCounts(ctx context.Context, in *GetDeviceCountsRequest, opts ...grpc.CallOption) (*GetDeviceCountsResponse, error) {
+	out := new(GetDeviceCountsResponse)
+	err := c.cc.Invoke(ctx, "/topo.diags.TopoDiags/GetDeviceCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoDiagsClient) ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, "/topo.diags.TopoDiags/ListSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoDiagsClient) GetLatency(ctx context.Context, in *GetLatencyRequest, opts ...grpc.CallOption) (*GetLatencyResponse, error) {
+	out := new(GetLatencyResponse)
+	err := c.cc.Invoke(ctx, "/topo.diags.TopoDiags/GetLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TopoDiagsServer is the server API for TopoDiags service.
 type TopoDiagsServer interface {
+	// GetServiceInfo returns the version, build information, enabled services and store backend of the service
+	GetServiceInfo(context.Context, *GetServiceInfoRequest) (*GetServiceInfoResponse, error)
+	// GetLeadership returns the leadership state of the onos-topo replicas
+	GetLeadership(context.Context, *GetLeadershipRequest) (*GetLeadershipResponse, error)
+	// GetStoreStatus probes the store backend and returns its health and the status of its session and primitives
+	GetStoreStatus(context.Context, *GetStoreStatusRequest) (*GetStoreStatusResponse, error)
+	// GetDeviceCounts returns the number of devices by namespace, type, role and state
+	GetDeviceCounts(context.Context, *GetDeviceCountsRequest) (*GetDeviceCountsResponse, error)
+	// ListSubscribers returns the active device List subscribers of the serving replica. ListSubscribers is
+	// restricted to administrators.
+	ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	// GetLatency returns RPC latency summaries of the serving replica. GetLatency is restricted to
+	// administrators.
+	GetLatency(context.Context, *GetLatencyRequest) (*GetLatencyResponse, error)
 }
 
 // UnimplementedTopoDiagsServer can be embedded to have forward compatible implementations.
 type UnimplementedTopoDiagsServer struct {
 }
 
+func (*UnimplementedTopoDiagsServer) GetServiceInfo(ctx context.Context, req *GetServiceInfoRequest) (*GetServiceInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceInfo not implemented")
+}
+func (*UnimplementedTopoDiagsServer) GetLeadership(ctx context.Context, req *GetLeadershipRequest) (*GetLeadershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeadership not implemented")
+}
+func (*UnimplementedTopoDiagsServer) GetStoreStatus(ctx context.Context, req *GetStoreStatusRequest) (*GetStoreStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoreStatus not implemented")
+}
+func (*UnimplementedTopoDiagsServer) GetDeviceCounts(ctx context.Context, req *GetDeviceCountsRequest) (*GetDeviceCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceCounts not implemented")
+}
+func (*UnimplementedTopoDiagsServer) ListSubscribers(ctx context.Context, req *ListSubscribersRequest) (*ListSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribers not implemented")
+}
+func (*UnimplementedTopoDiagsServer) GetLatency(ctx context.Context, req *GetLatencyRequest) (*GetLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatency not implemented")
+}
+
 func RegisterTopoDiagsServer(s *grpc.Server, srv TopoDiagsServer) {
 	s.RegisterService(&_TopoDiags_serviceDesc, srv)
 }
 
+func _TopoDiags_GetServiceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoDiagsServer).GetServiceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.diags.TopoDiags/GetServiceInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoDiagsServer).GetServiceInfo(ctx, req.(*GetServiceInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoDiags_GetLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoDiagsServer).GetLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.diags.TopoDiags/GetLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoDiagsServer).GetLeadership(ctx, req.(*GetLeadershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoDiags_GetStoreStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoDiagsServer).GetStoreStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.diags.TopoDiags/GetStoreStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoDiagsServer).GetStoreStatus(ctx, req.(*GetStoreStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoDiags_GetDeviceCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoDiagsServer).GetDeviceCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.diags.TopoDiags/GetDeviceCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoDiagsServer).GetDeviceCounts(ctx, req.(*GetDeviceCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoDiags_ListSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoDiagsServer).ListSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.diags.TopoDiags/ListSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoDiagsServer).ListSubscribers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoDiags_GetLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoDiagsServer).GetLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.diags.TopoDiags/GetLatency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoDiagsServer).GetLatency(ctx, req.(*GetLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TopoDiags_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "topo.diags.TopoDiags",
 	HandlerType: (*TopoDiagsServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "api/diags/diags.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceInfo",
+			Handler:    _TopoDiags_GetServiceInfo_Handler,
+		},
+		{
+			MethodName: "GetLeadership",
+			Handler:    _TopoDiags_GetLeadership_Handler,
+		},
+		{
+			MethodName: "GetStoreStatus",
+			Handler:    _TopoDiags_GetStoreStatus_Handler,
+		},
+		{
+			MethodName: "GetDeviceCounts",
+			Handler:    _TopoDiags_GetDeviceCounts_Handler,
+		},
+		{
+			MethodName: "ListSubscribers",
+			Handler:    _TopoDiags_ListSubscribers_Handler,
+		},
+		{
+			MethodName: "GetLatency",
+			Handler:    _TopoDiags_GetLatency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/diags/diags.proto",
+}
+
+func (m *GetServiceInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetServiceInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Store.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDiags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Services[iNdEx])
+			copy(dAtA[i:], m.Services[iNdEx])
+			i = encodeVarintDiags(dAtA, i, uint64(len(m.Services[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDiags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ApiVersion) > 0 {
+		i -= len(m.ApiVersion)
+		copy(dAtA[i:], m.ApiVersion)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.ApiVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GoVersion) > 0 {
+		i -= len(m.GoVersion)
+		copy(dAtA[i:], m.GoVersion)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.GoVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SessionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SessionTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDiags(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLeadershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLeadershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLeadershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLeadershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLeadershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLeadershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partitioned {
+		i--
+		if m.Partitioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsLeader {
+		i--
+		if m.IsLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Candidates[iNdEx])
+			copy(dAtA[i:], m.Candidates[iNdEx])
+			i = encodeVarintDiags(dAtA, i, uint64(len(m.Candidates[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Term != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStoreStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStoreStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStoreStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStoreStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStoreStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStoreStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Primitives) > 0 {
+		for iNdEx := len(m.Primitives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Primitives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDiags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProbeLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProbeLatency):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDiags(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Store.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDiags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastActive != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastActive, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastActive):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintDiags(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrimitiveStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrimitiveStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrimitiveStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastFailure != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastFailure, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailure):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintDiags(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastSuccess != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSuccess, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSuccess):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintDiags(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Conflicts != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Conflicts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Errors != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Errors))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Operations != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Operations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDeviceCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDeviceCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDeviceCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDeviceCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDeviceCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDeviceCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeviceCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Count != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LifecycleState != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.LifecycleState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConnectivityState != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.ConnectivityState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSubscribersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSubscribersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSubscribersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSubscribersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSubscribersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSubscribersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscribers) > 0 {
+		for iNdEx := len(m.Subscribers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscribers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscriber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscriber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscriber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueueCapacity != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.QueueCapacity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.QueueDepth != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.QueueDepth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastEvent != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEvent, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEvent):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintDiags(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EventsSent != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.EventsSent))
+		i--
+		dAtA[i] = 0x28
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Started):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintDiags(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDiags(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDiags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MethodLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MethodLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MethodLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintDiags(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x4a
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.P99, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.P99):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintDiags(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x42
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.P90, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.P90):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintDiags(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.P50, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.P50):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintDiags(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Mean, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Mean):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintDiags(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if m.Errors != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Errors))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintDiags(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintDiags(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDiags(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDiags(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetServiceInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetServiceInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.ApiVersion)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = m.Build.Size()
+	n += 1 + l + sovDiags(uint64(l))
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			l = len(s)
+			n += 1 + l + sovDiags(uint64(l))
+		}
+	}
+	l = m.Store.Size()
+	n += 1 + l + sovDiags(uint64(l))
+	return n
+}
+
+func (m *BuildInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.GoVersion)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	return n
+}
+
+func (m *StoreInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SessionTimeout)
+	n += 1 + l + sovDiags(uint64(l))
+	return n
+}
+
+func (m *GetLeadershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLeadershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.Term != 0 {
+		n += 1 + sovDiags(uint64(m.Term))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if len(m.Candidates) > 0 {
+		for _, s := range m.Candidates {
+			l = len(s)
+			n += 1 + l + sovDiags(uint64(l))
+		}
+	}
+	if m.IsLeader {
+		n += 2
+	}
+	if m.Partitioned {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetStoreStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetStoreStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Store.Size()
+	n += 1 + l + sovDiags(uint64(l))
+	if m.Healthy {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProbeLatency)
+	n += 1 + l + sovDiags(uint64(l))
+	l = m.Session.Size()
+	n += 1 + l + sovDiags(uint64(l))
+	if len(m.Primitives) > 0 {
+		for _, e := range m.Primitives {
+			l = e.Size()
+			n += 1 + l + sovDiags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SessionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovDiags(uint64(m.State))
+	}
+	if m.LastActive != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastActive)
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	return n
 }
+
+func (m *PrimitiveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.Operations != 0 {
+		n += 1 + sovDiags(uint64(m.Operations))
+	}
+	if m.Errors != 0 {
+		n += 1 + sovDiags(uint64(m.Errors))
+	}
+	if m.Conflicts != 0 {
+		n += 1 + sovDiags(uint64(m.Conflicts))
+	}
+	if m.LastSuccess != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSuccess)
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.LastFailure != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailure)
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDeviceCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetDeviceCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovDiags(uint64(m.Total))
+	}
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovDiags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.ConnectivityState != 0 {
+		n += 1 + sovDiags(uint64(m.ConnectivityState))
+	}
+	if m.LifecycleState != 0 {
+		n += 1 + sovDiags(uint64(m.LifecycleState))
+	}
+	if m.Count != 0 {
+		n += 1 + sovDiags(uint64(m.Count))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	return n
+}
+
+func (m *ListSubscribersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListSubscribersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscribers) > 0 {
+		for _, e := range m.Subscribers {
+			l = e.Size()
+			n += 1 + l + sovDiags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Subscriber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovDiags(uint64(m.ID))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Started)
+	n += 1 + l + sovDiags(uint64(l))
+	if m.EventsSent != 0 {
+		n += 1 + sovDiags(uint64(m.EventsSent))
+	}
+	if m.LastEvent != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEvent)
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.QueueDepth != 0 {
+		n += 1 + sovDiags(uint64(m.QueueDepth))
+	}
+	if m.QueueCapacity != 0 {
+		n += 1 + sovDiags(uint64(m.QueueCapacity))
+	}
+	return n
+}
+
+func (m *GetLatencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLatencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovDiags(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MethodLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovDiags(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovDiags(uint64(m.Count))
+	}
+	if m.Errors != 0 {
+		n += 1 + sovDiags(uint64(m.Errors))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Mean)
+	n += 1 + l + sovDiags(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.P50)
+	n += 1 + l + sovDiags(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.P90)
+	n += 1 + l + sovDiags(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.P99)
+	n += 1 + l + sovDiags(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max)
+	n += 1 + l + sovDiags(uint64(l))
+	return n
+}
+
+func sovDiags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDiags(x uint64) (n int) {
+	return sovDiags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetServiceInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Build", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Build.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SessionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLeadershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLeadershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLeadershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLeadershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLeadershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLeadershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeader = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partitioned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStoreStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStoreStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStoreStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStoreStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStoreStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStoreStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ProbeLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primitives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Primitives = append(m.Primitives, &PrimitiveStatus{})
+			if err := m.Primitives[len(m.Primitives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= SessionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastActive == nil {
+				m.LastActive = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastActive, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrimitiveStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrimitiveStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrimitiveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			m.Operations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			m.Errors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			m.Conflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conflicts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSuccess == nil {
+				m.LastSuccess = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSuccess, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailure == nil {
+				m.LastFailure = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastFailure, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDeviceCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDeviceCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDeviceCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDeviceCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDeviceCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDeviceCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counts = append(m.Counts, &DeviceCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = github_com_onosproject_onos_topo_api_device.Type(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = github_com_onosproject_onos_topo_api_device.Role(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectivityState", wireType)
+			}
+			m.ConnectivityState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectivityState |= device.ConnectivityState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleState", wireType)
+			}
+			m.LifecycleState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleState |= device.LifecycleState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSubscribersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSubscribersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSubscribersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSubscribersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSubscribersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSubscribersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscribers = append(m.Subscribers, &Subscriber{})
+			if err := m.Subscribers[len(m.Subscribers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscriber) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscriber: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscriber: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &device.ListRequest{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Started, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsSent", wireType)
+			}
+			m.EventsSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastEvent == nil {
+				m.LastEvent = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEvent, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueDepth", wireType)
+			}
+			m.QueueDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueCapacity", wireType)
+			}
+			m.QueueCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueCapacity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, &MethodLatency{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MethodLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MethodLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MethodLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			m.Errors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Mean, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.P50, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.P90, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.P99, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDiags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Max, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDiags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDiags(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDiags
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDiags
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDiags
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthDiags
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDiags
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDiags(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthDiags
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDiags = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDiags   = fmt.Errorf("proto: integer overflow")
+)
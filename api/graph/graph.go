@@ -0,0 +1,40 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import "google.golang.org/grpc"
+
+// ID is a link ID
+type ID string
+
+// LinkServiceClientFactory : Default LinkServiceClient creation.
+var LinkServiceClientFactory = func(cc *grpc.ClientConn) LinkServiceClient {
+	return NewLinkServiceClient(cc)
+}
+
+// CreateLinkServiceClient creates and returns a new topo link client
+func CreateLinkServiceClient(cc *grpc.ClientConn) LinkServiceClient {
+	return LinkServiceClientFactory(cc)
+}
+
+// TopoGraphServiceClientFactory : Default TopoGraphServiceClient creation.
+var TopoGraphServiceClientFactory = func(cc *grpc.ClientConn) TopoGraphServiceClient {
+	return NewTopoGraphServiceClient(cc)
+}
+
+// CreateTopoGraphServiceClient creates and returns a new topo graph client
+func CreateTopoGraphServiceClient(cc *grpc.ClientConn) TopoGraphServiceClient {
+	return TopoGraphServiceClientFactory(cc)
+}
@@ -0,0 +1,6106 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/graph/graph.proto
+
+// Package topo.graph defines interfaces for managing the links between devices and for querying
+// the graph they form.
+
+package graph
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/onosproject/onos-topo/api/device"
+	github_com_onosproject_onos_topo_api_device "github.com/onosproject/onos-topo/api/device"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Link event type
+type ListLinksResponse_Type int32
+
+const (
+	// NONE indicates this response does not represent a state change
+	ListLinksResponse_NONE ListLinksResponse_Type = 0
+	// ADDED is an event which occurs when a link is added to the topology
+	ListLinksResponse_ADDED ListLinksResponse_Type = 1
+	// UPDATED is an event which occurs when a link is updated
+	ListLinksResponse_UPDATED ListLinksResponse_Type = 2
+	// REMOVED is an event which occurs when a link is removed from the topology
+	ListLinksResponse_REMOVED ListLinksResponse_Type = 3
+)
+
+var ListLinksResponse_Type_name = map[int32]string{
+	0: "NONE",
+	1: "ADDED",
+	2: "UPDATED",
+	3: "REMOVED",
+}
+
+var ListLinksResponse_Type_value = map[string]int32{
+	"NONE":    0,
+	"ADDED":   1,
+	"UPDATED": 2,
+	"REMOVED": 3,
+}
+
+func (x ListLinksResponse_Type) String() string {
+	return proto.EnumName(ListLinksResponse_Type_name, int32(x))
+}
+
+func (ListLinksResponse_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{8, 0}
+}
+
+// Link is a link between two devices
+type Link struct {
+	// id is a link identifier, unique within the namespace of the link
+	ID ID `protobuf:"bytes,1,opt,name=id,proto3,casttype=ID" json:"id,omitempty"`
+	// revision is the revision of the link
+	Revision github_com_onosproject_onos_topo_api_device.Revision `protobuf:"varint,2,opt,name=revision,proto3,casttype=github.com/onosproject/onos-topo/api/device.Revision" json:"revision,omitempty"`
+	// source is the ID of the device at the source of the link
+	Source github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,3,opt,name=source,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"source,omitempty"`
+	// sourcePort is the port of the link on the source device
+	SourcePort string `protobuf:"bytes,4,opt,name=sourcePort,proto3" json:"sourcePort,omitempty"`
+	// target is the ID of the device at the target of the link
+	Target github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,5,opt,name=target,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"target,omitempty"`
+	// targetPort is the port of the link on the target device
+	TargetPort string `protobuf:"bytes,6,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	// directed indicates the link may only be traversed from its source to its target; links may be
+	// traversed in both directions otherwise
+	Directed bool `protobuf:"varint,7,opt,name=directed,proto3" json:"directed,omitempty"`
+	// weight is the cost of traversing the link; links with no weight have a weight of 1
+	Weight uint32 `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	// attributes is an arbitrary mapping of attribute keys/values
+	Attributes map[string]string `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// namespace is the namespace of the link and of the devices it connects, set by the service from
+	// the namespace of the request
+	Namespace string `protobuf:"bytes,10,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *Link) Reset()         { *m = Link{} }
+func (m *Link) String() string { return proto.CompactTextString(m) }
+func (*Link) ProtoMessage()    {}
+func (*Link) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{0}
+}
+func (m *Link) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Link) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Link.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Link) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Link.Merge(m, src)
+}
+func (m *Link) XXX_Size() int {
+	return m.Size()
+}
+func (m *Link) XXX_DiscardUnknown() {
+	xxx_messageInfo_Link.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Link proto.InternalMessageInfo
+
+func (m *Link) GetID() ID {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Link) GetRevision() github_com_onosproject_onos_topo_api_device.Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Link) GetSource() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Link) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *Link) GetTarget() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Link) GetTargetPort() string {
+	if m != nil {
+		return m.TargetPort
+	}
+	return ""
+}
+
+func (m *Link) GetDirected() bool {
+	if m != nil {
+		return m.Directed
+	}
+	return false
+}
+
+func (m *Link) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Link) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Link) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// AddLinkRequest adds a link to the topology
+type AddLinkRequest struct {
+	// link is the link to add
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *AddLinkRequest) Reset()         { *m = AddLinkRequest{} }
+func (m *AddLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*AddLinkRequest) ProtoMessage()    {}
+func (*AddLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{1}
+}
+func (m *AddLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddLinkRequest.Merge(m, src)
+}
+func (m *AddLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddLinkRequest proto.InternalMessageInfo
+
+func (m *AddLinkRequest) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// AddLinkResponse is sent in response to an AddLinkRequest
+type AddLinkResponse struct {
+	// link is the link with a revision number
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *AddLinkResponse) Reset()         { *m = AddLinkResponse{} }
+func (m *AddLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*AddLinkResponse) ProtoMessage()    {}
+func (*AddLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{2}
+}
+func (m *AddLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddLinkResponse.Merge(m, src)
+}
+func (m *AddLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddLinkResponse proto.InternalMessageInfo
+
+func (m *AddLinkResponse) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// UpdateLinkRequest updates a link
+type UpdateLinkRequest struct {
+	// link is the updated link
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *UpdateLinkRequest) Reset()         { *m = UpdateLinkRequest{} }
+func (m *UpdateLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLinkRequest) ProtoMessage()    {}
+func (*UpdateLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{3}
+}
+func (m *UpdateLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLinkRequest.Merge(m, src)
+}
+func (m *UpdateLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLinkRequest proto.InternalMessageInfo
+
+func (m *UpdateLinkRequest) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// UpdateLinkResponse is sent in response to an UpdateLinkRequest
+type UpdateLinkResponse struct {
+	// link is the link with updated revision
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *UpdateLinkResponse) Reset()         { *m = UpdateLinkResponse{} }
+func (m *UpdateLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateLinkResponse) ProtoMessage()    {}
+func (*UpdateLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{4}
+}
+func (m *UpdateLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLinkResponse.Merge(m, src)
+}
+func (m *UpdateLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLinkResponse proto.InternalMessageInfo
+
+func (m *UpdateLinkResponse) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// GetLinkRequest gets a link by ID
+type GetLinkRequest struct {
+	// id is the ID of the link
+	ID ID `protobuf:"bytes,1,opt,name=id,proto3,casttype=ID" json:"id,omitempty"`
+}
+
+func (m *GetLinkRequest) Reset()         { *m = GetLinkRequest{} }
+func (m *GetLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLinkRequest) ProtoMessage()    {}
+func (*GetLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{5}
+}
+func (m *GetLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLinkRequest.Merge(m, src)
+}
+func (m *GetLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLinkRequest proto.InternalMessageInfo
+
+func (m *GetLinkRequest) GetID() ID {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+// GetLinkResponse carries a link
+type GetLinkResponse struct {
+	// link is the link object
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *GetLinkResponse) Reset()         { *m = GetLinkResponse{} }
+func (m *GetLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLinkResponse) ProtoMessage()    {}
+func (*GetLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{6}
+}
+func (m *GetLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLinkResponse.Merge(m, src)
+}
+func (m *GetLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLinkResponse proto.InternalMessageInfo
+
+func (m *GetLinkResponse) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// ListLinksRequest requests a stream of links and, if subscribe is true, of the changes that occur
+// after all links have been streamed
+type ListLinksRequest struct {
+	// subscribe indicates whether to subscribe to events that occur after all links have been streamed
+	Subscribe bool `protobuf:"varint,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+}
+
+func (m *ListLinksRequest) Reset()         { *m = ListLinksRequest{} }
+func (m *ListLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLinksRequest) ProtoMessage()    {}
+func (*ListLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{7}
+}
+func (m *ListLinksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListLinksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLinksRequest.Merge(m, src)
+}
+func (m *ListLinksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLinksRequest proto.InternalMessageInfo
+
+func (m *ListLinksRequest) GetSubscribe() bool {
+	if m != nil {
+		return m.Subscribe
+	}
+	return false
+}
+
+// ListLinksResponse carries a single link event
+type ListLinksResponse struct {
+	// type is the type of the event
+	Type ListLinksResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=topo.graph.ListLinksResponse_Type" json:"type,omitempty"`
+	// link is the link on which the event occurred
+	Link *Link `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *ListLinksResponse) Reset()         { *m = ListLinksResponse{} }
+func (m *ListLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLinksResponse) ProtoMessage()    {}
+func (*ListLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{8}
+}
+func (m *ListLinksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListLinksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLinksResponse.Merge(m, src)
+}
+func (m *ListLinksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLinksResponse proto.InternalMessageInfo
+
+func (m *ListLinksResponse) GetType() ListLinksResponse_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ListLinksResponse_NONE
+}
+
+func (m *ListLinksResponse) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// RemoveLinkRequest removes a link
+type RemoveLinkRequest struct {
+	// link is the link to remove
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (m *RemoveLinkRequest) Reset()         { *m = RemoveLinkRequest{} }
+func (m *RemoveLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveLinkRequest) ProtoMessage()    {}
+func (*RemoveLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{9}
+}
+func (m *RemoveLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLinkRequest.Merge(m, src)
+}
+func (m *RemoveLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveLinkRequest proto.InternalMessageInfo
+
+func (m *RemoveLinkRequest) GetLink() *Link {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+// RemoveLinkResponse is sent in response to a RemoveLinkRequest
+type RemoveLinkResponse struct {
+}
+
+func (m *RemoveLinkResponse) Reset()         { *m = RemoveLinkResponse{} }
+func (m *RemoveLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveLinkResponse) ProtoMessage()    {}
+func (*RemoveLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{10}
+}
+func (m *RemoveLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLinkResponse.Merge(m, src)
+}
+func (m *RemoveLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveLinkResponse proto.InternalMessageInfo
+
+// Constraints restrict the devices and links traversed by a graph query
+type Constraints struct {
+	// excludeDevices are the IDs of devices that may not be traversed
+	ExcludeDevices []github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,rep,name=excludeDevices,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"excludeDevices,omitempty"`
+	// excludeLinks are the IDs of links that may not be traversed
+	ExcludeLinks []ID `protobuf:"bytes,2,rep,name=excludeLinks,proto3,casttype=ID" json:"excludeLinks,omitempty"`
+	// linkAttributes are attributes a link must have, with the same values, to be traversed
+	LinkAttributes map[string]string `protobuf:"bytes,3,rep,name=linkAttributes,proto3" json:"linkAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Constraints) Reset()         { *m = Constraints{} }
+func (m *Constraints) String() string { return proto.CompactTextString(m) }
+func (*Constraints) ProtoMessage()    {}
+func (*Constraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{11}
+}
+func (m *Constraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Constraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Constraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Constraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Constraints.Merge(m, src)
+}
+func (m *Constraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *Constraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_Constraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Constraints proto.InternalMessageInfo
+
+func (m *Constraints) GetExcludeDevices() []github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.ExcludeDevices
+	}
+	return nil
+}
+
+func (m *Constraints) GetExcludeLinks() []ID {
+	if m != nil {
+		return m.ExcludeLinks
+	}
+	return nil
+}
+
+func (m *Constraints) GetLinkAttributes() map[string]string {
+	if m != nil {
+		return m.LinkAttributes
+	}
+	return nil
+}
+
+// Neighbor is a device found by a graph traversal
+type Neighbor struct {
+	// id is the ID of the device
+	ID github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"id,omitempty"`
+	// hops is the number of links between the device and the device from which the traversal started
+	Hops uint32 `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+}
+
+func (m *Neighbor) Reset()         { *m = Neighbor{} }
+func (m *Neighbor) String() string { return proto.CompactTextString(m) }
+func (*Neighbor) ProtoMessage()    {}
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{12}
+}
+func (m *Neighbor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Neighbor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Neighbor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Neighbor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Neighbor.Merge(m, src)
+}
+func (m *Neighbor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Neighbor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Neighbor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Neighbor proto.InternalMessageInfo
+
+func (m *Neighbor) GetID() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Neighbor) GetHops() uint32 {
+	if m != nil {
+		return m.Hops
+	}
+	return 0
+}
+
+// Path is a path between two devices
+type Path struct {
+	// devices are the IDs of the devices along the path, including its source and its target
+	Devices []github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,rep,name=devices,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"devices,omitempty"`
+	// links are the IDs of the links along the path
+	Links []ID `protobuf:"bytes,2,rep,name=links,proto3,casttype=ID" json:"links,omitempty"`
+	// cost is the sum of the weights of the links along the path
+	Cost uint64 `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (m *Path) Reset()         { *m = Path{} }
+func (m *Path) String() string { return proto.CompactTextString(m) }
+func (*Path) ProtoMessage()    {}
+func (*Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{13}
+}
+func (m *Path) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Path.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Path) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Path.Merge(m, src)
+}
+func (m *Path) XXX_Size() int {
+	return m.Size()
+}
+func (m *Path) XXX_DiscardUnknown() {
+	xxx_messageInfo_Path.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Path proto.InternalMessageInfo
+
+func (m *Path) GetDevices() []github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *Path) GetLinks() []ID {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *Path) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+// Component is a set of devices connected to each other regardless of the direction of links
+type Component struct {
+	// devices are the IDs of the devices in the component, in lexical order
+	Devices []github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,rep,name=devices,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"devices,omitempty"`
+}
+
+func (m *Component) Reset()         { *m = Component{} }
+func (m *Component) String() string { return proto.CompactTextString(m) }
+func (*Component) ProtoMessage()    {}
+func (*Component) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{14}
+}
+func (m *Component) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Component) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Component.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Component) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Component.Merge(m, src)
+}
+func (m *Component) XXX_Size() int {
+	return m.Size()
+}
+func (m *Component) XXX_DiscardUnknown() {
+	xxx_messageInfo_Component.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Component proto.InternalMessageInfo
+
+func (m *Component) GetDevices() []github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+// GetNeighborsRequest requests the neighbors of a device
+type GetNeighborsRequest struct {
+	// id is the ID of the device whose neighbors to get
+	ID github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"id,omitempty"`
+	// depth is the maximum number of hops between the device and its neighbors; defaults to 1
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// constraints restrict the traversed devices and links
+	Constraints *Constraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *GetNeighborsRequest) Reset()         { *m = GetNeighborsRequest{} }
+func (m *GetNeighborsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNeighborsRequest) ProtoMessage()    {}
+func (*GetNeighborsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{15}
+}
+func (m *GetNeighborsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNeighborsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNeighborsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetNeighborsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNeighborsRequest.Merge(m, src)
+}
+func (m *GetNeighborsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNeighborsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNeighborsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNeighborsRequest proto.InternalMessageInfo
+
+func (m *GetNeighborsRequest) GetID() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GetNeighborsRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *GetNeighborsRequest) GetConstraints() *Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// GetNeighborsResponse carries the neighbors of a device
+type GetNeighborsResponse struct {
+	// neighbors are the neighbors ordered by hops and then by ID
+	Neighbors []*Neighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+}
+
+func (m *GetNeighborsResponse) Reset()         { *m = GetNeighborsResponse{} }
+func (m *GetNeighborsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNeighborsResponse) ProtoMessage()    {}
+func (*GetNeighborsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{16}
+}
+func (m *GetNeighborsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNeighborsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNeighborsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetNeighborsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNeighborsResponse.Merge(m, src)
+}
+func (m *GetNeighborsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNeighborsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNeighborsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNeighborsResponse proto.InternalMessageInfo
+
+func (m *GetNeighborsResponse) GetNeighbors() []*Neighbor {
+	if m != nil {
+		return m.Neighbors
+	}
+	return nil
+}
+
+// GetShortestPathsRequest requests the shortest paths between two devices
+type GetShortestPathsRequest struct {
+	// source is the ID of the device at which paths start
+	Source github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,opt,name=source,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"source,omitempty"`
+	// target is the ID of the device at which paths end
+	Target github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,2,opt,name=target,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"target,omitempty"`
+	// constraints restrict the traversed devices and links
+	Constraints *Constraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// weightAttribute is the name of a numeric link attribute used as the weight of links in place of
+	// their weight; links without the attribute may not be traversed
+	WeightAttribute string `protobuf:"bytes,4,opt,name=weightAttribute,proto3" json:"weightAttribute,omitempty"`
+	// maxPaths is the maximum number of equal cost paths to return; defaults to 1
+	MaxPaths uint32 `protobuf:"varint,5,opt,name=maxPaths,proto3" json:"maxPaths,omitempty"`
+}
+
+func (m *GetShortestPathsRequest) Reset()         { *m = GetShortestPathsRequest{} }
+func (m *GetShortestPathsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShortestPathsRequest) ProtoMessage()    {}
+func (*GetShortestPathsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{17}
+}
+func (m *GetShortestPathsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShortestPathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShortestPathsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShortestPathsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShortestPathsRequest.Merge(m, src)
+}
+func (m *GetShortestPathsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShortestPathsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShortestPathsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShortestPathsRequest proto.InternalMessageInfo
+
+func (m *GetShortestPathsRequest) GetSource() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *GetShortestPathsRequest) GetTarget() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *GetShortestPathsRequest) GetConstraints() *Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+func (m *GetShortestPathsRequest) GetWeightAttribute() string {
+	if m != nil {
+		return m.WeightAttribute
+	}
+	return ""
+}
+
+func (m *GetShortestPathsRequest) GetMaxPaths() uint32 {
+	if m != nil {
+		return m.MaxPaths
+	}
+	return 0
+}
+
+// GetShortestPathsResponse carries the shortest paths between two devices
+type GetShortestPathsResponse struct {
+	// paths are the shortest paths; empty if the target cannot be reached
+	Paths []*Path `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (m *GetShortestPathsResponse) Reset()         { *m = GetShortestPathsResponse{} }
+func (m *GetShortestPathsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShortestPathsResponse) ProtoMessage()    {}
+func (*GetShortestPathsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{18}
+}
+func (m *GetShortestPathsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShortestPathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShortestPathsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShortestPathsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShortestPathsResponse.Merge(m, src)
+}
+func (m *GetShortestPathsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShortestPathsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShortestPathsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShortestPathsResponse proto.InternalMessageInfo
+
+func (m *GetShortestPathsResponse) GetPaths() []*Path {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+// GetComponentsRequest requests the connected components of the topology
+type GetComponentsRequest struct {
+	// constraints restrict the traversed devices and links
+	Constraints *Constraints `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *GetComponentsRequest) Reset()         { *m = GetComponentsRequest{} }
+func (m *GetComponentsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetComponentsRequest) ProtoMessage()    {}
+func (*GetComponentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{19}
+}
+func (m *GetComponentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetComponentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetComponentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetComponentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetComponentsRequest.Merge(m, src)
+}
+func (m *GetComponentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetComponentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetComponentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetComponentsRequest proto.InternalMessageInfo
+
+func (m *GetComponentsRequest) GetConstraints() *Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// GetComponentsResponse carries the connected components of the topology
+type GetComponentsResponse struct {
+	// components are the components ordered by decreasing size and then by their first device
+	Components []*Component `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (m *GetComponentsResponse) Reset()         { *m = GetComponentsResponse{} }
+func (m *GetComponentsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetComponentsResponse) ProtoMessage()    {}
+func (*GetComponentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{20}
+}
+func (m *GetComponentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetComponentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetComponentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetComponentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetComponentsResponse.Merge(m, src)
+}
+func (m *GetComponentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetComponentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetComponentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetComponentsResponse proto.InternalMessageInfo
+
+func (m *GetComponentsResponse) GetComponents() []*Component {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+// GetReachableRequest requests the devices reachable from a device
+type GetReachableRequest struct {
+	// root is the ID of the device from which to start
+	Root github_com_onosproject_onos_topo_api_device.ID `protobuf:"bytes,1,opt,name=root,proto3,casttype=github.com/onosproject/onos-topo/api/device.ID" json:"root,omitempty"`
+	// constraints restrict the traversed devices and links
+	Constraints *Constraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *GetReachableRequest) Reset()         { *m = GetReachableRequest{} }
+func (m *GetReachableRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReachableRequest) ProtoMessage()    {}
+func (*GetReachableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{21}
+}
+func (m *GetReachableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReachableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReachableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReachableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReachableRequest.Merge(m, src)
+}
+func (m *GetReachableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReachableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReachableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReachableRequest proto.InternalMessageInfo
+
+func (m *GetReachableRequest) GetRoot() github_com_onosproject_onos_topo_api_device.ID {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *GetReachableRequest) GetConstraints() *Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// GetReachableResponse carries the devices reachable from a device
+type GetReachableResponse struct {
+	// devices are the reachable devices, excluding the root, ordered by hops and then by ID
+	Devices []*Neighbor `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (m *GetReachableResponse) Reset()         { *m = GetReachableResponse{} }
+func (m *GetReachableResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReachableResponse) ProtoMessage()    {}
+func (*GetReachableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0ae09f8e853c08c, []int{22}
+}
+func (m *GetReachableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReachableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReachableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReachableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReachableResponse.Merge(m, src)
+}
+func (m *GetReachableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReachableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReachableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReachableResponse proto.InternalMessageInfo
+
+func (m *GetReachableResponse) GetDevices() []*Neighbor {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("topo.graph.ListLinksResponse_Type", ListLinksResponse_Type_name, ListLinksResponse_Type_value)
+	proto.RegisterType((*Link)(nil), "topo.graph.Link")
+	proto.RegisterMapType((map[string]string)(nil), "topo.graph.Link.AttributesEntry")
+	proto.RegisterType((*AddLinkRequest)(nil), "topo.graph.AddLinkRequest")
+	proto.RegisterType((*AddLinkResponse)(nil), "topo.graph.AddLinkResponse")
+	proto.RegisterType((*UpdateLinkRequest)(nil), "topo.graph.UpdateLinkRequest")
+	proto.RegisterType((*UpdateLinkResponse)(nil), "topo.graph.UpdateLinkResponse")
+	proto.RegisterType((*GetLinkRequest)(nil), "topo.graph.GetLinkRequest")
+	proto.RegisterType((*GetLinkResponse)(nil), "topo.graph.GetLinkResponse")
+	proto.RegisterType((*ListLinksRequest)(nil), "topo.graph.ListLinksRequest")
+	proto.RegisterType((*ListLinksResponse)(nil), "topo.graph.ListLinksResponse")
+	proto.RegisterType((*RemoveLinkRequest)(nil), "topo.graph.RemoveLinkRequest")
+	proto.RegisterType((*RemoveLinkResponse)(nil), "topo.graph.RemoveLinkResponse")
+	proto.RegisterType((*Constraints)(nil), "topo.graph.Constraints")
+	proto.RegisterMapType((map[string]string)(nil), "topo.graph.Constraints.LinkAttributesEntry")
+	proto.RegisterType((*Neighbor)(nil), "topo.graph.Neighbor")
+	proto.RegisterType((*Path)(nil), "topo.graph.Path")
+	proto.RegisterType((*Component)(nil), "topo.graph.Component")
+	proto.RegisterType((*GetNeighborsRequest)(nil), "topo.graph.GetNeighborsRequest")
+	proto.RegisterType((*GetNeighborsResponse)(nil), "topo.graph.GetNeighborsResponse")
+	proto.RegisterType((*GetShortestPathsRequest)(nil), "topo.graph.GetShortestPathsRequest")
+	proto.RegisterType((*GetShortestPathsResponse)(nil), "topo.graph.GetShortestPathsResponse")
+	proto.RegisterType((*GetComponentsRequest)(nil), "topo.graph.GetComponentsRequest")
+	proto.RegisterType((*GetComponentsResponse)(nil), "topo.graph.GetComponentsResponse")
+	proto.RegisterType((*GetReachableRequest)(nil), "topo.graph.GetReachableRequest")
+	proto.RegisterType((*GetReachableResponse)(nil), "topo.graph.GetReachableResponse")
+}
+
+func init() { proto.RegisterFile("api/graph/graph.proto", fileDescriptor_c0ae09f8e853c08c) }
+
+var fileDescriptor_c0ae09f8e853c08c = []byte{
+	// 1163 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xae, 0xd7, 0xae, 0xfd, 0xdc, 0xa4, 0xee, 0xd4, 0xa5, 0xab, 0x6d, 0xeb, 0x98, 0xa5,
+	0x42, 0x16, 0x88, 0x4d, 0x65, 0x68, 0x69, 0x2b, 0x51, 0xe1, 0xd4, 0xae, 0x69, 0x15, 0xd2, 0x74,
+	0x92, 0x22, 0x81, 0xc4, 0x61, 0xbd, 0x3b, 0xb2, 0x97, 0x24, 0x3b, 0xcb, 0xee, 0x38, 0x34, 0x5f,
+	0x80, 0x33, 0x47, 0xbe, 0x00, 0x12, 0x17, 0x24, 0xc4, 0xa7, 0xe0, 0xd8, 0x23, 0xa7, 0x08, 0x25,
+	0x12, 0x37, 0xbe, 0x40, 0x4e, 0x68, 0x66, 0xd6, 0xeb, 0xdd, 0x4d, 0x1c, 0xe1, 0xa4, 0x17, 0x67,
+	0xf6, 0xcd, 0xef, 0xf7, 0xfe, 0xce, 0x9b, 0x79, 0x81, 0xeb, 0x76, 0xe0, 0xad, 0x0c, 0x43, 0x3b,
+	0x18, 0xc9, 0x5f, 0x2b, 0x08, 0x29, 0xa3, 0x08, 0x18, 0x0d, 0xa8, 0x25, 0x24, 0x46, 0x7d, 0x48,
+	0x87, 0x54, 0x88, 0x57, 0xf8, 0x4a, 0x22, 0x8c, 0x1b, 0x9c, 0xe8, 0x92, 0x3d, 0xcf, 0x21, 0xf1,
+	0x1f, 0xb9, 0x61, 0xfe, 0xa2, 0x81, 0xb6, 0xe6, 0xf9, 0xdb, 0xe8, 0x16, 0xa8, 0x9e, 0xab, 0x2b,
+	0x4d, 0xa5, 0x55, 0x59, 0xbd, 0x7c, 0x78, 0xb0, 0xac, 0x3e, 0xeb, 0x1e, 0x8b, 0x5f, 0xac, 0x7a,
+	0x2e, 0xda, 0x82, 0x72, 0x48, 0xf6, 0xbc, 0xc8, 0xa3, 0xbe, 0xae, 0x36, 0x95, 0x96, 0xb6, 0xfa,
+	0xe0, 0xf8, 0x60, 0xf9, 0x93, 0xa1, 0xc7, 0x46, 0xe3, 0x81, 0xe5, 0xd0, 0xdd, 0x15, 0xea, 0xd3,
+	0x28, 0x08, 0xe9, 0x77, 0xc4, 0x61, 0x62, 0xfd, 0x11, 0xf7, 0x69, 0x65, 0x6a, 0xd6, 0xc2, 0x31,
+	0x1f, 0x27, 0x9a, 0xd0, 0x73, 0x28, 0x45, 0x74, 0x1c, 0x3a, 0x44, 0x2f, 0x08, 0xbb, 0xed, 0xe3,
+	0x83, 0x65, 0x6b, 0x1e, 0x9d, 0xcf, 0xba, 0x38, 0xd6, 0x80, 0x1a, 0x00, 0x72, 0xb5, 0x41, 0x43,
+	0xa6, 0x6b, 0x5c, 0x1f, 0x4e, 0x49, 0xb8, 0x2d, 0x66, 0x87, 0x43, 0xc2, 0xf4, 0xe2, 0xf9, 0x6d,
+	0x49, 0x0d, 0xdc, 0x96, 0x5c, 0x09, 0x5b, 0x25, 0x69, 0x6b, 0x2a, 0x41, 0x06, 0x94, 0x5d, 0x2f,
+	0x24, 0x0e, 0x23, 0xae, 0x7e, 0xa9, 0xa9, 0xb4, 0xca, 0x38, 0xf9, 0x46, 0xef, 0x40, 0xe9, 0x07,
+	0xe2, 0x0d, 0x47, 0x4c, 0x2f, 0x37, 0x95, 0xd6, 0x22, 0x8e, 0xbf, 0xd0, 0xe7, 0x00, 0x36, 0x63,
+	0xa1, 0x37, 0x18, 0x33, 0x12, 0xe9, 0x95, 0x66, 0xa1, 0x55, 0x6d, 0x37, 0xad, 0x69, 0x61, 0x2d,
+	0x5e, 0x25, 0xab, 0x93, 0x40, 0x7a, 0x3e, 0x0b, 0xf7, 0x71, 0x8a, 0x83, 0x6e, 0x41, 0xc5, 0xb7,
+	0x77, 0x49, 0x14, 0xd8, 0x0e, 0xd1, 0x41, 0x38, 0x35, 0x15, 0x18, 0x9f, 0xc1, 0x95, 0x1c, 0x19,
+	0xd5, 0xa0, 0xb0, 0x4d, 0xf6, 0x65, 0xcd, 0x31, 0x5f, 0xa2, 0x3a, 0x14, 0xf7, 0xec, 0x9d, 0x31,
+	0x11, 0x35, 0xae, 0x60, 0xf9, 0xf1, 0x48, 0x7d, 0xa0, 0x98, 0xf7, 0x61, 0xa9, 0xe3, 0xba, 0xdc,
+	0x07, 0x4c, 0xbe, 0x1f, 0x93, 0x88, 0xa1, 0x3b, 0xa0, 0xed, 0x78, 0xfe, 0xb6, 0xa0, 0x57, 0xdb,
+	0xb5, 0xbc, 0xab, 0x58, 0xec, 0x9a, 0x9f, 0xc2, 0x95, 0x84, 0x17, 0x05, 0xd4, 0x8f, 0xc8, 0xff,
+	0x24, 0x3e, 0x84, 0xab, 0xaf, 0x02, 0xd7, 0x66, 0x64, 0x7e, 0x9b, 0x8f, 0x00, 0xa5, 0xa9, 0x73,
+	0x99, 0xb5, 0x60, 0xa9, 0x4f, 0x58, 0xda, 0xe6, 0x99, 0x8d, 0xc1, 0xe3, 0x4b, 0xf0, 0x73, 0x19,
+	0xba, 0x0b, 0xb5, 0x35, 0x2f, 0x12, 0xcc, 0x68, 0x6a, 0xaa, 0x12, 0x8d, 0x07, 0x91, 0x13, 0x7a,
+	0x03, 0x22, 0xe8, 0x65, 0x3c, 0x15, 0x98, 0xbf, 0x2a, 0x70, 0x35, 0x45, 0x89, 0xad, 0xdd, 0x07,
+	0x8d, 0xed, 0x07, 0x12, 0xbe, 0xd4, 0x36, 0xb3, 0xd6, 0x72, 0x60, 0x6b, 0x6b, 0x3f, 0x20, 0x58,
+	0xe0, 0x13, 0x2f, 0xd5, 0x33, 0xbd, 0xbc, 0x07, 0x1a, 0xe7, 0xa0, 0x32, 0x68, 0xeb, 0x2f, 0xd6,
+	0x7b, 0xb5, 0x05, 0x54, 0x81, 0x62, 0xa7, 0xdb, 0xed, 0x75, 0x6b, 0x0a, 0xaa, 0xc2, 0xa5, 0x57,
+	0x1b, 0xdd, 0xce, 0x56, 0xaf, 0x5b, 0x53, 0xf9, 0x07, 0xee, 0x7d, 0xf9, 0xe2, 0xab, 0x5e, 0xb7,
+	0x56, 0xe0, 0xc5, 0xc3, 0x64, 0x97, 0xee, 0x9d, 0xa3, 0x78, 0x75, 0x40, 0x69, 0xaa, 0x74, 0xdc,
+	0xfc, 0x4d, 0x85, 0xea, 0x13, 0xea, 0x47, 0x2c, 0xb4, 0x3d, 0x9f, 0x45, 0xe8, 0x1b, 0x58, 0x22,
+	0xaf, 0x9d, 0x9d, 0xb1, 0x4b, 0xba, 0xa2, 0x3b, 0x23, 0x5d, 0x69, 0x16, 0xce, 0xd9, 0xd5, 0x39,
+	0x4d, 0xe8, 0x03, 0xb8, 0x1c, 0x4b, 0x44, 0xf2, 0x74, 0x55, 0x68, 0x2e, 0xc5, 0x45, 0xcf, 0xec,
+	0xa1, 0x4d, 0x58, 0xe2, 0x5e, 0x4f, 0x3b, 0x4b, 0x2f, 0x88, 0xce, 0xfd, 0x30, 0x1d, 0x5d, 0xca,
+	0x71, 0x6b, 0x2d, 0x83, 0x96, 0x4d, 0x9c, 0x53, 0x61, 0x74, 0xe0, 0xda, 0x29, 0xb0, 0xb9, 0xda,
+	0x75, 0x04, 0xe5, 0x75, 0x7e, 0xaf, 0x0c, 0x68, 0x88, 0xbe, 0x48, 0x1d, 0xe0, 0x07, 0xc9, 0x01,
+	0x9e, 0x37, 0x4b, 0xfc, 0x15, 0x40, 0xa0, 0x8d, 0x68, 0x10, 0x09, 0x73, 0x8b, 0x58, 0xac, 0xcd,
+	0x1f, 0x15, 0xd0, 0x36, 0x6c, 0x36, 0x42, 0x6b, 0x70, 0xc9, 0xbd, 0x70, 0x2d, 0x26, 0x2a, 0xd0,
+	0x2d, 0x28, 0xee, 0x9c, 0x92, 0x7d, 0x29, 0xe4, 0x8e, 0x38, 0x34, 0x62, 0xe2, 0xd9, 0xd0, 0xb0,
+	0x58, 0x9b, 0x5f, 0x43, 0xe5, 0x09, 0xdd, 0x0d, 0xa8, 0x4f, 0x7c, 0xf6, 0x76, 0x9d, 0x31, 0x7f,
+	0x57, 0xe0, 0x5a, 0x9f, 0xb0, 0x49, 0x46, 0x93, 0x7e, 0x7d, 0x7b, 0x99, 0xad, 0x43, 0xd1, 0x25,
+	0x01, 0x1b, 0xc5, 0xa9, 0x95, 0x1f, 0xe8, 0x21, 0x54, 0x9d, 0xe9, 0xd9, 0x11, 0xd1, 0x56, 0xdb,
+	0x37, 0x66, 0x1c, 0x2d, 0x9c, 0xc6, 0x9a, 0xcf, 0xa1, 0x9e, 0xf5, 0x38, 0xbe, 0x2e, 0xda, 0x50,
+	0xf1, 0x27, 0x42, 0x91, 0x9a, 0x6a, 0xbb, 0x9e, 0x56, 0x38, 0x61, 0xe0, 0x29, 0xcc, 0xfc, 0x43,
+	0x85, 0x1b, 0x7d, 0xc2, 0x36, 0x47, 0x34, 0x64, 0x24, 0x62, 0xbc, 0xda, 0x49, 0x0a, 0xa6, 0x4f,
+	0xb8, 0x72, 0xe1, 0x27, 0x7c, 0xfa, 0x44, 0xab, 0x17, 0x7e, 0xa2, 0xcf, 0x9f, 0x3a, 0xd4, 0x82,
+	0x2b, 0xf2, 0x4d, 0x4e, 0x1a, 0x30, 0x1e, 0x27, 0xf2, 0x62, 0xfe, 0xce, 0xef, 0xda, 0xaf, 0x45,
+	0x3e, 0xc4, 0x54, 0xb1, 0x88, 0x93, 0x6f, 0x73, 0x15, 0xf4, 0x93, 0x39, 0x8b, 0x8b, 0xf0, 0x3e,
+	0x14, 0x03, 0x41, 0x92, 0x05, 0xc8, 0x5c, 0x85, 0x1c, 0x89, 0xe5, 0xb6, 0xf9, 0x52, 0x14, 0x31,
+	0x39, 0xd5, 0x49, 0xd2, 0x73, 0xc1, 0x29, 0x73, 0x9c, 0x8b, 0x75, 0xb8, 0x9e, 0x53, 0x19, 0xfb,
+	0x74, 0x0f, 0xc0, 0x49, 0xa4, 0xb1, 0x63, 0xd7, 0xb3, 0x2a, 0xe3, 0x5d, 0x9c, 0x02, 0x9a, 0x3f,
+	0xcb, 0xd6, 0xc0, 0xc4, 0x76, 0x46, 0xf6, 0x60, 0x87, 0x4c, 0x5c, 0x7c, 0x0a, 0x5a, 0x48, 0x29,
+	0xbb, 0xc0, 0xa9, 0x10, 0xfc, 0x7c, 0xa8, 0xea, 0x1c, 0xa1, 0x3e, 0x85, 0x7a, 0xd6, 0xb3, 0x38,
+	0x52, 0x2b, 0x7b, 0x37, 0xcc, 0x6a, 0x80, 0x09, 0xa8, 0xfd, 0x8f, 0x0a, 0x55, 0x7e, 0x1f, 0x6f,
+	0x92, 0x90, 0x0b, 0xd0, 0x63, 0x28, 0x74, 0x5c, 0x17, 0x19, 0x69, 0x56, 0x76, 0x36, 0x32, 0x6e,
+	0x9e, 0xba, 0x17, 0xdb, 0xef, 0x43, 0x49, 0x8e, 0x27, 0xe8, 0x76, 0x1a, 0x76, 0x62, 0xda, 0x31,
+	0x1a, 0xb3, 0xb6, 0x63, 0x45, 0x8f, 0xa1, 0xd0, 0x27, 0x2c, 0xeb, 0x48, 0x76, 0x78, 0x31, 0x6e,
+	0x9e, 0xba, 0x17, 0xf3, 0x7b, 0x7c, 0xf4, 0xe7, 0x63, 0xc7, 0x8c, 0xa1, 0x41, 0xaa, 0xb8, 0x7d,
+	0xe6, 0x48, 0x71, 0x57, 0xe1, 0xf1, 0xc8, 0x17, 0x3b, 0x1b, 0xcf, 0x89, 0x01, 0xc0, 0x68, 0xcc,
+	0xda, 0x96, 0xaa, 0xda, 0xff, 0xaa, 0x50, 0xdb, 0xa2, 0x01, 0xed, 0x73, 0xc0, 0x24, 0xdb, 0x2f,
+	0xe1, 0x72, 0xfa, 0x22, 0x43, 0xcb, 0xb9, 0x88, 0xf2, 0x97, 0xb2, 0xd1, 0x9c, 0x0d, 0x88, 0xe3,
+	0xfe, 0x16, 0x6a, 0xf9, 0xd6, 0x44, 0xef, 0xe5, 0x58, 0xa7, 0x5d, 0x76, 0xc6, 0x9d, 0xb3, 0x41,
+	0xb1, 0xfa, 0x2d, 0x58, 0xcc, 0xb4, 0x18, 0xca, 0x7b, 0x74, 0xa2, 0xa1, 0x8d, 0x77, 0xcf, 0x40,
+	0xc4, 0x5a, 0x65, 0x1e, 0x92, 0xd3, 0x7c, 0x22, 0x0f, 0xf9, 0x0e, 0x34, 0x9a, 0xb3, 0x01, 0x52,
+	0xe5, 0xaa, 0xfe, 0xe7, 0x61, 0x43, 0x79, 0x73, 0xd8, 0x50, 0xfe, 0x3e, 0x6c, 0x28, 0x3f, 0x1d,
+	0x35, 0x16, 0xde, 0x1c, 0x35, 0x16, 0xfe, 0x3a, 0x6a, 0x2c, 0x0c, 0x4a, 0xe2, 0x9f, 0xc3, 0x8f,
+	0xff, 0x1b, 0x00, 0xb4, 0x0c, 0x04, 0xec, 0x70, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LinkServiceClient is the client API for LinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LinkServiceClient interface {
+	// Add adds a link between two devices
+	Add(ctx context.Context, in *AddLinkRequest, opts ...grpc.CallOption) (*AddLinkResponse, error)
+	// Update updates a link
+	Update(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*UpdateLinkResponse, error)
+	// Get gets a link by ID
+	Get(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*GetLinkResponse, error)
+	// List gets a stream of link add/update/remove events
+	List(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (LinkService_ListClient, error)
+	// Remove removes a link
+	Remove(ctx context.Context, in *RemoveLinkRequest, opts ...grpc.CallOption) (*RemoveLinkResponse, error)
+}
+
+type linkServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLinkServiceClient(cc *grpc.ClientConn) LinkServiceClient {
+	return &linkServiceClient{cc}
+}
+
+func (c *linkServiceClient) Add(ctx context.Context, in *AddLinkRequest, opts ...grpc.CallOption) (*AddLinkResponse, error) {
+	out := new(AddLinkResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.LinkService/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) Update(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*UpdateLinkResponse, error) {
+	out := new(UpdateLinkResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.LinkService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) Get(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*GetLinkResponse, error) {
+	out := new(GetLinkResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.LinkService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) List(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (LinkService_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LinkService_serviceDesc.Streams[0], "/topo.graph.LinkService/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &linkServiceListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LinkService_ListClient interface {
+	Recv() (*ListLinksResponse, error)
+	grpc.ClientStream
+}
+
+type linkServiceListClient struct {
+	grpc.ClientStream
+}
+
+func (x *linkServiceListClient) Recv() (*ListLinksResponse, error) {
+	m := new(ListLinksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *linkServiceClient) Remove(ctx context.Context, in *RemoveLinkRequest, opts ...grpc.CallOption) (*RemoveLinkResponse, error) {
+	out := new(RemoveLinkResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.LinkService/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LinkServiceServer is the server API for LinkService service.
+type LinkServiceServer interface {
+	// Add adds a link between two devices
+	Add(context.Context, *AddLinkRequest) (*AddLinkResponse, error)
+	// Update updates a link
+	Update(context.Context, *UpdateLinkRequest) (*UpdateLinkResponse, error)
+	// Get gets a link by ID
+	Get(context.Context, *GetLinkRequest) (*GetLinkResponse, error)
+	// List gets a stream of link add/update/remove events
+	List(*ListLinksRequest, LinkService_ListServer) error
+	// Remove removes a link
+	Remove(context.Context, *RemoveLinkRequest) (*RemoveLinkResponse, error)
+}
+
+// UnimplementedLinkServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLinkServiceServer struct {
+}
+
+func (*UnimplementedLinkServiceServer) Add(ctx context.Context, req *AddLinkRequest) (*AddLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedLinkServiceServer) Update(ctx context.Context, req *UpdateLinkRequest) (*UpdateLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedLinkServiceServer) Get(ctx context.Context, req *GetLinkRequest) (*GetLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedLinkServiceServer) List(req *ListLinksRequest, srv LinkService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedLinkServiceServer) Remove(ctx context.Context, req *RemoveLinkRequest) (*RemoveLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+
+func RegisterLinkServiceServer(s *grpc.Server, srv LinkServiceServer) {
+	s.RegisterService(&_LinkService_serviceDesc, srv)
+}
+
+func _LinkService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.LinkService/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).Add(ctx, req.(*AddLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.LinkService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).Update(ctx, req.(*UpdateLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.LinkService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).Get(ctx, req.(*GetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListLinksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LinkServiceServer).List(m, &linkServiceListServer{stream})
+}
+
+type LinkService_ListServer interface {
+	Send(*ListLinksResponse) error
+	grpc.ServerStream
+}
+
+type linkServiceListServer struct {
+	grpc.ServerStream
+}
+
+func (x *linkServiceListServer) Send(m *ListLinksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LinkService_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.LinkService/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).Remove(ctx, req.(*RemoveLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LinkService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topo.graph.LinkService",
+	HandlerType: (*LinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _LinkService_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _LinkService_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _LinkService_Get_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _LinkService_Remove_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _LinkService_List_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/graph/graph.proto",
+}
+
+// TopoGraphServiceClient is the client API for TopoGraphService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TopoGraphServiceClient interface {
+	// GetNeighbors returns the devices within a number of hops of a device
+	GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error)
+	// GetShortestPaths returns the lowest cost paths between two devices
+	GetShortestPaths(ctx context.Context, in *GetShortestPathsRequest, opts ...grpc.CallOption) (*GetShortestPathsResponse, error)
+	// GetComponents returns the connected components of the topology
+	GetComponents(ctx context.Context, in *GetComponentsRequest, opts ...grpc.CallOption) (*GetComponentsResponse, error)
+	// GetReachable returns all devices reachable from a device
+	GetReachable(ctx context.Context, in *GetReachableRequest, opts ...grpc.CallOption) (*GetReachableResponse, error)
+}
+
+type topoGraphServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTopoGraphServiceClient(cc *grpc.ClientConn) TopoGraphServiceClient {
+	return &topoGraphServiceClient{cc}
+}
+
+func (c *topoGraphServiceClient) GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error) {
+	out := new(GetNeighborsResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.TopoGraphService/GetNeighbors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoGraphServiceClient) GetShortestPaths(ctx context.Context, in *GetShortestPathsRequest, opts ...grpc.CallOption) (*GetShortestPathsResponse, error) {
+	out := new(GetShortestPathsResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.TopoGraphService/GetShortestPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoGraphServiceClient) GetComponents(ctx context.Context, in *GetComponentsRequest, opts ...grpc.CallOption) (*GetComponentsResponse, error) {
+	out := new(GetComponentsResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.TopoGraphService/GetComponents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topoGraphServiceClient) GetReachable(ctx context.Context, in *GetReachableRequest, opts ...grpc.CallOption) (*GetReachableResponse, error) {
+	out := new(GetReachableResponse)
+	err := c.cc.Invoke(ctx, "/topo.graph.TopoGraphService/GetReachable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TopoGraphServiceServer is the server API for TopoGraphService service.
+type TopoGraphServiceServer interface {
+	// GetNeighbors returns the devices within a number of hops of a device
+	GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error)
+	// GetShortestPaths returns the lowest cost paths between two devices
+	GetShortestPaths(context.Context, *GetShortestPathsRequest) (*GetShortestPathsResponse, error)
+	// GetComponents returns the connected components of the topology
+	GetComponents(context.Context, *GetComponentsRequest) (*GetComponentsResponse, error)
+	// GetReachable returns all devices reachable from a device
+	GetReachable(context.Context, *GetReachableRequest) (*GetReachableResponse, error)
+}
+
+// UnimplementedTopoGraphServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTopoGraphServiceServer struct {
+}
+
+func (*UnimplementedTopoGraphServiceServer) GetNeighbors(ctx context.Context, req *GetNeighborsRequest) (*GetNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNeighbors not implemented")
+}
+func (*UnimplementedTopoGraphServiceServer) GetShortestPaths(ctx context.Context, req *GetShortestPathsRequest) (*GetShortestPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortestPaths not implemented")
+}
+func (*UnimplementedTopoGraphServiceServer) GetComponents(ctx context.Context, req *GetComponentsRequest) (*GetComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponents not implemented")
+}
+func (*UnimplementedTopoGraphServiceServer) GetReachable(ctx context.Context, req *GetReachableRequest) (*GetReachableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReachable not implemented")
+}
+
+func RegisterTopoGraphServiceServer(s *grpc.Server, srv TopoGraphServiceServer) {
+	s.RegisterService(&_TopoGraphService_serviceDesc, srv)
+}
+
+func _TopoGraphService_GetNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoGraphServiceServer).GetNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.TopoGraphService/GetNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoGraphServiceServer).GetNeighbors(ctx, req.(*GetNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoGraphService_GetShortestPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortestPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoGraphServiceServer).GetShortestPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.TopoGraphService/GetShortestPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoGraphServiceServer).GetShortestPaths(ctx, req.(*GetShortestPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoGraphService_GetComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoGraphServiceServer).GetComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.TopoGraphService/GetComponents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoGraphServiceServer).GetComponents(ctx, req.(*GetComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TopoGraphService_GetReachable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReachableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopoGraphServiceServer).GetReachable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topo.graph.TopoGraphService/GetReachable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopoGraphServiceServer).GetReachable(ctx, req.(*GetReachableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TopoGraphService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topo.graph.TopoGraphService",
+	HandlerType: (*TopoGraphServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNeighbors",
+			Handler:    _TopoGraphService_GetNeighbors_Handler,
+		},
+		{
+			MethodName: "GetShortestPaths",
+			Handler:    _TopoGraphService_GetShortestPaths_Handler,
+		},
+		{
+			MethodName: "GetComponents",
+			Handler:    _TopoGraphService_GetComponents_Handler,
+		},
+		{
+			MethodName: "GetReachable",
+			Handler:    _TopoGraphService_GetReachable_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/graph/graph.proto",
+}
+
+func (m *Link) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Link) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGraph(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGraph(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGraph(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Weight != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Directed {
+		i--
+		if m.Directed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TargetPort) > 0 {
+		i -= len(m.TargetPort)
+		copy(dAtA[i:], m.TargetPort)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.TargetPort)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Subscribe {
+		i--
+		if m.Subscribe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Link != nil {
+		{
+			size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Constraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Constraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Constraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LinkAttributes) > 0 {
+		for k := range m.LinkAttributes {
+			v := m.LinkAttributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGraph(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGraph(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGraph(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExcludeLinks) > 0 {
+		for iNdEx := len(m.ExcludeLinks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeLinks[iNdEx])
+			copy(dAtA[i:], m.ExcludeLinks[iNdEx])
+			i = encodeVarintGraph(dAtA, i, uint64(len(m.ExcludeLinks[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExcludeDevices) > 0 {
+		for iNdEx := len(m.ExcludeDevices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeDevices[iNdEx])
+			copy(dAtA[i:], m.ExcludeDevices[iNdEx])
+			i = encodeVarintGraph(dAtA, i, uint64(len(m.ExcludeDevices[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Neighbor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Neighbor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Neighbor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hops != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.Hops))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Path) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Path) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Path) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cost != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Links[iNdEx])
+			copy(dAtA[i:], m.Links[iNdEx])
+			i = encodeVarintGraph(dAtA, i, uint64(len(m.Links[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Devices[iNdEx])
+			copy(dAtA[i:], m.Devices[iNdEx])
+			i = encodeVarintGraph(dAtA, i, uint64(len(m.Devices[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Component) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Component) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Component) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Devices[iNdEx])
+			copy(dAtA[i:], m.Devices[iNdEx])
+			i = encodeVarintGraph(dAtA, i, uint64(len(m.Devices[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNeighborsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNeighborsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Depth != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNeighborsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNeighborsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNeighborsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Neighbors) > 0 {
+		for iNdEx := len(m.Neighbors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Neighbors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGraph(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShortestPathsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShortestPathsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShortestPathsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPaths != 0 {
+		i = encodeVarintGraph(dAtA, i, uint64(m.MaxPaths))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WeightAttribute) > 0 {
+		i -= len(m.WeightAttribute)
+		copy(dAtA[i:], m.WeightAttribute)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.WeightAttribute)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShortestPathsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShortestPathsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShortestPathsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGraph(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetComponentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetComponentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetComponentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetComponentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetComponentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetComponentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGraph(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReachableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReachableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReachableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGraph(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintGraph(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReachableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReachableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReachableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGraph(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGraph(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGraph(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Link) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovGraph(uint64(m.Revision))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	l = len(m.TargetPort)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.Directed {
+		n += 2
+	}
+	if m.Weight != 0 {
+		n += 1 + sovGraph(uint64(m.Weight))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGraph(uint64(len(k))) + 1 + len(v) + sovGraph(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGraph(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *AddLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *AddLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLinksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subscribe {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListLinksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovGraph(uint64(m.Type))
+	}
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Link != nil {
+		l = m.Link.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Constraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExcludeDevices) > 0 {
+		for _, s := range m.ExcludeDevices {
+			l = len(s)
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	if len(m.ExcludeLinks) > 0 {
+		for _, s := range m.ExcludeLinks {
+			l = len(s)
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	if len(m.LinkAttributes) > 0 {
+		for k, v := range m.LinkAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGraph(uint64(len(k))) + 1 + len(v) + sovGraph(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGraph(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Neighbor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.Hops != 0 {
+		n += 1 + sovGraph(uint64(m.Hops))
+	}
+	return n
+}
+
+func (m *Path) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	if len(m.Links) > 0 {
+		for _, s := range m.Links {
+			l = len(s)
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	if m.Cost != 0 {
+		n += 1 + sovGraph(uint64(m.Cost))
+	}
+	return n
+}
+
+func (m *Component) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetNeighborsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovGraph(uint64(m.Depth))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNeighborsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Neighbors) > 0 {
+		for _, e := range m.Neighbors {
+			l = e.Size()
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetShortestPathsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	l = len(m.WeightAttribute)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.MaxPaths != 0 {
+		n += 1 + sovGraph(uint64(m.MaxPaths))
+	}
+	return n
+}
+
+func (m *GetShortestPathsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetComponentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *GetComponentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetReachableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovGraph(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReachableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovGraph(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGraph(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGraph(x uint64) (n int) {
+	return sovGraph(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Link) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= github_com_onosproject_onos_topo_api_device.Revision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Directed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGraph
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGraph
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGraph
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGraph
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGraph
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGraph
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGraph
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGraph(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGraph
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListLinksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListLinksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribe", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Subscribe = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListLinksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListLinksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListLinksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ListLinksResponse_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Link == nil {
+				m.Link = &Link{}
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveLinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveLinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Constraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Constraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Constraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeDevices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeDevices = append(m.ExcludeDevices, github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeLinks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeLinks = append(m.ExcludeLinks, ID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinkAttributes == nil {
+				m.LinkAttributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGraph
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGraph
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGraph
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGraph
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGraph
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGraph
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGraph
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGraph(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGraph
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LinkAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Neighbor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Neighbor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Neighbor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			m.Hops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Path) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Path: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Path: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, ID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Component) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Component: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Component: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNeighborsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNeighborsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNeighborsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &Constraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNeighborsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNeighborsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNeighborsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Neighbors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Neighbors = append(m.Neighbors, &Neighbor{})
+			if err := m.Neighbors[len(m.Neighbors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShortestPathsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShortestPathsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShortestPathsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &Constraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightAttribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightAttribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPaths", wireType)
+			}
+			m.MaxPaths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPaths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShortestPathsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShortestPathsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShortestPathsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, &Path{})
+			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetComponentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetComponentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetComponentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &Constraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetComponentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetComponentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetComponentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, &Component{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReachableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReachableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReachableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = github_com_onosproject_onos_topo_api_device.ID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &Constraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReachableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReachableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReachableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGraph
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &Neighbor{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGraph(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGraph
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGraph(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGraph
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGraph
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGraph
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthGraph
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowGraph
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipGraph(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthGraph
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthGraph = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGraph   = fmt.Errorf("proto: integer overflow")
+)
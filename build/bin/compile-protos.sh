@@ -1,7 +1,13 @@
 #!/bin/sh
 
-proto_imports=".:${GOPATH}/src/github.com/gogo/protobuf/protobuf:${GOPATH}/src/github.com/gogo/protobuf:${GOPATH}/src"
+proto_imports=".:${GOPATH}/src/github.com/gogo/protobuf/protobuf:${GOPATH}/src/github.com/gogo/protobuf:${GOPATH}/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis:${GOPATH}/src"
 
-protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,admin.md --gogofaster_out=Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,import_path=topo/admin,plugins=grpc:. api/admin/*.proto
-protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,device.md --gogofaster_out=Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,import_path=topo/device,plugins=grpc:. api/device/*.proto
-protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,diags.md --gogofaster_out=Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,import_path=topo/diags,plugins=grpc:. api/diags/*.proto
+protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,admin.md --gogofaster_out=Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,Mapi/device/device.proto=github.com/onosproject/onos-topo/api/device,Mapi/graph/graph.proto=github.com/onosproject/onos-topo/api/graph,import_path=topo/admin,plugins=grpc:. api/admin/*.proto
+protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,device.md --gogofaster_out=Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,import_path=topo/device,plugins=grpc:. api/device/*.proto
+protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,diags.md --gogofaster_out=Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,Mapi/device/device.proto=github.com/onosproject/onos-topo/api/device,import_path=topo/diags,plugins=grpc:. api/diags/*.proto
+protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,admission.md --gogofaster_out=Mapi/device/device.proto=github.com/onosproject/onos-topo/api/device,import_path=topo/admission,plugins=grpc:. api/admission/*.proto
+protoc -I=$proto_imports --doc_out=docs/api  --doc_opt=markdown,graph.md --gogofaster_out=Mapi/device/device.proto=github.com/onosproject/onos-topo/api/device,import_path=topo/graph,plugins=grpc:. api/graph/*.proto
+
+# Generate the OpenAPI document for the REST gateway and embed it in the device API package
+protoc -I=$proto_imports --swagger_out=. api/device/device.proto
+build/bin/embed-openapi.sh api/device/device.swagger.json api/device/openapi.go device
@@ -0,0 +1,182 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"unicode"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/admission"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/spf13/viper"
+)
+
+const (
+	// configFlag is the flag naming the configuration file
+	configFlag = "config"
+
+	// envPrefix is the prefix of the environment variables overriding the configuration file
+	envPrefix = "ONOS_TOPO"
+)
+
+// loadConfig merges the named command line flags with the environment and the configuration file.
+// Flags set on the command line take precedence over environment variables, which take precedence
+// over the configuration file. Flag defaults apply to options that are not set anywhere.
+func loadConfig(flags *flag.FlagSet, names ...string) (*viper.Viper, error) {
+	v := viper.New()
+	for _, name := range names {
+		f := flags.Lookup(name)
+		if f == nil {
+			return nil, fmt.Errorf("unknown flag '%s'", name)
+		}
+		v.SetDefault(name, f.DefValue)
+		if err := v.BindEnv(name, envName(name)); err != nil {
+			return nil, err
+		}
+	}
+
+	if f := flags.Lookup(configFlag); f != nil && f.Value.String() != "" {
+		v.SetConfigFile(f.Value.String())
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read configuration file: %v", err)
+		}
+	}
+
+	flags.Visit(func(f *flag.Flag) {
+		for _, name := range names {
+			if f.Name == name {
+				v.Set(name, f.Value.String())
+			}
+		}
+	})
+	return v, nil
+}
+
+// envName returns the environment variable for the given flag, e.g. ONOS_TOPO_TLS_MODE for tlsMode
+func envName(name string) string {
+	var b strings.Builder
+	b.WriteString(envPrefix)
+	b.WriteRune('_')
+	for i, r := range name {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// newServerConfig creates and validates the northbound server configuration from the loaded configuration
+func newServerConfig(v *viper.Viper) (*northbound.ServerConfig, error) {
+	cfg := northbound.NewServerConfig(v.GetString("caPath"), v.GetString("keyPath"), v.GetString("certPath"))
+	cfg.Address = v.GetString("address")
+	cfg.Port = v.GetInt("port")
+	cfg.SocketPath = v.GetString("socketPath")
+	if err := cfg.SetTLSMode(northbound.TLSMode(v.GetString("tlsMode"))); err != nil {
+		return nil, err
+	}
+	cfg.KeepaliveTime = v.GetDuration("keepaliveTime")
+	cfg.KeepaliveTimeout = v.GetDuration("keepaliveTimeout")
+	cfg.KeepaliveMinTime = v.GetDuration("keepaliveMinTime")
+	cfg.MaxRecvMsgSize = v.GetInt("maxRecvMsgSize")
+	cfg.MaxSendMsgSize = v.GetInt("maxSendMsgSize")
+	cfg.Reflection = v.GetBool("reflection")
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// parseProtocols parses a comma separated list of device protocols, e.g. gnmi,p4runtime
+func parseProtocols(value string) ([]deviceapi.Protocol, error) {
+	var protocols []deviceapi.Protocol
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		protocol, ok := deviceapi.Protocol_value[name]
+		if !ok || protocol == int32(deviceapi.Protocol_UNKNOWN_PROTOCOL) {
+			return nil, fmt.Errorf("unknown protocol '%s'", name)
+		}
+		protocols = append(protocols, deviceapi.Protocol(protocol))
+	}
+	return protocols, nil
+}
+
+// newAdmissionPlugins loads the admission plugins listed under admission.plugins in the configuration file
+func newAdmissionPlugins(v *viper.Viper) ([]admission.Plugin, error) {
+	var configs []admission.Config
+	if err := v.UnmarshalKey("admission.plugins", &configs); err != nil {
+		return nil, fmt.Errorf("invalid admission plugins: %v", err)
+	}
+	return admission.LoadPlugins(configs)
+}
+
+// newNamespaceConfig loads the tenants and administrators listed under namespaces in the configuration file.
+// Clients are identified by their certificates, so tenants and administrators require the mtls mode, in
+// which client certificates are verified.
+func newNamespaceConfig(v *viper.Viper, mode northbound.TLSMode) (northbound.NamespaceConfig, error) {
+	cfg := northbound.NamespaceConfig{
+		Tenants: make(map[string][]string),
+		Admins:  v.GetStringSlice("namespaces.admins"),
+	}
+	for namespace := range v.GetStringMap("namespaces.tenants") {
+		cfg.Tenants[namespace] = v.GetStringSlice("namespaces.tenants." + namespace)
+	}
+	if err := cfg.Validate(); err != nil {
+		return northbound.NamespaceConfig{}, fmt.Errorf("invalid namespaces: %v", err)
+	}
+	if (len(cfg.Tenants) > 0 || len(cfg.Admins) > 0) && mode != northbound.TLSModeMutual {
+		return northbound.NamespaceConfig{}, fmt.Errorf("invalid namespaces: tenants and admins require the %s TLS mode", northbound.TLSModeMutual)
+	}
+	return cfg, nil
+}
+
+// validateGateway checks that the REST/JSON gateway is not enabled together with tenants. Gateway callers
+// are not authenticated and act with the server's own identity, so they would not be confined to a namespace.
+func validateGateway(v *viper.Viper, namespaces northbound.NamespaceConfig) error {
+	if v.GetBool("gateway") && len(namespaces.Tenants) > 0 {
+		return fmt.Errorf("invalid gateway: the gateway cannot be enabled when namespaces.tenants are configured")
+	}
+	return nil
+}
+
+// newQuotas loads the device quotas listed under namespaces in the configuration file
+func newQuotas(v *viper.Viper) (device.Quotas, error) {
+	quotas := device.Quotas{
+		Default:    v.GetInt("namespaces.defaultQuota"),
+		Namespaces: make(map[string]int),
+	}
+	for namespace := range v.GetStringMap("namespaces.quotas") {
+		if err := northbound.ValidateNamespace(namespace); err != nil {
+			return device.Quotas{}, fmt.Errorf("invalid namespace quotas: %v", err)
+		}
+		quotas.Namespaces[namespace] = v.GetInt("namespaces.quotas." + namespace)
+	}
+	if quotas.Default < 0 {
+		return device.Quotas{}, fmt.Errorf("invalid namespace quotas: the default quota must not be negative")
+	}
+	for namespace, quota := range quotas.Namespaces {
+		if quota < 0 {
+			return device.Quotas{}, fmt.Errorf("invalid namespace quotas: the quota of namespace '%s' must not be negative", namespace)
+		}
+	}
+	return quotas, nil
+}
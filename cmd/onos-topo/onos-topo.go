@@ -17,32 +17,137 @@ Package onos-topo is the main entry point to the ONOS topology subsystem.
 
 Arguments
 
+-config <the location of a YAML, JSON or TOML configuration file>
+
 -caPath <the location of a CA certificate>
 
 -keyPath <the location of a client private key>
 
 -certPath <the location of a client certificate>
 
+-address <the address on which to listen; all interfaces by default>
+
+-port <the port on which to listen>
+
+-socketPath <the location of a unix domain socket on which to listen instead of TCP>
+
+-tlsMode <plaintext, tls or mtls>
+
+-keepaliveTime <the idle time after which the server pings a client>
+
+-keepaliveTimeout <the time to wait for a keepalive ping ack>
+
+-keepaliveMinTime <the minimum interval at which clients may send keepalive pings>
+
+-maxRecvMsgSize <the maximum message size in bytes the server can receive>
+
+-maxSendMsgSize <the maximum message size in bytes the server can send>
+
+-reflection <whether to register the gRPC server reflection service>
+
+-probeInterval <the interval at which devices are probed for reachability>
+
+-probeJitter <the maximum random delay added to the probe interval>
+
+-probeRetries <the number of times a device state update is retried on concurrent modification>
+
+-probeProtocols <the comma separated protocols probed on devices that do not declare any protocol>
+
+-deviceTypePolicy <allow to admit devices of types missing from the catalog, or reject to reject them>
+
+-leaderElection <atomix to elect a leader among replicas, or local to run a single replica>
+
+-partitionDevices <whether to partition background work on devices across all replicas>
+
+-metricsPort <the port on which to expose Prometheus metrics>
+
+-gateway <whether to serve the REST/JSON gateway; disabled by default>
+
+-gatewayAddress <the address on which to serve the REST/JSON gateway; localhost by default>
+
+-gatewayPort <the port on which to serve the REST/JSON gateway>
+
+-shutdownTimeout <the time allowed for shutdown, including waiting for pending RPCs to complete>
+
+-readOnly <whether to put the topology into read-only mode on startup>
+
+-readOnlyReason <the reason reported to clients whose writes are rejected in read-only mode>
+
+Admission plugins validating and mutating devices, such as webhooks, can only be listed in the
+configuration file, under admission.plugins.
+
+Namespaces can likewise only be configured in the configuration file. namespaces.tenants maps each
+namespace to the client certificate common names confined to it, namespaces.admins lists the common
+names allowed to list across namespaces and to manage the topology, and namespaces.defaultQuota and
+namespaces.quotas limit the number of devices in each namespace. Tenants and admins require -tlsMode mtls.
+The gateway cannot be enabled when tenants are configured, since its callers act with the server's identity.
+
+Each argument may also be set in the configuration file, or in the environment by an ONOS_TOPO_
+prefixed variable, e.g. ONOS_TOPO_TLS_MODE for -tlsMode. Arguments given on the command line take
+precedence over the environment, which takes precedence over the configuration file.
 
 See ../../docs/run.md for how to run the application.
 */
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	adminapi "github.com/onosproject/onos-topo/api/admin"
+	diagsapi "github.com/onosproject/onos-topo/api/diags"
+	"github.com/onosproject/onos-topo/pkg/admission"
+	"github.com/onosproject/onos-topo/pkg/certs"
+	"github.com/onosproject/onos-topo/pkg/gateway"
+	"github.com/onosproject/onos-topo/pkg/logging"
 	"github.com/onosproject/onos-topo/pkg/manager"
+	"github.com/onosproject/onos-topo/pkg/metrics"
 	"github.com/onosproject/onos-topo/pkg/northbound"
 	"github.com/onosproject/onos-topo/pkg/northbound/admin"
 	"github.com/onosproject/onos-topo/pkg/northbound/device"
 	"github.com/onosproject/onos-topo/pkg/northbound/diags"
+	"github.com/onosproject/onos-topo/pkg/northbound/graph"
+	"github.com/onosproject/onos-topo/pkg/util"
+	"google.golang.org/grpc"
 	log "k8s.io/klog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 // The main entry point
 func main() {
-	caPath := flag.String("caPath", "", "path to CA certificate")
-	keyPath := flag.String("keyPath", "", "path to client private key")
-	certPath := flag.String("certPath", "", "path to client certificate")
+	flag.String(configFlag, "", "path to a configuration file")
+	flag.String("caPath", "", "path to CA certificate")
+	flag.String("keyPath", "", "path to client private key")
+	flag.String("certPath", "", "path to client certificate")
+	flag.String("address", "", "address on which to listen; all interfaces if empty")
+	flag.Int("port", northbound.DefaultPort, "port on which to listen")
+	flag.String("socketPath", "", "path to a unix domain socket on which to listen instead of TCP")
+	flag.String("tlsMode", string(northbound.TLSModeTLS), "plaintext, tls (client certificates optional and unverified) or mtls (client certificates required and verified)")
+	flag.Duration("keepaliveTime", 0, "idle time after which the server pings a client; 0 for the gRPC default")
+	flag.Duration("keepaliveTimeout", 0, "time to wait for a keepalive ping ack; 0 for the gRPC default")
+	flag.Duration("keepaliveMinTime", 0, "minimum interval at which clients may send keepalive pings; 0 for the gRPC default")
+	flag.Int("maxRecvMsgSize", 0, "maximum message size in bytes the server can receive; 0 for the gRPC default")
+	flag.Int("maxSendMsgSize", 0, "maximum message size in bytes the server can send; 0 for the gRPC default")
+	flag.Bool("reflection", true, "register the gRPC server reflection service")
+	flag.Duration("probeInterval", 30*time.Second, "interval at which devices are probed for reachability")
+	flag.Duration("probeJitter", 5*time.Second, "maximum random delay added to the probe interval")
+	flag.Int("probeRetries", 5, "number of times a device state update is retried on concurrent modification")
+	flag.String("probeProtocols", "gnmi", "comma separated protocols probed on devices that do not declare any protocol")
+	flag.String("deviceTypePolicy", string(device.TypePolicyAllow), "allow to admit devices of types missing from the catalog, or reject to reject them")
+	flag.String("leaderElection", "atomix", "atomix to elect a leader among replicas, or local to run a single replica")
+	flag.Bool("partitionDevices", false, "partition background work on devices across all replicas rather than the leader alone")
+	flag.Int("metricsPort", 7070, "port on which to expose Prometheus metrics")
+	flag.Bool("gateway", false, "whether to serve the REST/JSON gateway, which calls the gRPC services with the server's own identity")
+	flag.String("gatewayAddress", "localhost", "address on which to serve the REST/JSON gateway")
+	flag.Int("gatewayPort", 8080, "port on which to serve the REST/JSON gateway")
+	flag.Duration("shutdownTimeout", 20*time.Second, "time allowed for shutdown, including waiting for pending RPCs to complete")
+	flag.Bool("readOnly", false, "put the topology into read-only mode on startup; the mode is persisted and must be cleared with the admin API")
+	flag.String("readOnlyReason", "", "reason reported to clients whose writes are rejected in read-only mode")
 
 	//lines 93-109 are implemented according to
 	// https://github.com/kubernetes/klog/blob/master/examples/coexist_glog/coexist_glog.go
@@ -66,33 +171,279 @@ func main() {
 			_ = f2.Value.Set(value)
 		}
 	})
+	if err := logging.Init(klogFlags); err != nil {
+		log.Fatal("Unable to load onos-topo log configuration ", err)
+	}
 	log.Info("Starting onos-topo")
 
-	mgr, err := manager.NewManager()
+	config, err := loadConfig(flag.CommandLine, "caPath", "keyPath", "certPath", "address", "port", "socketPath",
+		"tlsMode", "keepaliveTime", "keepaliveTimeout", "keepaliveMinTime", "maxRecvMsgSize", "maxSendMsgSize",
+		"reflection", "metricsPort", "gateway", "gatewayAddress", "gatewayPort", "shutdownTimeout", "probeInterval", "probeJitter", "probeRetries",
+		"probeProtocols", "deviceTypePolicy", "leaderElection", "partitionDevices", "readOnly", "readOnlyReason")
+	if err != nil {
+		log.Fatal("Unable to load onos-topo configuration ", err)
+	}
+	serverConfig, err := newServerConfig(config)
+	if err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+
+	metricsServer := metrics.NewServer(config.GetInt("metricsPort"))
+	go func() {
+		log.Infof("Starting metrics server on address: %s", metricsServer.Addr)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("Unable to start metrics server ", err)
+		}
+	}()
+
+	probeProtocols, err := parseProtocols(config.GetString("probeProtocols"))
+	if err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+
+	typePolicy, err := device.ParseTypePolicy(config.GetString("deviceTypePolicy"))
+	if err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+
+	deviceStore, err := device.NewAtomixStore()
 	if err != nil {
 		log.Fatal("Unable to load onos-topo ", err)
-	} else {
-		mgr.Run()
-		err = startServer(*caPath, *keyPath, *certPath)
-		if err != nil {
-			log.Fatal("Unable to start onos-topo ", err)
+	}
+	if err := device.WatchMetrics(context.Background(), deviceStore); err != nil {
+		log.Fatal("Unable to load onos-topo ", err)
+	}
+
+	typeStore, err := device.NewAtomixTypeStore()
+	if err != nil {
+		log.Fatal("Unable to load onos-topo ", err)
+	}
+
+	linkStore, err := graph.NewAtomixLinkStore()
+	if err != nil {
+		log.Fatal("Unable to load onos-topo ", err)
+	}
+
+	modeStore, err := device.NewAtomixModeStore()
+	if err != nil {
+		log.Fatal("Unable to load onos-topo ", err)
+	}
+	if config.GetBool("readOnly") {
+		if err := setReadOnly(modeStore, config.GetString("readOnlyReason")); err != nil {
+			log.Fatal("Unable to load onos-topo ", err)
 		}
 	}
+
+	admissionPlugins, err := newAdmissionPlugins(config)
+	if err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+	namespaceConfig, err := newNamespaceConfig(config, serverConfig.TLSMode())
+	if err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+	if err := validateGateway(config, namespaceConfig); err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+	quotas, err := newQuotas(config)
+	if err != nil {
+		log.Fatal("Invalid onos-topo configuration ", err)
+	}
+	admissionPlugins = append([]admission.Plugin{
+		device.NewQuotaPlugin(deviceStore, quotas),
+		device.NewTypePlugin(typeStore, typePolicy),
+	}, admissionPlugins...)
+
+	elector, err := newElector(config.GetString("leaderElection"), config.GetBool("partitionDevices"))
+	if err != nil {
+		log.Fatal("Unable to load onos-topo ", err)
+	}
+
+	mgr, err := manager.NewManager(deviceStore, modeStore, elector, manager.ProberConfig{
+		Interval:  config.GetDuration("probeInterval"),
+		Jitter:    config.GetDuration("probeJitter"),
+		Retries:   config.GetInt("probeRetries"),
+		Protocols: probeProtocols,
+	})
+	if err != nil {
+		log.Fatal("Unable to load onos-topo ", err)
+	}
+	if err := mgr.Run(); err != nil {
+		log.Fatal("Unable to start onos-topo ", err)
+	}
+
+	s, err := newServer(serverConfig, namespaceConfig, quotas, deviceStore, typeStore, linkStore, modeStore, admissionPlugins, elector)
+	if err != nil {
+		log.Fatal("Unable to start onos-topo ", err)
+	}
+
+	errCh := make(chan error, 1)
+	gatewayCh := make(chan *gateway.Server, 1)
+	go func() {
+		errCh <- s.Serve(func(started string) {
+			log.Info("Started NBI on ", started)
+			if config.GetBool("gateway") {
+				go startGateway(serverConfig, started, config.GetString("gatewayAddress"), config.GetInt("gatewayPort"), gatewayCh)
+			}
+		})
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case sig := <-sigCh:
+		log.Infof("Received %s; shutting down onos-topo", sig)
+		ctx, cancel := context.WithTimeout(context.Background(), config.GetDuration("shutdownTimeout"))
+		shutdown(ctx, s, mgr, gatewayCh, metricsServer)
+		cancel()
+	case err := <-errCh:
+		mgr.Close()
+		s.Stop()
+		log.Fatal("Unable to start onos-topo ", err)
+	}
 }
 
-// Creates gRPC server and registers various services; then serves.
-func startServer(caPath string, keyPath string, certPath string) error {
-	s := northbound.NewServer(northbound.NewServerConfig(caPath, keyPath, certPath))
-	s.AddService(admin.Service{})
-	s.AddService(diags.Service{})
+// Creates gRPC server and registers various services.
+func newServer(cfg *northbound.ServerConfig, namespaceConfig northbound.NamespaceConfig, quotas device.Quotas, deviceStore device.Store, typeStore device.TypeStore, linkStore graph.LinkStore, modeStore device.ModeStore, admissionPlugins []admission.Plugin, elector *manager.Elector) (*northbound.Server, error) {
+	s := northbound.NewServer(cfg)
+	s.AddUnaryInterceptor(metrics.UnaryServerInterceptor())
+	s.AddStreamInterceptor(metrics.StreamServerInterceptor())
+	s.AddUnaryInterceptor(logging.UnaryServerInterceptor())
+	s.AddStreamInterceptor(logging.StreamServerInterceptor())
+	s.AddUnaryInterceptor(northbound.NamespaceUnaryServerInterceptor(namespaceConfig))
+	s.AddStreamInterceptor(northbound.NamespaceStreamServerInterceptor(namespaceConfig))
 
-	deviceService, err := device.NewService()
+	deviceService, err := device.NewService(deviceStore, typeStore, modeStore, admissionPlugins...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	s.AddService(deviceService)
+	s.AddService(admin.NewService(deviceStore, typeStore, linkStore, modeStore, deviceService, quotas))
+
+	graphService, err := graph.NewService(linkStore, deviceStore, modeStore)
+	if err != nil {
+		return nil, err
+	}
+	s.AddService(graphService)
 
-	return s.Serve(func(started string) {
-		log.Info("Started NBI on ", started)
+	s.AddService(diags.NewService(diagsapi.StoreInfo{
+		Type:           "atomix",
+		Controller:     util.GetAtomixController(),
+		Group:          util.GetAtomixRaftGroup(),
+		SessionTimeout: device.SessionTimeout,
+	}, deviceStore, deviceService, elector))
+	return s, nil
+}
+
+// Shuts down onos-topo before the given context is done: the REST gateway stops accepting requests and
+// completes pending ones, the gRPC server drains, the manager stops probing and leaves its elections and
+// the metrics server stops last so that the shutdown itself remains observable. Steps that are still
+// running when the context is done are abandoned.
+func shutdown(ctx context.Context, s *northbound.Server, mgr *manager.Manager, gatewayCh <-chan *gateway.Server, metricsServer *http.Server) {
+	select {
+	case gw := <-gatewayCh:
+		if err := gw.Shutdown(ctx); err != nil {
+			log.Warning("Failed to shut down REST gateway ", err)
+		}
+	default:
+	}
+
+	s.GracefulStop(ctx)
+
+	closed := make(chan struct{})
+	go func() {
+		mgr.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-ctx.Done():
+		log.Warning("Manager did not close before the shutdown deadline")
+	}
+
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		log.Warning("Failed to shut down metrics server ", err)
+	}
+}
+
+// Returns the identifier of this replica: the pod name if set, or else the hostname.
+func replicaID() (string, error) {
+	if id := os.Getenv("POD_NAME"); id != "" {
+		return id, nil
+	}
+	return os.Hostname()
+}
+
+// Persists the read-only mode requested by the readOnly flag.
+func setReadOnly(modeStore device.ModeStore, reason string) error {
+	id, err := replicaID()
+	if err != nil {
+		return err
+	}
+	if reason == "" {
+		reason = "readOnly flag"
+	}
+	updated := time.Now()
+	log.Infof("Putting the topology into read-only mode: %s", reason)
+	return modeStore.Store(&adminapi.Mode{
+		ReadOnly: true,
+		Reason:   reason,
+		SetBy:    id + " (readOnly flag)",
+		Updated:  &updated,
 	})
 }
+
+// Creates the elector electing the replica responsible for background work on devices.
+func newElector(election string, partitioned bool) (*manager.Elector, error) {
+	id, err := replicaID()
+	if err != nil {
+		return nil, err
+	}
+
+	switch election {
+	case "atomix":
+		return manager.NewAtomixElector(id, partitioned)
+	case "local":
+		return manager.NewLocalElector(id, partitioned)
+	default:
+		return nil, fmt.Errorf("invalid leader election '%s'", election)
+	}
+}
+
+// Connects the REST/JSON gateway to the gRPC server listening on the given address; then serves on the
+// given gateway address and port. Gateway callers are not authenticated, and their requests are made
+// with the server's own identity, so the gateway is not started when tenants are configured.
+func startGateway(cfg *northbound.ServerConfig, address string, gatewayAddress string, port int, gatewayCh chan<- *gateway.Server) {
+	var opts []grpc.DialOption
+	if cfg.Plaintext {
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		// The server's own certificate is used as the client certificate so the gateway is trusted in mtls mode
+		certOpts, err := certs.HandleCertArgs(cfg.KeyPath, cfg.CertPath)
+		if err != nil {
+			log.Error("Unable to start REST gateway ", err)
+			return
+		}
+		opts = append(opts, certOpts...)
+	}
+
+	if cfg.SocketPath != "" {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}))
+	} else {
+		_, grpcPort, err := net.SplitHostPort(address)
+		if err != nil {
+			log.Error("Unable to start REST gateway ", err)
+			return
+		}
+		address = net.JoinHostPort("localhost", grpcPort)
+	}
+
+	conn := northbound.Connect(address, opts...)
+	server := gateway.NewServer(conn)
+	gatewayCh <- server
+	if err := server.Serve(gatewayAddress, port); err != nil && err != http.ErrServerClosed {
+		log.Error("Unable to start REST gateway ", err)
+	}
+}
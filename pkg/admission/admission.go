@@ -0,0 +1,151 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package admission implements chains of plugins that validate and mutate device operations
+// before they are applied to the store.
+package admission
+
+import (
+	"context"
+
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Request is a device operation to be admitted
+type Request struct {
+	// Operation is the operation being admitted
+	Operation admissionapi.Operation
+
+	// Device is the device being created or updated, or the stored device being removed
+	Device *deviceapi.Device
+
+	// OldDevice is the stored device being updated, if any
+	OldDevice *deviceapi.Device
+}
+
+// Plugin is an admission plugin. A plugin must implement Mutator, Validator or both.
+type Plugin interface {
+	// Name returns the name of the plugin, used in errors
+	Name() string
+}
+
+// Mutator is a plugin that modifies devices being created or updated
+type Mutator interface {
+	Plugin
+
+	// Mutate modifies the request's device in place, returning an error to deny the request
+	Mutate(ctx context.Context, request *Request) error
+}
+
+// Validator is a plugin that validates device operations
+type Validator interface {
+	Plugin
+
+	// Validate returns an error to deny the request
+	Validate(ctx context.Context, request *Request) error
+}
+
+// NewMutator returns a Mutator calling the given function
+func NewMutator(name string, mutate func(ctx context.Context, request *Request) error) Mutator {
+	return &funcMutator{funcPlugin{name: name, fn: mutate}}
+}
+
+// NewValidator returns a Validator calling the given function
+func NewValidator(name string, validate func(ctx context.Context, request *Request) error) Validator {
+	return &funcValidator{funcPlugin{name: name, fn: validate}}
+}
+
+type funcPlugin struct {
+	name string
+	fn   func(ctx context.Context, request *Request) error
+}
+
+func (p *funcPlugin) Name() string {
+	return p.name
+}
+
+type funcMutator struct {
+	funcPlugin
+}
+
+func (p *funcMutator) Mutate(ctx context.Context, request *Request) error {
+	return p.fn(ctx, request)
+}
+
+type funcValidator struct {
+	funcPlugin
+}
+
+func (p *funcValidator) Validate(ctx context.Context, request *Request) error {
+	return p.fn(ctx, request)
+}
+
+// NewChain returns a Chain of the given plugins
+func NewChain(plugins ...Plugin) *Chain {
+	return &Chain{
+		plugins: plugins,
+	}
+}
+
+// Chain admits device operations by running a series of plugins.
+// All mutators are run in order before all validators, so that validators see the final device.
+type Chain struct {
+	plugins []Plugin
+}
+
+// Admit runs the chain's plugins on the given request, returning an error if any plugin denies it.
+// Mutators are not run on DELETE requests.
+func (c *Chain) Admit(ctx context.Context, request *Request) error {
+	if request.Operation != admissionapi.Operation_DELETE {
+		for _, plugin := range c.plugins {
+			if mutator, ok := plugin.(Mutator); ok {
+				if err := mutator.Mutate(ctx, request); err != nil {
+					return denied(plugin, err)
+				}
+			}
+		}
+	}
+
+	for _, plugin := range c.plugins {
+		if validator, ok := plugin.(Validator); ok {
+			if err := validator.Validate(ctx, request); err != nil {
+				return denied(plugin, err)
+			}
+		}
+	}
+	return nil
+}
+
+// denied returns the gRPC error for a request denied by the given plugin.
+// Errors with a gRPC status are returned as is; other errors are InvalidArgument errors.
+func denied(plugin Plugin, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.InvalidArgument, "denied by %s: %v", plugin.Name(), err)
+}
+
+// Close closes the chain's plugins that hold resources, such as webhook connections
+func (c *Chain) Close() error {
+	var err error
+	for _, plugin := range c.plugins {
+		if e := closePlugin(plugin); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
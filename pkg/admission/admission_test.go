@@ -0,0 +1,116 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	chain := NewChain(
+		NewValidator("validator-1", func(ctx context.Context, request *Request) error {
+			calls = append(calls, "validator-1")
+			if request.Device.Role == "" {
+				return errors.New("role is required")
+			}
+			return nil
+		}),
+		NewMutator("mutator-1", func(ctx context.Context, request *Request) error {
+			calls = append(calls, "mutator-1")
+			request.Device.Role = "leaf"
+			return nil
+		}),
+		NewValidator("validator-2", func(ctx context.Context, request *Request) error {
+			calls = append(calls, "validator-2")
+			return status.Error(codes.PermissionDenied, "denied")
+		}),
+	)
+
+	device := &deviceapi.Device{ID: "device-1"}
+	err := chain.Admit(context.Background(), &Request{
+		Operation: admissionapi.Operation_CREATE,
+		Device:    device,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, []string{"mutator-1", "validator-1", "validator-2"}, calls)
+	assert.Equal(t, deviceapi.Role("leaf"), device.Role)
+
+	// Mutators are not run on delete
+	calls = nil
+	err = chain.Admit(context.Background(), &Request{
+		Operation: admissionapi.Operation_DELETE,
+		Device:    &deviceapi.Device{ID: "device-1"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "denied by validator-1: role is required")
+	assert.Equal(t, []string{"validator-1"}, calls)
+}
+
+func TestLoadPatternPlugin(t *testing.T) {
+	plugins, err := LoadPlugins([]Config{
+		{
+			Name:       "eu-naming",
+			Type:       PluginTypePattern,
+			Operations: []string{"create", "update"},
+			Selector:   map[string]string{"attributes.region": "eu"},
+			Field:      "id",
+			Pattern:    "eu-.+",
+		},
+	})
+	assert.NoError(t, err)
+	chain := NewChain(plugins...)
+	defer chain.Close()
+
+	admit := func(operation admissionapi.Operation, id deviceapi.ID, region string) error {
+		return chain.Admit(context.Background(), &Request{
+			Operation: operation,
+			Device: &deviceapi.Device{
+				ID:         id,
+				Attributes: map[string]string{"region": region},
+			},
+		})
+	}
+	assert.NoError(t, admit(admissionapi.Operation_CREATE, "eu-leaf-1", "eu"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(admit(admissionapi.Operation_CREATE, "leaf-1", "eu")))
+	assert.Equal(t, codes.InvalidArgument, status.Code(admit(admissionapi.Operation_UPDATE, "xeu-leaf-1", "eu")))
+	assert.NoError(t, admit(admissionapi.Operation_DELETE, "leaf-1", "eu"))
+	assert.NoError(t, admit(admissionapi.Operation_CREATE, "leaf-1", "us"))
+}
+
+func TestLoadPluginErrors(t *testing.T) {
+	invalid := []Config{
+		{Type: PluginTypePattern, Field: "id", Pattern: ".*"},
+		{Name: "foo", Type: "foo"},
+		{Name: "foo", Type: PluginTypePattern, Field: "foo", Pattern: ".*"},
+		{Name: "foo", Type: PluginTypePattern, Field: "id", Pattern: "("},
+		{Name: "foo", Type: PluginTypePattern, Field: "id", Operations: []string{"foo"}},
+		{Name: "foo", Type: PluginTypePattern, Field: "id", Selector: map[string]string{"attributes.": "foo"}},
+		{Name: "foo", Type: PluginTypeWebhook},
+		{Name: "foo", Type: PluginTypeWebhook, Webhook: WebhookConfig{Address: "localhost:1234", FailurePolicy: "foo"}},
+	}
+	for _, config := range invalid {
+		_, err := LoadPlugins([]Config{config})
+		assert.Error(t, err, "%v", config)
+	}
+}
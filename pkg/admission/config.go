@@ -0,0 +1,227 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+)
+
+const (
+	// PluginTypePattern is the type of plugins requiring a device field to match a regular expression
+	PluginTypePattern = "pattern"
+
+	// PluginTypeWebhook is the type of plugins calling an external admission service
+	PluginTypeWebhook = "webhook"
+)
+
+// Config is the configuration of an admission plugin loaded at startup
+type Config struct {
+	// Name is the name of the plugin
+	Name string
+
+	// Type is the type of the plugin: pattern or webhook
+	Type string
+
+	// Operations are the operations to which the plugin applies: create, update and/or delete.
+	// Plugins apply to all operations if empty.
+	Operations []string
+
+	// Selector restricts the plugin to devices whose fields have all the given values, e.g.
+	// {"attributes.region": "eu"}. Fields are named as for Field.
+	Selector map[string]string
+
+	// Field is the device field checked by a pattern plugin: namespace, id, type, role, target,
+	// address, version, or attributes.<key> for an attribute
+	Field string
+
+	// Pattern is the regular expression the whole field must match in a pattern plugin
+	Pattern string
+
+	// Webhook is the configuration of a webhook plugin
+	Webhook WebhookConfig
+}
+
+// LoadPlugins creates the plugins with the given configurations
+func LoadPlugins(configs []Config) ([]Plugin, error) {
+	plugins := make([]Plugin, 0, len(configs))
+	for _, config := range configs {
+		plugin, err := loadPlugin(config)
+		if err != nil {
+			for _, p := range plugins {
+				_ = closePlugin(p)
+			}
+			return nil, err
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
+
+func loadPlugin(config Config) (Plugin, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("admission plugin name is required")
+	}
+
+	operations := make(map[admissionapi.Operation]bool)
+	for _, name := range config.Operations {
+		operation, ok := admissionapi.Operation_value[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("admission plugin %s: unknown operation '%s'", config.Name, name)
+		}
+		operations[admissionapi.Operation(operation)] = true
+	}
+	for field := range config.Selector {
+		if _, err := fieldValue(&deviceapi.Device{}, field); err != nil {
+			return nil, fmt.Errorf("admission plugin %s: %v", config.Name, err)
+		}
+	}
+
+	var plugin Plugin
+	switch config.Type {
+	case PluginTypePattern:
+		p, err := newPatternValidator(config)
+		if err != nil {
+			return nil, fmt.Errorf("admission plugin %s: %v", config.Name, err)
+		}
+		plugin = p
+	case PluginTypeWebhook:
+		p, err := NewWebhook(config.Name, config.Webhook)
+		if err != nil {
+			return nil, fmt.Errorf("admission plugin %s: %v", config.Name, err)
+		}
+		plugin = p
+	default:
+		return nil, fmt.Errorf("admission plugin %s: unknown type '%s'", config.Name, config.Type)
+	}
+
+	if len(operations) == 0 && len(config.Selector) == 0 {
+		return plugin, nil
+	}
+	s := scope{operations: operations, selector: config.Selector}
+	switch p := plugin.(type) {
+	case Mutator:
+		return &scopedMutator{Mutator: p, scope: s}, nil
+	case Validator:
+		return &scopedValidator{Validator: p, scope: s}, nil
+	}
+	return plugin, nil
+}
+
+// fieldValue returns the value of the named device field
+func fieldValue(device *deviceapi.Device, field string) (string, error) {
+	switch field {
+	case "namespace":
+		return device.Namespace, nil
+	case "id":
+		return string(device.ID), nil
+	case "type":
+		return string(device.Type), nil
+	case "role":
+		return string(device.Role), nil
+	case "target":
+		return device.Target, nil
+	case "address":
+		return device.Address, nil
+	case "version":
+		return device.Version, nil
+	}
+	if key := strings.TrimPrefix(field, "attributes."); key != field && key != "" {
+		return device.Attributes[key], nil
+	}
+	return "", fmt.Errorf("unknown device field '%s'", field)
+}
+
+// newPatternValidator returns a validator requiring the configured field to match the configured pattern
+func newPatternValidator(config Config) (Validator, error) {
+	if _, err := fieldValue(&deviceapi.Device{}, config.Field); err != nil {
+		return nil, err
+	}
+	pattern, err := regexp.Compile(`^(?:` + config.Pattern + `)$`)
+	if err != nil {
+		return nil, err
+	}
+	return NewValidator(config.Name, func(ctx context.Context, request *Request) error {
+		value, _ := fieldValue(request.Device, config.Field)
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("device %s '%s' does not match '%s'", config.Field, value, config.Pattern)
+		}
+		return nil
+	}), nil
+}
+
+// scope restricts a plugin to a set of operations and devices
+type scope struct {
+	operations map[admissionapi.Operation]bool
+	selector   map[string]string
+}
+
+func (s scope) matches(request *Request) bool {
+	if len(s.operations) > 0 && !s.operations[request.Operation] {
+		return false
+	}
+	for field, value := range s.selector {
+		if v, _ := fieldValue(request.Device, field); v != value {
+			return false
+		}
+	}
+	return true
+}
+
+type scopedMutator struct {
+	Mutator
+	scope scope
+}
+
+func (p *scopedMutator) Mutate(ctx context.Context, request *Request) error {
+	if !p.scope.matches(request) {
+		return nil
+	}
+	return p.Mutator.Mutate(ctx, request)
+}
+
+func (p *scopedMutator) Close() error {
+	return closePlugin(p.Mutator)
+}
+
+type scopedValidator struct {
+	Validator
+	scope scope
+}
+
+func (p *scopedValidator) Validate(ctx context.Context, request *Request) error {
+	if !p.scope.matches(request) {
+		return nil
+	}
+	return p.Validator.Validate(ctx, request)
+}
+
+func (p *scopedValidator) Close() error {
+	return closePlugin(p.Validator)
+}
+
+// closePlugin closes the given plugin if it holds resources
+func closePlugin(plugin Plugin) error {
+	if closer, ok := plugin.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
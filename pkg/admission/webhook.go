@@ -0,0 +1,217 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+const defaultWebhookTimeout = 5 * time.Second
+
+// FailurePolicy determines how a webhook that cannot be called affects the request
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail denies requests if the webhook cannot be called
+	FailurePolicyFail FailurePolicy = "fail"
+
+	// FailurePolicyIgnore admits requests if the webhook cannot be called
+	FailurePolicyIgnore FailurePolicy = "ignore"
+)
+
+// WebhookConfig is the configuration of a webhook plugin
+type WebhookConfig struct {
+	// Address is the host:port of the admission service
+	Address string
+
+	// Mutating indicates whether the webhook may mutate devices. Mutating webhooks are called before
+	// validators; other webhooks are called as validators.
+	Mutating bool
+
+	// Timeout is the timeout of admission requests; 5 seconds if zero
+	Timeout time.Duration
+
+	// FailurePolicy is fail (the default) or ignore
+	FailurePolicy FailurePolicy
+
+	// TLS is the TLS configuration for connecting to the admission service
+	TLS WebhookTLSConfig
+}
+
+// WebhookTLSConfig is the TLS configuration of a webhook
+type WebhookTLSConfig struct {
+	// CaCert is the path to the CA certificate verifying the admission service
+	CaCert string
+
+	// Cert is the path to the client certificate
+	Cert string
+
+	// Key is the path to the client key
+	Key string
+
+	// Plain indicates whether to connect over plaintext
+	Plain bool
+
+	// Insecure indicates whether to skip verification of the admission service's certificate
+	Insecure bool
+}
+
+// NewWebhook returns a plugin calling the AdmissionService at the configured address.
+// The returned plugin is a Mutator if the webhook is mutating, or a Validator otherwise.
+func NewWebhook(name string, config WebhookConfig) (Plugin, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("webhook address is required")
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultWebhookTimeout
+	}
+	switch config.FailurePolicy {
+	case "":
+		config.FailurePolicy = FailurePolicyFail
+	case FailurePolicyFail, FailurePolicyIgnore:
+	default:
+		return nil, fmt.Errorf("invalid failure policy '%s'", config.FailurePolicy)
+	}
+
+	opts, err := webhookDialOptions(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(config.Address, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &webhook{
+		name:   name,
+		config: config,
+		conn:   conn,
+		client: admissionapi.NewAdmissionServiceClient(conn),
+	}
+	if config.Mutating {
+		return &mutatingWebhook{w}, nil
+	}
+	return &validatingWebhook{w}, nil
+}
+
+// webhookDialOptions returns the options for dialing a webhook with the given TLS configuration
+func webhookDialOptions(config WebhookTLSConfig) ([]grpc.DialOption, error) {
+	if config.Plain {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.Insecure,
+	}
+	if config.Cert != "" || config.Key != "" {
+		cert, err := tls.LoadX509KeyPair(config.Cert, config.Key)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if config.CaCert != "" {
+		ca, err := ioutil.ReadFile(config.CaCert)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse CA certificate %s", config.CaCert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// webhook calls an external AdmissionService
+type webhook struct {
+	name   string
+	config WebhookConfig
+	conn   *grpc.ClientConn
+	client admissionapi.AdmissionServiceClient
+}
+
+func (w *webhook) Name() string {
+	return w.name
+}
+
+// admit calls the webhook, returning nil if the webhook failed and failures are ignored
+func (w *webhook) admit(ctx context.Context, request *Request) (*admissionapi.AdmissionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.config.Timeout)
+	defer cancel()
+	response, err := w.client.Admit(ctx, &admissionapi.AdmissionRequest{
+		Operation: request.Operation,
+		Device:    request.Device,
+		OldDevice: request.OldDevice,
+	})
+	if err != nil {
+		if w.config.FailurePolicy == FailurePolicyIgnore {
+			log.Warningf("Ignoring failure of admission webhook %s: %v", w.name, err)
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Unavailable, "admission webhook %s failed: %v", w.name, err)
+	}
+	if !response.Allowed {
+		return nil, status.Errorf(codes.InvalidArgument, "denied by %s: %s", w.name, response.Reason)
+	}
+	return response, nil
+}
+
+func (w *webhook) Close() error {
+	return w.conn.Close()
+}
+
+type mutatingWebhook struct {
+	*webhook
+}
+
+// Mutate replaces the request's device with the device returned by the webhook, if any.
+// The webhook cannot change the ID, namespace or revision of the device.
+func (w *mutatingWebhook) Mutate(ctx context.Context, request *Request) error {
+	response, err := w.admit(ctx, request)
+	if err != nil {
+		return err
+	}
+	if response != nil && response.Device != nil {
+		device := *response.Device
+		device.ID = request.Device.ID
+		device.Namespace = request.Device.Namespace
+		device.Revision = request.Device.Revision
+		*request.Device = device
+	}
+	return nil
+}
+
+type validatingWebhook struct {
+	*webhook
+}
+
+func (w *validatingWebhook) Validate(ctx context.Context, request *Request) error {
+	_, err := w.admit(ctx, request)
+	return err
+}
@@ -0,0 +1,141 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubAdmissionServer requires device IDs to be prefixed by their region, sets the device role and
+// moves the device to the namespace in its attributes
+type stubAdmissionServer struct {
+	requests []*admissionapi.AdmissionRequest
+}
+
+func (s *stubAdmissionServer) Admit(ctx context.Context, request *admissionapi.AdmissionRequest) (*admissionapi.AdmissionResponse, error) {
+	s.requests = append(s.requests, request)
+	device := request.Device
+	if region := device.Attributes["region"]; !strings.HasPrefix(string(device.ID), region+"-") {
+		return &admissionapi.AdmissionResponse{
+			Allowed: false,
+			Reason:  "device ID must be prefixed by the region",
+		}, nil
+	}
+	device.ID = "changed"
+	device.Namespace = device.Attributes["namespace"]
+	device.Role = "spine"
+	return &admissionapi.AdmissionResponse{
+		Allowed: true,
+		Device:  device,
+	}, nil
+}
+
+func startStubWebhook(t *testing.T) (*stubAdmissionServer, string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	stub := &stubAdmissionServer{}
+	s := grpc.NewServer()
+	admissionapi.RegisterAdmissionServiceServer(s, stub)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	return stub, lis.Addr().String(), s.Stop
+}
+
+func newTestRequest(id deviceapi.ID) *Request {
+	return &Request{
+		Operation: admissionapi.Operation_CREATE,
+		Device: &deviceapi.Device{
+			ID:         id,
+			Namespace:  "tenant-a",
+			Revision:   2,
+			Attributes: map[string]string{"region": "eu"},
+		},
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	stub, address, stop := startStubWebhook(t)
+	defer stop()
+
+	plugins, err := LoadPlugins([]Config{
+		{
+			Name: "inventory",
+			Type: PluginTypeWebhook,
+			Webhook: WebhookConfig{
+				Address:  address,
+				Mutating: true,
+				TLS:      WebhookTLSConfig{Plain: true},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	chain := NewChain(plugins...)
+	defer chain.Close()
+
+	request := newTestRequest("eu-leaf-1")
+	assert.NoError(t, chain.Admit(context.Background(), request))
+	assert.Equal(t, deviceapi.ID("eu-leaf-1"), request.Device.ID)
+	assert.Equal(t, "tenant-a", request.Device.Namespace)
+	assert.Equal(t, deviceapi.Revision(2), request.Device.Revision)
+	assert.Equal(t, deviceapi.Role("spine"), request.Device.Role)
+	assert.Len(t, stub.requests, 1)
+	assert.Equal(t, admissionapi.Operation_CREATE, stub.requests[0].Operation)
+
+	// The webhook cannot move the device to another namespace
+	request = newTestRequest("eu-leaf-2")
+	request.Device.Attributes["namespace"] = "tenant-b"
+	assert.NoError(t, chain.Admit(context.Background(), request))
+	assert.Equal(t, "tenant-a", request.Device.Namespace)
+
+	err = chain.Admit(context.Background(), newTestRequest("leaf-1"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "denied by inventory: device ID must be prefixed by the region")
+}
+
+func TestWebhookFailurePolicy(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := lis.Addr().String()
+	lis.Close()
+
+	fail, err := NewWebhook("fail", WebhookConfig{
+		Address: address,
+		TLS:     WebhookTLSConfig{Plain: true},
+	})
+	assert.NoError(t, err)
+	ignore, err := NewWebhook("ignore", WebhookConfig{
+		Address:       address,
+		FailurePolicy: FailurePolicyIgnore,
+		TLS:           WebhookTLSConfig{Plain: true},
+	})
+	assert.NoError(t, err)
+
+	chain := NewChain(ignore, fail)
+	defer chain.Close()
+	assert.NoError(t, NewChain(ignore).Admit(context.Background(), newTestRequest("eu-leaf-1")))
+	err = chain.Admit(context.Background(), newTestRequest("eu-leaf-1"))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,484 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/onosproject/onos-topo/api/device"
+	"github.com/spf13/cobra"
+)
+
+// maxSnapshotLineSize is the maximum size of a single snapshot entry read by restore
+const maxSnapshotLineSize = 16 * 1024 * 1024
+
+func getAdminCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin {backup,restore,log-level,trace,read-only} [args]",
+		Short: "Topology administration commands",
+	}
+	cmd.AddCommand(getAdminBackupCommand())
+	cmd.AddCommand(getAdminRestoreCommand())
+	cmd.AddCommand(getAdminLogLevelCommand())
+	cmd.AddCommand(getAdminTraceCommand())
+	cmd.AddCommand(getAdminReadOnlyCommand())
+	return cmd
+}
+
+func getAdminBackupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Args:  cobra.NoArgs,
+		Short: "Back up the topology to a snapshot file",
+		RunE:  runAdminBackupCommand,
+	}
+	cmd.Flags().StringP("output", "o", "", "the file to which to write the snapshot; defaults to stdout")
+	return cmd
+}
+
+func runAdminBackupCommand(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Backup(ctx, &admin.BackupRequest{})
+	if err != nil {
+		return err
+	}
+
+	var entries []*admin.SnapshotEntry
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		entries = append(entries, response.Entry)
+	}
+
+	// Write the snapshot only once it has been received in full to avoid leaving a truncated file
+	writer := GetOutput()
+	if output != "" && output != "-" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		writer = file
+	}
+	if err := writeSnapshot(writer, entries); err != nil {
+		return err
+	}
+	if output != "" && output != "-" && len(entries) > 0 {
+		header := entries[0].GetHeader()
+		Output("Backed up %d device types, %d devices and %d links to %s\n", header.GetDeviceTypes(), header.GetDevices(),
+			header.GetLinks(), output)
+	}
+	return nil
+}
+
+// writeSnapshot writes the snapshot entries as newline-delimited JSON
+func writeSnapshot(writer io.Writer, entries []*admin.SnapshotEntry) error {
+	marshaler := &jsonpb.Marshaler{}
+	for _, entry := range entries {
+		line, err := marshaler.MarshalToString(entry)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(writer, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSnapshot reads newline-delimited JSON snapshot entries
+func readSnapshot(reader io.Reader) ([]*admin.SnapshotEntry, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxSnapshotLineSize)
+	var entries []*admin.SnapshotEntry
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		entry := &admin.SnapshotEntry{}
+		if err := jsonpb.UnmarshalString(text, entry); err != nil {
+			return nil, fmt.Errorf("invalid snapshot entry on line %d: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func getAdminRestoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Args:  cobra.NoArgs,
+		Short: "Restore the topology from a snapshot file",
+		RunE:  runAdminRestoreCommand,
+	}
+	cmd.Flags().StringP("file", "f", "", "the snapshot file to restore")
+	cmd.Flags().String("mode", "merge", "the restore mode: 'merge' adds and updates resources, 'replace' also removes resources not in the snapshot")
+	cmd.Flags().Bool("dry-run", false, "report the changes the restore would make without applying them")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func runAdminRestoreCommand(cmd *cobra.Command, args []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	modeName, _ := cmd.Flags().GetString("mode")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	mode, ok := admin.RestoreMode_value[strings.ToUpper(modeName)]
+	if !ok {
+		return fmt.Errorf("unknown restore mode '%s'", modeName)
+	}
+
+	reader, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	entries, err := readSnapshot(reader)
+	if err != nil {
+		return err
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Restore(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		request := &admin.RestoreRequest{
+			Mode:   admin.RestoreMode(mode),
+			DryRun: dryRun,
+			Entry:  entry,
+		}
+		if err := stream.Send(request); err != nil {
+			return err
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	prefix := "Restored"
+	if response.DryRun {
+		prefix = "Dry run: would restore"
+	}
+	Output("%s device types: %d added, %d updated, %d removed\n", prefix,
+		response.DeviceTypesAdded, response.DeviceTypesUpdated, response.DeviceTypesRemoved)
+	Output("%s devices: %d added, %d updated, %d removed\n", prefix,
+		response.DevicesAdded, response.DevicesUpdated, response.DevicesRemoved)
+	Output("%s links: %d added, %d updated, %d removed\n", prefix,
+		response.LinksAdded, response.LinksUpdated, response.LinksRemoved)
+	return nil
+}
+
+func getAdminLogLevelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-level [verbosity]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Get or set the log verbosity of the serving replica",
+		RunE:  runAdminLogLevelCommand,
+	}
+	cmd.Flags().StringP("package", "p", "", "the package whose verbosity to set, e.g. pkg/manager")
+	cmd.Flags().Bool("clear", false, "clear the verbosity of the package so that it uses the global verbosity")
+	return cmd
+}
+
+func runAdminLogLevelCommand(cmd *cobra.Command, args []string) error {
+	pkg, _ := cmd.Flags().GetString("package")
+	clear, _ := cmd.Flags().GetBool("clear")
+	if clear && (pkg == "" || len(args) > 0) {
+		return fmt.Errorf("--clear requires --package and no verbosity")
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	var verbosity int32
+	var packages map[string]int32
+	if len(args) == 0 && !clear {
+		response, err := client.GetLogLevels(ctx, &admin.GetLogLevelsRequest{})
+		if err != nil {
+			return err
+		}
+		verbosity, packages = response.Verbosity, response.Packages
+	} else {
+		level := int64(-1)
+		if !clear {
+			level, err = strconv.ParseInt(args[0], 10, 32)
+			if err != nil || level < 0 {
+				return fmt.Errorf("invalid verbosity '%s'", args[0])
+			}
+		}
+		response, err := client.SetLogLevel(ctx, &admin.SetLogLevelRequest{
+			Package:   pkg,
+			Verbosity: int32(level),
+		})
+		if err != nil {
+			return err
+		}
+		verbosity, packages = response.Verbosity, response.Packages
+	}
+
+	names := make([]string, 0, len(packages))
+	for name := range packages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	fmt.Fprintln(writer, "PACKAGE\tVERBOSITY")
+	fmt.Fprintf(writer, "*\t%d\n", verbosity)
+	for _, name := range names {
+		fmt.Fprintf(writer, "%s\t%d\n", name, packages[name])
+	}
+	return writer.Flush()
+}
+
+func getAdminTraceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace {start,stop,list} [args]",
+		Short: "Manage request traces on the serving replica",
+	}
+
+	start := &cobra.Command{
+		Use:   "start",
+		Args:  cobra.NoArgs,
+		Short: "Start logging the requests concerning a device or made by a client",
+		RunE:  runAdminTraceStartCommand,
+	}
+	start.Flags().String("device", "", "the ID of the device whose requests to trace")
+	start.Flags().String("client", "", "a substring of the identity of the clients whose requests to trace, e.g. cn=onos-config")
+	start.Flags().Duration("duration", 0, "the duration of the trace; defaults to 5m and may not exceed 1h")
+	cmd.AddCommand(start)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "stop <id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Stop a request trace",
+		RunE:  runAdminTraceStopCommand,
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Args:  cobra.NoArgs,
+		Short: "List the active request traces",
+		RunE:  runAdminTraceListCommand,
+	})
+	return cmd
+}
+
+func runAdminTraceStartCommand(cmd *cobra.Command, args []string) error {
+	deviceID, _ := cmd.Flags().GetString("device")
+	clientID, _ := cmd.Flags().GetString("client")
+	duration, _ := cmd.Flags().GetDuration("duration")
+	if deviceID == "" && clientID == "" {
+		return fmt.Errorf("--device or --client is required")
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	response, err := client.StartTrace(ctx, &admin.StartTraceRequest{
+		DeviceID: device.ID(deviceID),
+		Client:   clientID,
+		Duration: duration,
+	})
+	if err != nil {
+		return err
+	}
+	Output("Started trace %d until %s\n", response.Trace.ID, response.Trace.Expires.Format(time.RFC3339))
+	return nil
+}
+
+func runAdminTraceStopCommand(cmd *cobra.Command, args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid trace ID '%s'", args[0])
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	if _, err := client.StopTrace(ctx, &admin.StopTraceRequest{ID: id}); err != nil {
+		return err
+	}
+	Output("Stopped trace %d\n", id)
+	return nil
+}
+
+func runAdminTraceListCommand(cmd *cobra.Command, args []string) error {
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	response, err := client.ListTraces(ctx, &admin.ListTracesRequest{})
+	if err != nil {
+		return err
+	}
+
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	fmt.Fprintln(writer, "ID\tDEVICE\tCLIENT\tEXPIRES")
+	for _, trace := range response.Traces {
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", trace.ID, valueOrAny(string(trace.DeviceID)), valueOrAny(trace.Client),
+			trace.Expires.Format(time.RFC3339))
+	}
+	return writer.Flush()
+}
+
+// valueOrAny returns the given value, or '*' if it is empty
+func valueOrAny(value string) string {
+	if value == "" {
+		return "*"
+	}
+	return value
+}
+
+func getAdminReadOnlyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       "read-only [on|off]",
+		Args:      cobra.OnlyValidArgs,
+		ValidArgs: []string{"on", "off"},
+		Short:     "Get or set the read-only mode of the topology",
+		RunE:      runAdminReadOnlyCommand,
+	}
+	cmd.Flags().String("reason", "", "the reason for the change, reported to clients whose writes are rejected")
+	return cmd
+}
+
+func runAdminReadOnlyCommand(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("accepts at most 1 arg(s), received %d", len(args))
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	var mode *admin.Mode
+	if len(args) == 0 {
+		response, err := client.GetMode(ctx, &admin.GetModeRequest{})
+		if err != nil {
+			return err
+		}
+		mode = response.Mode
+	} else {
+		reason, _ := cmd.Flags().GetString("reason")
+		response, err := client.SetMode(ctx, &admin.SetModeRequest{
+			ReadOnly: args[0] == "on",
+			Reason:   reason,
+		})
+		if err != nil {
+			return err
+		}
+		mode = response.Mode
+	}
+
+	state := "read-write"
+	if mode.ReadOnly {
+		state = "read-only"
+	}
+	setBy := mode.SetBy
+	if setBy == "" {
+		setBy = "-"
+	}
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	fmt.Fprintf(writer, "MODE\t%s\n", state)
+	fmt.Fprintf(writer, "REASON\t%s\n", mode.Reason)
+	fmt.Fprintf(writer, "SET BY\t%s\n", setBy)
+	fmt.Fprintf(writer, "UPDATED\t%s\n", timeString(mode.Updated))
+	return writer.Flush()
+}
@@ -0,0 +1,165 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for admin CLI
+package cli
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_BackupRestore(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	dir, err := ioutil.TempDir("", "onos-topo")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "topo.snapshot")
+
+	setUpMockClients()
+	mock := &mockTopoAdminServiceClient{
+		snapshot: []*admin.SnapshotEntry{
+			{Entry: &admin.SnapshotEntry_Header{Header: &admin.SnapshotHeader{Version: 1, DeviceTypes: 1, Devices: 1}}},
+			{Entry: &admin.SnapshotEntry_DeviceType{DeviceType: generateDeviceTypeData()}},
+			{Entry: &admin.SnapshotEntry_Device{Device: generateDeviceData(1)[0]}},
+		},
+	}
+	admin.TopoAdminServiceClientFactory = func(cc *grpc.ClientConn) admin.TopoAdminServiceClient {
+		return mock
+	}
+
+	backup := getAdminBackupCommand()
+	backup.SetArgs([]string{"-o", file})
+	assert.NilError(t, backup.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "Backed up 1 device types, 1 devices and 0 links"))
+
+	contents, err := ioutil.ReadFile(file)
+	assert.NilError(t, err)
+	assert.Equal(t, len(strings.Split(strings.TrimSpace(string(contents)), "\n")), 3)
+
+	outputBuffer.Reset()
+	restore := getAdminRestoreCommand()
+	restore.SetArgs([]string{"-f", file, "--mode", "replace", "--dry-run"})
+	assert.NilError(t, restore.Execute())
+	assert.Equal(t, len(mock.restored), 3)
+	assert.Equal(t, mock.restored[0].Mode, admin.RestoreMode_REPLACE)
+	assert.DeepEqual(t, mock.restored[2].Entry.GetDevice(), mock.snapshot[2].GetDevice())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "Dry run: would restore devices: 1 added"))
+
+	restore = getAdminRestoreCommand()
+	restore.SetArgs([]string{"-f", file, "--mode", "overwrite"})
+	assert.ErrorContains(t, restore.Execute(), "unknown restore mode")
+}
+
+func Test_LogLevel(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	admin.TopoAdminServiceClientFactory = func(cc *grpc.ClientConn) admin.TopoAdminServiceClient {
+		return &mockTopoAdminServiceClient{}
+	}
+
+	logLevel := getAdminLogLevelCommand()
+	logLevel.SetArgs([]string{})
+	assert.NilError(t, logLevel.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "*             1"))
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "pkg/manager   4"))
+
+	outputBuffer.Reset()
+	logLevel = getAdminLogLevelCommand()
+	logLevel.SetArgs([]string{"--package", "pkg/northbound/device", "3"})
+	assert.NilError(t, logLevel.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "pkg/northbound/device   3"))
+
+	outputBuffer.Reset()
+	logLevel = getAdminLogLevelCommand()
+	logLevel.SetArgs([]string{"--package", "pkg/northbound/device", "--clear"})
+	assert.NilError(t, logLevel.Execute())
+	assert.Assert(t, !strings.Contains(outputBuffer.String(), "pkg/northbound/device"))
+
+	logLevel = getAdminLogLevelCommand()
+	logLevel.SetArgs([]string{"--clear"})
+	assert.ErrorContains(t, logLevel.Execute(), "--clear requires --package")
+
+	logLevel = getAdminLogLevelCommand()
+	logLevel.SetArgs([]string{"high"})
+	assert.ErrorContains(t, logLevel.Execute(), "invalid verbosity")
+}
+
+func Test_Trace(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	admin.TopoAdminServiceClientFactory = func(cc *grpc.ClientConn) admin.TopoAdminServiceClient {
+		return &mockTopoAdminServiceClient{}
+	}
+
+	trace := getAdminTraceCommand()
+	trace.SetArgs([]string{"start", "--device", "device-1", "--duration", "5m"})
+	assert.NilError(t, trace.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "Started trace 1 until 2019-12-01T00:05:00Z"))
+
+	outputBuffer.Reset()
+	trace = getAdminTraceCommand()
+	trace.SetArgs([]string{"list"})
+	assert.NilError(t, trace.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "1    device-1   *"))
+
+	trace = getAdminTraceCommand()
+	trace.SetArgs([]string{"start"})
+	assert.ErrorContains(t, trace.Execute(), "--device or --client is required")
+}
+
+func Test_ReadOnly(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	mock := &mockTopoAdminServiceClient{}
+	admin.TopoAdminServiceClientFactory = func(cc *grpc.ClientConn) admin.TopoAdminServiceClient {
+		return mock
+	}
+
+	readOnly := getAdminReadOnlyCommand()
+	readOnly.SetArgs([]string{})
+	assert.NilError(t, readOnly.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "MODE      read-write"))
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "SET BY    -"))
+
+	outputBuffer.Reset()
+	readOnly = getAdminReadOnlyCommand()
+	readOnly.SetArgs([]string{"on", "--reason", "Atomix migration"})
+	assert.NilError(t, readOnly.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "MODE      read-only"))
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "REASON    Atomix migration"))
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "SET BY    cn=onos-cli"))
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "UPDATED   2019-12-01T00:00:00Z"))
+	assert.Equal(t, mock.mode.ReadOnly, true)
+
+	readOnly = getAdminReadOnlyCommand()
+	readOnly.SetArgs([]string{"maybe"})
+	assert.ErrorContains(t, readOnly.Execute(), "invalid argument")
+}
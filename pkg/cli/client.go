@@ -15,8 +15,11 @@
 package cli
 
 import (
+	"context"
 	"crypto/tls"
 	"github.com/onosproject/onos-topo/pkg/certs"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -61,9 +64,27 @@ func getConnection() (*grpc.ClientConn, error) {
 		}
 	}
 
+	if namespace := viper.GetString("namespace"); namespace != "" {
+		opts = append(opts, northbound.NamespaceDialOptions(namespace)...)
+	}
+
 	conn, err := grpc.Dial(address, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return conn, nil
 }
+
+// addAllNamespacesFlag adds the flag listing resources across all namespaces to the given command
+func addAllNamespacesFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("all-namespaces", "A", false, "whether to list the resources of all namespaces")
+}
+
+// withAllNamespaces returns a context listing resources across all namespaces if the command's
+// all-namespaces flag is set, and whether it is set
+func withAllNamespaces(ctx context.Context, cmd *cobra.Command) (context.Context, bool) {
+	if all, _ := cmd.Flags().GetBool("all-namespaces"); all {
+		return northbound.WithNamespace(ctx, northbound.AllNamespaces), true
+	}
+	return ctx, false
+}
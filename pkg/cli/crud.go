@@ -18,19 +18,23 @@ import "github.com/spf13/cobra"
 
 func getGetCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get {device} [args]",
+		Use:   "get {device,device-type,link} [args]",
 		Short: "Get topology resources",
 	}
 	cmd.AddCommand(getGetDeviceCommand())
+	cmd.AddCommand(getGetDeviceTypeCommand())
+	cmd.AddCommand(getGetLinkCommand())
 	return cmd
 }
 
 func getAddCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add {device} [args]",
+		Use:   "add {device,device-type,link} [args]",
 		Short: "Add a topology resource",
 	}
 	cmd.AddCommand(getAddDeviceCommand())
+	cmd.AddCommand(getAddDeviceTypeCommand())
+	cmd.AddCommand(getAddLinkCommand())
 	return cmd
 }
 
@@ -45,10 +49,12 @@ func getUpdateCommand() *cobra.Command {
 
 func getRemoveCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove {device} [args]",
+		Use:   "remove {device,device-type,link} [args]",
 		Short: "Remove a topology resource",
 	}
 	cmd.AddCommand(getRemoveDeviceCommand())
+	cmd.AddCommand(getRemoveDeviceTypeCommand())
+	cmd.AddCommand(getRemoveLinkCommand())
 	return cmd
 }
 
@@ -60,3 +66,12 @@ func getWatchCommand() *cobra.Command {
 	cmd.AddCommand(getWatchDeviceCommand())
 	return cmd
 }
+
+func getSetStateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-state {device} [args]",
+		Short: "Set the lifecycle state of a topology resource",
+	}
+	cmd.AddCommand(getSetStateDeviceCommand())
+	return cmd
+}
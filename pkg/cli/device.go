@@ -20,8 +20,12 @@ import (
 	"fmt"
 	"github.com/onosproject/onos-topo/api/device"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"io"
 	log "k8s.io/klog"
+	"sort"
+	"strings"
 	"text/tabwriter"
 	"time"
 )
@@ -36,12 +40,25 @@ func getGetDeviceCommand() *cobra.Command {
 	}
 	cmd.Flags().BoolP("verbose", "v", false, "whether to print the device with verbose output")
 	cmd.Flags().Bool("no-headers", false, "disables output headers")
+	cmd.Flags().String("versions", "", "a version range by which to filter devices, e.g. '<2.0.0'")
+	cmd.Flags().StringSlice("states", []string{}, "the lifecycle states by which to filter devices, e.g. maintenance")
+	addAllNamespacesFlag(cmd)
 	return cmd
 }
 
 func runGetDeviceCommand(cmd *cobra.Command, args []string) error {
 	verbose, _ := cmd.Flags().GetBool("verbose")
 	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+	versions, _ := cmd.Flags().GetString("versions")
+	stateNames, _ := cmd.Flags().GetStringSlice("states")
+	states := make([]device.LifecycleState, len(stateNames))
+	for i, name := range stateNames {
+		state, err := parseLifecycleState(name)
+		if err != nil {
+			return err
+		}
+		states[i] = state
+	}
 
 	conn, err := getConnection()
 	if err != nil {
@@ -55,7 +72,11 @@ func runGetDeviceCommand(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 	if len(args) == 0 {
-		stream, err := client.List(ctx, &device.ListRequest{})
+		ctx, allNamespaces := withAllNamespaces(ctx, cmd)
+		stream, err := client.List(ctx, &device.ListRequest{
+			Versions:        versions,
+			LifecycleStates: states,
+		})
 		if err != nil {
 			log.Error("list error ", err)
 			return err
@@ -64,10 +85,13 @@ func runGetDeviceCommand(cmd *cobra.Command, args []string) error {
 		writer.Init(outputWriter, 0, 0, 3, ' ', tabwriter.FilterHTML)
 
 		if !noHeaders {
+			if allNamespaces {
+				fmt.Fprint(writer, "NAMESPACE\t")
+			}
 			if verbose {
-				fmt.Fprintln(writer, "ID\tADDRESS\tVERSION\tSTATE\tUSER\tPASSWORD\tATTRIBUTES")
+				fmt.Fprintln(writer, "ID\tADDRESS\tVERSION\tLIFECYCLE\tSTATE\tUSER\tPASSWORD\tATTRIBUTES")
 			} else {
-				fmt.Fprintln(writer, "ID\tADDRESS\tVERSION\tSTATE")
+				fmt.Fprintln(writer, "ID\tADDRESS\tVERSION\tLIFECYCLE\tSTATE")
 			}
 		}
 
@@ -82,6 +106,9 @@ func runGetDeviceCommand(cmd *cobra.Command, args []string) error {
 
 			dev := response.Device
 			state := stateString(dev)
+			if allNamespaces {
+				fmt.Fprintf(writer, "%s\t", dev.Namespace)
+			}
 			if verbose {
 				attributesBuf := bytes.Buffer{}
 				for key, attribute := range dev.Attributes {
@@ -90,10 +117,10 @@ func runGetDeviceCommand(cmd *cobra.Command, args []string) error {
 					attributesBuf.WriteString(attribute)
 					attributesBuf.WriteString(", ")
 				}
-				fmt.Fprintln(writer, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s", dev.ID, dev.Address, dev.Version, state,
-					dev.Credentials.User, dev.Credentials.Password, attributesBuf.String()))
+				fmt.Fprintln(writer, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s", dev.ID, dev.Address, dev.Version, dev.LifecycleState,
+					state, dev.Credentials.User, dev.Credentials.Password, attributesBuf.String()))
 			} else {
-				fmt.Fprintln(writer, fmt.Sprintf("%s\t%s\t%s\t%s", dev.ID, dev.Address, dev.Version, state))
+				fmt.Fprintln(writer, fmt.Sprintf("%s\t%s\t%s\t%s\t%s", dev.ID, dev.Address, dev.Version, dev.LifecycleState, state))
 			}
 		}
 		writer.Flush()
@@ -112,9 +139,14 @@ func runGetDeviceCommand(cmd *cobra.Command, args []string) error {
 		writer.Init(outputWriter, 0, 0, 3, ' ', tabwriter.FilterHTML)
 		state := stateString(dev)
 		fmt.Fprintln(writer, fmt.Sprintf("ID\t%s", dev.ID))
+		fmt.Fprintln(writer, fmt.Sprintf("NAMESPACE\t%s", dev.Namespace))
 		fmt.Fprintln(writer, fmt.Sprintf("ADDRESS\t%s", dev.Address))
 		fmt.Fprintln(writer, fmt.Sprintf("VERSION\t%s", dev.Version))
+		fmt.Fprintln(writer, fmt.Sprintf("LIFECYCLE\t%s", dev.LifecycleState))
 		fmt.Fprintln(writer, fmt.Sprintf("STATE\t%s", state))
+		if len(dev.Endpoints) > 0 {
+			fmt.Fprintln(writer, fmt.Sprintf("ENDPOINTS\t%s", endpointsString(dev)))
+		}
 		if verbose {
 			fmt.Fprintln(writer, fmt.Sprintf("USER\t%s", dev.Credentials.User))
 			fmt.Fprintln(writer, fmt.Sprintf("PASSWORD\t%s", dev.Credentials.Password))
@@ -142,6 +174,40 @@ func stateString(dev *device.Device) string {
 	return stateBuf.String()
 }
 
+func endpointsString(dev *device.Device) string {
+	endpoints := make([]string, len(dev.Endpoints))
+	for i, endpoint := range dev.Endpoints {
+		endpoints[i] = fmt.Sprintf("%s=%s", endpoint.Protocol, dev.ResolveEndpoint(endpoint.Protocol).Address)
+	}
+	return strings.Join(endpoints, ",")
+}
+
+// setEndpoints sets the addresses of the device's endpoints from the given mapping of protocol names
+// to addresses, adding endpoints for protocols the device does not have an endpoint for
+func setEndpoints(dev *device.Device, addresses map[string]string) error {
+	names := make([]string, 0, len(addresses))
+	for name := range addresses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		protocol, ok := device.Protocol_value[strings.ToUpper(name)]
+		if !ok || protocol == int32(device.Protocol_UNKNOWN_PROTOCOL) {
+			return fmt.Errorf("unknown protocol '%s'", name)
+		}
+		if endpoint := dev.FindEndpoint(device.Protocol(protocol)); endpoint != nil {
+			endpoint.Address = addresses[name]
+		} else {
+			dev.Endpoints = append(dev.Endpoints, &device.Endpoint{
+				Protocol: device.Protocol(protocol),
+				Address:  addresses[name],
+			})
+		}
+	}
+	return nil
+}
+
 func getAddDeviceCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "device <id> [args]",
@@ -164,6 +230,8 @@ func getAddDeviceCommand() *cobra.Command {
 	cmd.Flags().Bool("insecure", false, "whether to enable skip verification")
 	cmd.Flags().Duration("timeout", 5*time.Second, "the device connection timeout")
 	cmd.Flags().StringToString("attributes", map[string]string{}, "an arbitrary mapping of device attributes")
+	cmd.Flags().StringToString("endpoint", map[string]string{}, "the address of a protocol endpoint, e.g. gnmi=host:9339")
+	cmd.Flags().String("state", "in_service", "the lifecycle state of the device")
 
 	_ = cmd.MarkFlagRequired("version")
 	_ = cmd.MarkFlagRequired("type")
@@ -186,6 +254,12 @@ func runAddDeviceCommand(cmd *cobra.Command, args []string) error {
 	insecure, _ := cmd.Flags().GetBool("insecure")
 	timeout, _ := cmd.Flags().GetDuration("timeout")
 	attributes, _ := cmd.Flags().GetStringToString("attributes")
+	endpoints, _ := cmd.Flags().GetStringToString("endpoint")
+	stateName, _ := cmd.Flags().GetString("state")
+	state, err := parseLifecycleState(stateName)
+	if err != nil {
+		return err
+	}
 
 	// Target defaults to the ID
 	if deviceTarget == "" {
@@ -219,7 +293,11 @@ func runAddDeviceCommand(cmd *cobra.Command, args []string) error {
 			Plain:    plain,
 			Insecure: insecure,
 		},
-		Attributes: attributes,
+		Attributes:     attributes,
+		LifecycleState: state,
+	}
+	if err := setEndpoints(dev, endpoints); err != nil {
+		return err
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -257,6 +335,7 @@ func getUpdateDeviceCommand() *cobra.Command {
 	cmd.Flags().Bool("insecure", false, "whether to enable skip verification")
 	cmd.Flags().Duration("timeout", 30*time.Second, "the device connection timeout")
 	cmd.Flags().StringToString("attributes", map[string]string{}, "an arbitrary mapping of device attributes")
+	cmd.Flags().StringToString("endpoint", map[string]string{}, "the address of a protocol endpoint, e.g. gnmi=host:9339")
 	return cmd
 }
 
@@ -265,91 +344,179 @@ func runUpdateDeviceCommand(cmd *cobra.Command, args []string) error {
 
 	conn, err := getConnection()
 	if err != nil {
-		return nil
+		return err
 	}
 	defer conn.Close()
 
 	client := device.CreateDeviceServiceClient(conn)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-
-	response, err := client.Get(ctx, &device.GetRequest{
-		ID: device.ID(id),
+	_, err = updateDevice(client, device.ID(id), func(dev *device.Device) error {
+		return applyUpdateFlags(cmd, dev)
 	})
 	if err != nil {
 		return err
 	}
+	Output("Updated device %s", id)
+	return nil
+}
 
-	cancel()
-	dvc := response.Device
-
+// applyUpdateFlags applies the flags set on the update command to the device
+func applyUpdateFlags(cmd *cobra.Command, dev *device.Device) error {
 	if cmd.Flags().Changed("type") {
 		deviceType, _ := cmd.Flags().GetString("type")
-		dvc.Type = device.Type(deviceType)
+		dev.Type = device.Type(deviceType)
 	}
 	if cmd.Flags().Changed("target") {
 		deviceTarget, _ := cmd.Flags().GetString("target")
-		dvc.Target = deviceTarget
+		dev.Target = deviceTarget
 	}
 	if cmd.Flags().Changed("role") {
 		deviceRole, _ := cmd.Flags().GetString("role")
-		dvc.Role = device.Role(deviceRole)
+		dev.Role = device.Role(deviceRole)
 	}
 	if cmd.Flags().Changed("address") {
 		address, _ := cmd.Flags().GetString("address")
-		dvc.Address = address
+		dev.Address = address
 	}
 	if cmd.Flags().Changed("user") {
 		user, _ := cmd.Flags().GetString("user")
-		dvc.Credentials.User = user
+		dev.Credentials.User = user
 	}
 	if cmd.Flags().Changed("password") {
 		password, _ := cmd.Flags().GetString("password")
-		dvc.Credentials.Password = password
+		dev.Credentials.Password = password
 	}
 	if cmd.Flags().Changed("version") {
 		version, _ := cmd.Flags().GetString("version")
-		dvc.Version = version
+		dev.Version = version
 	}
 	if cmd.Flags().Changed("key") {
 		key, _ := cmd.Flags().GetString("key")
-		dvc.TLS.Key = key
+		dev.TLS.Key = key
 	}
 	if cmd.Flags().Changed("cert") {
 		cert, _ := cmd.Flags().GetString("cert")
-		dvc.TLS.Cert = cert
+		dev.TLS.Cert = cert
 	}
 	if cmd.Flags().Changed("ca-cert") {
 		caCert, _ := cmd.Flags().GetString("ca-cert")
-		dvc.TLS.CaCert = caCert
+		dev.TLS.CaCert = caCert
 	}
 	if cmd.Flags().Changed("plain") {
 		plain, _ := cmd.Flags().GetBool("plain")
-		dvc.TLS.Plain = plain
+		dev.TLS.Plain = plain
 	}
 	if cmd.Flags().Changed("insecure") {
 		insecure, _ := cmd.Flags().GetBool("insecure")
-		dvc.TLS.Insecure = insecure
+		dev.TLS.Insecure = insecure
 	}
 	if cmd.Flags().Changed("timeout") {
 		timeout, _ := cmd.Flags().GetDuration("timeout")
-		dvc.Timeout = &timeout
+		dev.Timeout = &timeout
 	}
 	if cmd.Flags().Changed("attributes") {
 		attributes, _ := cmd.Flags().GetStringToString("attributes")
-		dvc.Attributes = attributes
+		dev.Attributes = attributes
+	}
+	if cmd.Flags().Changed("endpoint") {
+		endpoints, _ := cmd.Flags().GetStringToString("endpoint")
+		if err := setEndpoints(dev, endpoints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxUpdateAttempts is the number of times a device update is attempted when the device is concurrently modified
+const maxUpdateAttempts = 5
+
+// updateDevice reads the device, applies the given mutation and writes the device back, retrying the
+// read-modify-write if the device is concurrently modified
+func updateDevice(client device.DeviceServiceClient, id device.ID, mutate func(*device.Device) error) (*device.Device, error) {
+	var err error
+	for attempt := 1; attempt <= maxUpdateAttempts; attempt++ {
+		var dev *device.Device
+		dev, err = tryUpdateDevice(client, id, mutate)
+		if err == nil {
+			return dev, nil
+		} else if status.Code(err) != codes.Aborted {
+			return nil, err
+		}
+		log.Infof("Device %s was modified concurrently; retrying update", id)
 	}
+	return nil, err
+}
 
-	ctx, cancel = context.WithTimeout(context.Background(), 15*time.Second)
+// tryUpdateDevice makes a single attempt to read, mutate and write the device
+func tryUpdateDevice(client device.DeviceServiceClient, id device.ID, mutate func(*device.Device) error) (*device.Device, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
-	_, err = client.Update(ctx, &device.UpdateRequest{
-		Device: dvc,
+	response, err := client.Get(ctx, &device.GetRequest{
+		ID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dev := response.Device
+	if err := mutate(dev); err != nil {
+		return nil, err
+	}
+	updateResponse, err := client.Update(ctx, &device.UpdateRequest{
+		Device: dev,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse.Device, nil
+}
+
+// parseLifecycleState parses a case insensitive lifecycle state name, e.g. maintenance
+func parseLifecycleState(name string) (device.LifecycleState, error) {
+	state, ok := device.LifecycleState_value[strings.ToUpper(strings.Replace(name, "-", "_", -1))]
+	if !ok {
+		return 0, fmt.Errorf("unknown lifecycle state '%s'", name)
+	}
+	return device.LifecycleState(state), nil
+}
+
+func getSetStateDeviceCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "device <id> <state>",
+		Aliases: []string{"devices"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Set the lifecycle state of a device",
+		Long: "Set the lifecycle state of a device to one of planned, provisioning, in-service, maintenance\n" +
+			"or decommissioned. The transition must be allowed from the current state of the device.",
+		RunE: runSetStateDeviceCommand,
+	}
+}
+
+func runSetStateDeviceCommand(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	state, err := parseLifecycleState(args[1])
+	if err != nil {
+		return err
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := device.CreateDeviceServiceClient(conn)
+	_, err = updateDevice(client, device.ID(id), func(dev *device.Device) error {
+		if !dev.LifecycleState.CanTransitionTo(state) {
+			return fmt.Errorf("device %s cannot transition from %s to %s", id, dev.LifecycleState, state)
+		}
+		dev.LifecycleState = state
+		return nil
 	})
 	if err != nil {
 		return err
 	}
-	Output("Updated device %s", id)
+	Output("Device %s is %s", id, state)
 	return nil
 }
 
@@ -17,8 +17,12 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/onosproject/onos-topo/api/device"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gotest.tools/assert"
 	"strings"
 	"testing"
@@ -72,7 +76,7 @@ func Test_AddDevice(t *testing.T) {
 
 	setUpMockClients()
 	addDevice := getAddDeviceCommand()
-	args := make([]string, 7)
+	args := make([]string, 8)
 	args[0] = "test-device-1" // Name
 	args[1] = fmt.Sprintf("--type=%s", deviceType)
 	args[2] = fmt.Sprintf("--version=%s", version)
@@ -80,6 +84,7 @@ func Test_AddDevice(t *testing.T) {
 	args[4] = "--timeout=1s"
 	args[5] = "--user=test"
 	args[6] = "--role=leaf"
+	args[7] = "--endpoint=p4runtime=192.168.0.1:9559"
 	addDevice.SetArgs(args)
 	err := addDevice.Execute()
 	assert.NilError(t, err)
@@ -122,3 +127,77 @@ func Test_RemoveDevice(t *testing.T) {
 	output := outputBuffer.String()
 	assert.Equal(t, output, "Removed device test-device-1")
 }
+
+func Test_SetEndpoints(t *testing.T) {
+	dev := generateDeviceData(1)[0]
+	dev.Endpoints = []*device.Endpoint{
+		{Protocol: device.Protocol_GNMI, Address: "10.0.0.1:9339"},
+	}
+	err := setEndpoints(dev, map[string]string{
+		"gnmi":      "[2001:db8::1]:9339",
+		"p4runtime": "[2001:db8::1]:9559",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(dev.Endpoints), 2)
+	assert.Equal(t, dev.Endpoints[0].Address, "[2001:db8::1]:9339")
+	assert.Equal(t, dev.Endpoints[1].Protocol, device.Protocol_P4RUNTIME)
+	assert.Equal(t, endpointsString(dev), "GNMI=[2001:db8::1]:9339,P4RUNTIME=[2001:db8::1]:9559")
+
+	err = setEndpoints(dev, map[string]string{"foo": "10.0.0.1:1234"})
+	assert.ErrorContains(t, err, "unknown protocol 'foo'")
+}
+
+func Test_SetStateDevice(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	setState := getSetStateDeviceCommand()
+	setState.SetArgs([]string{"test-device-1", "maintenance"})
+	err := setState.Execute()
+	assert.NilError(t, err)
+	assert.Equal(t, outputBuffer.String(), "Device test-device-1 is MAINTENANCE")
+
+	setState = getSetStateDeviceCommand()
+	setState.SetArgs([]string{"test-device-1", "planned"})
+	err = setState.Execute()
+	assert.ErrorContains(t, err, "cannot transition from IN_SERVICE to PLANNED")
+
+	setState = getSetStateDeviceCommand()
+	setState.SetArgs([]string{"test-device-1", "foo"})
+	err = setState.Execute()
+	assert.ErrorContains(t, err, "unknown lifecycle state 'foo'")
+}
+
+// conflictingDeviceServiceClient fails the given number of updates with a revision conflict
+type conflictingDeviceServiceClient struct {
+	mockDeviceServiceClient
+	conflicts int
+	updates   int
+}
+
+func (m *conflictingDeviceServiceClient) Update(ctx context.Context, request *device.UpdateRequest, opts ...grpc.CallOption) (*device.UpdateResponse, error) {
+	m.updates++
+	if m.updates <= m.conflicts {
+		return nil, device.NewRevisionConflict("device", string(request.Device.ID), request.Device.Revision, request.Device.Revision+1)
+	}
+	return m.mockDeviceServiceClient.Update(ctx, request, opts...)
+}
+
+func Test_UpdateDeviceRetry(t *testing.T) {
+	client := &conflictingDeviceServiceClient{conflicts: 2}
+	dev, err := updateDevice(client, "test-device-0", func(dev *device.Device) error {
+		dev.Role = "spine"
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, client.updates, 3)
+	assert.Equal(t, dev.Role, device.Role("spine"))
+
+	client = &conflictingDeviceServiceClient{conflicts: maxUpdateAttempts}
+	_, err = updateDevice(client, "test-device-0", func(dev *device.Device) error {
+		return nil
+	})
+	assert.Equal(t, status.Code(err), codes.Aborted)
+	assert.Equal(t, client.updates, maxUpdateAttempts)
+}
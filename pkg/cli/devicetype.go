@@ -0,0 +1,250 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/device"
+	"github.com/spf13/cobra"
+)
+
+func getGetDeviceTypeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "device-type <name>",
+		Aliases: []string{"device-types"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Get a device type",
+		RunE:    runGetDeviceTypeCommand,
+	}
+	cmd.Flags().Bool("no-headers", false, "disables output headers")
+	return cmd
+}
+
+func runGetDeviceTypeCommand(cmd *cobra.Command, args []string) error {
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := device.CreateDeviceTypeServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	if len(args) == 0 {
+		response, err := client.List(ctx, &device.ListDeviceTypesRequest{})
+		if err != nil {
+			return err
+		}
+		if !noHeaders {
+			fmt.Fprintln(writer, "NAME\tVERSIONS\tPROTOCOLS\tTIMEOUT\tATTRIBUTES")
+		}
+		for _, deviceType := range response.DeviceTypes {
+			fmt.Fprintln(writer, fmt.Sprintf("%s\t%s\t%s\t%s\t%s", deviceType.Name, deviceType.Versions,
+				protocolsString(deviceType.Protocols), timeoutString(deviceType.Timeout), attributesString(deviceType.Attributes)))
+		}
+	} else {
+		response, err := client.Get(ctx, &device.GetDeviceTypeRequest{
+			Name: device.Type(args[0]),
+		})
+		if err != nil {
+			return err
+		}
+		deviceType := response.DeviceType
+		fmt.Fprintln(writer, fmt.Sprintf("NAME\t%s", deviceType.Name))
+		fmt.Fprintln(writer, fmt.Sprintf("VERSIONS\t%s", deviceType.Versions))
+		fmt.Fprintln(writer, fmt.Sprintf("PROTOCOLS\t%s", protocolsString(deviceType.Protocols)))
+		fmt.Fprintln(writer, fmt.Sprintf("TIMEOUT\t%s", timeoutString(deviceType.Timeout)))
+		fmt.Fprintln(writer, fmt.Sprintf("ATTRIBUTES\t%s", attributesString(deviceType.Attributes)))
+	}
+	return writer.Flush()
+}
+
+func protocolsString(protocols []device.Protocol) string {
+	names := make([]string, len(protocols))
+	for i, protocol := range protocols {
+		names[i] = protocol.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func timeoutString(timeout *time.Duration) string {
+	if timeout == nil {
+		return ""
+	}
+	return timeout.String()
+}
+
+// attributesString formats attribute schemas as name:TYPE, suffixed with ! if required and =~pattern if constrained
+func attributesString(attributes []*device.AttributeSchema) string {
+	values := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		value := fmt.Sprintf("%s:%s", attribute.Name, attribute.Type)
+		if attribute.Required {
+			value += "!"
+		}
+		if attribute.Pattern != "" {
+			value += "=~" + attribute.Pattern
+		}
+		values[i] = value
+	}
+	return strings.Join(values, ", ")
+}
+
+func getAddDeviceTypeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "device-type <name> [args]",
+		Aliases: []string{"device-types"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Add a device type",
+		RunE:    runAddDeviceTypeCommand,
+	}
+	cmd.Flags().String("versions", "", "the semantic version range of devices of the type, e.g. '>=1.0.0 <2.0.0' or '1.x'")
+	cmd.Flags().StringSlice("required", []string{}, "the attributes required on devices of the type")
+	cmd.Flags().StringSlice("optional", []string{}, "the optional attributes of devices of the type")
+	cmd.Flags().StringToString("attribute-types", map[string]string{}, "a mapping of attributes to value types: string, integer, boolean or float")
+	cmd.Flags().StringToString("attribute-patterns", map[string]string{}, "a mapping of attributes to regular expressions their values must match")
+	cmd.Flags().StringSlice("protocols", []string{}, "the protocols of devices of the type that do not declare any")
+	cmd.Flags().Duration("timeout", 0, "the timeout of devices of the type that do not specify one")
+	return cmd
+}
+
+func runAddDeviceTypeCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	versions, _ := cmd.Flags().GetString("versions")
+	required, _ := cmd.Flags().GetStringSlice("required")
+	optional, _ := cmd.Flags().GetStringSlice("optional")
+	attributeTypes, _ := cmd.Flags().GetStringToString("attribute-types")
+	attributePatterns, _ := cmd.Flags().GetStringToString("attribute-patterns")
+	protocolNames, _ := cmd.Flags().GetStringSlice("protocols")
+
+	deviceType := &device.DeviceType{
+		Name:     device.Type(name),
+		Versions: versions,
+	}
+	if cmd.Flags().Changed("timeout") {
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		deviceType.Timeout = &timeout
+	}
+
+	for _, protocolName := range protocolNames {
+		protocol, ok := device.Protocol_value[strings.ToUpper(protocolName)]
+		if !ok {
+			return fmt.Errorf("unknown protocol '%s'", protocolName)
+		}
+		deviceType.Protocols = append(deviceType.Protocols, device.Protocol(protocol))
+	}
+
+	attributes := make(map[string]*device.AttributeSchema)
+	for _, attribute := range required {
+		attributes[attribute] = &device.AttributeSchema{Name: attribute, Required: true}
+	}
+	for _, attribute := range optional {
+		if _, ok := attributes[attribute]; !ok {
+			attributes[attribute] = &device.AttributeSchema{Name: attribute}
+		}
+	}
+	for attribute, typeName := range attributeTypes {
+		schema, ok := attributes[attribute]
+		if !ok {
+			return fmt.Errorf("attribute '%s' is neither required nor optional", attribute)
+		}
+		attributeType, ok := device.AttributeType_value[strings.ToUpper(typeName)]
+		if !ok {
+			return fmt.Errorf("unknown attribute type '%s'", typeName)
+		}
+		schema.Type = device.AttributeType(attributeType)
+	}
+	for attribute, pattern := range attributePatterns {
+		schema, ok := attributes[attribute]
+		if !ok {
+			return fmt.Errorf("attribute '%s' is neither required nor optional", attribute)
+		}
+		schema.Pattern = pattern
+	}
+	for _, schema := range attributes {
+		deviceType.Attributes = append(deviceType.Attributes, schema)
+	}
+	sort.Slice(deviceType.Attributes, func(i, j int) bool {
+		return deviceType.Attributes[i].Name < deviceType.Attributes[j].Name
+	})
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := device.CreateDeviceTypeServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err = client.Add(ctx, &device.AddDeviceTypeRequest{
+		DeviceType: deviceType,
+	})
+	if err != nil {
+		return err
+	}
+	Output("Added device type %s", name)
+	return nil
+}
+
+func getRemoveDeviceTypeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "device-type <name>",
+		Aliases: []string{"device-types"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Remove a device type",
+		RunE:    runRemoveDeviceTypeCommand,
+	}
+}
+
+func runRemoveDeviceTypeCommand(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := device.CreateDeviceTypeServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err = client.Remove(ctx, &device.RemoveDeviceTypeRequest{
+		DeviceType: &device.DeviceType{
+			Name: device.Type(name),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	Output("Removed device type %s", name)
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for device type CLI
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/device"
+	"gotest.tools/assert"
+)
+
+func generateDeviceTypeData() *device.DeviceType {
+	timeout := 10 * time.Second
+	return &device.DeviceType{
+		Name:     deviceType,
+		Revision: 1,
+		Versions: "1.x",
+		Attributes: []*device.AttributeSchema{
+			{Name: "pipeconf", Required: true},
+			{Name: "deviceId", Type: device.AttributeType_INTEGER},
+		},
+		Protocols: []device.Protocol{device.Protocol_P4RUNTIME},
+		Timeout:   &timeout,
+	}
+}
+
+func Test_GetDeviceTypes(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	getDeviceTypes := getGetDeviceTypeCommand()
+	getDeviceTypes.SetArgs([]string{})
+	err := getDeviceTypes.Execute()
+	assert.NilError(t, err)
+	output := outputBuffer.String()
+	assert.Assert(t, strings.Contains(output, "TestDevice   1.x"))
+	assert.Assert(t, strings.Contains(output, "pipeconf:STRING!, deviceId:INTEGER"))
+}
+
+func Test_AddDeviceType(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	addDeviceType := getAddDeviceTypeCommand()
+	addDeviceType.SetArgs([]string{
+		deviceType,
+		"--versions=1.x",
+		"--required=pipeconf",
+		"--optional=deviceId",
+		"--attribute-types=deviceId=integer",
+		"--protocols=p4runtime,gnmi",
+	})
+	err := addDeviceType.Execute()
+	assert.NilError(t, err)
+	assert.Equal(t, outputBuffer.String(), "Added device type TestDevice")
+
+	addDeviceType = getAddDeviceTypeCommand()
+	addDeviceType.SetArgs([]string{deviceType, "--attribute-types=foo=integer"})
+	assert.ErrorContains(t, addDeviceType.Execute(), "neither required nor optional")
+}
+
+func Test_RemoveDeviceType(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	removeDeviceType := getRemoveDeviceTypeCommand()
+	removeDeviceType.SetArgs([]string{deviceType})
+	err := removeDeviceType.Execute()
+	assert.NilError(t, err)
+	assert.Equal(t, outputBuffer.String(), "Removed device type TestDevice")
+}
@@ -0,0 +1,212 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/diags"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// diagsSection prints a section of the diagnostics output
+type diagsSection func(ctx context.Context, client diags.TopoDiagsClient, writer io.Writer) error
+
+func getDiagsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diags [{store,devices,subscribers,latency}]",
+		Args:  cobra.NoArgs,
+		Short: "Show diagnostic information about the topology service",
+		RunE: runDiagsCommand(
+			printStoreStatus,
+			printDeviceCounts,
+			printSubscribers,
+			printLatency),
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "store",
+		Args:  cobra.NoArgs,
+		Short: "Show the health of the store backend and its session",
+		RunE:  runDiagsCommand(printStoreStatus),
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "devices",
+		Args:  cobra.NoArgs,
+		Short: "Show the number of devices by type, role and state",
+		RunE:  runDiagsCommand(printDeviceCounts),
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "subscribers",
+		Args:  cobra.NoArgs,
+		Short: "Show the active device subscribers of the serving replica",
+		RunE:  runDiagsCommand(printSubscribers),
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "latency",
+		Args:  cobra.NoArgs,
+		Short: "Show RPC latency summaries of the serving replica",
+		RunE:  runDiagsCommand(printLatency),
+	})
+	return cmd
+}
+
+// runDiagsCommand returns a command function printing the given sections separated by blank lines. When
+// several sections are printed, the sections restricted to administrators are skipped for other clients.
+func runDiagsCommand(sections ...diagsSection) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		conn, err := getConnection()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		client := diags.CreateTopoDiagsClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		outputWriter := GetOutput()
+		printed := 0
+		for _, section := range sections {
+			buf := &bytes.Buffer{}
+			writer := new(tabwriter.Writer)
+			writer.Init(buf, 0, 0, 3, ' ', tabwriter.FilterHTML)
+			if err := section(ctx, client, writer); err != nil {
+				if len(sections) > 1 && status.Code(err) == codes.PermissionDenied {
+					continue
+				}
+				return err
+			}
+			writer.Flush()
+			if printed > 0 {
+				fmt.Fprintln(outputWriter)
+			}
+			_, _ = buf.WriteTo(outputWriter)
+			printed++
+		}
+		return nil
+	}
+}
+
+func printStoreStatus(ctx context.Context, client diags.TopoDiagsClient, writer io.Writer) error {
+	response, err := client.GetStoreStatus(ctx, &diags.GetStoreStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	health := "HEALTHY"
+	if !response.Healthy {
+		health = "UNHEALTHY: " + response.Error
+	}
+	fmt.Fprintf(writer, "STORE\t%s\n", response.Store.Type)
+	fmt.Fprintf(writer, "CONTROLLER\t%s\n", response.Store.Controller)
+	fmt.Fprintf(writer, "GROUP\t%s\n", response.Store.Group)
+	fmt.Fprintf(writer, "HEALTH\t%s\n", health)
+	fmt.Fprintf(writer, "PROBE LATENCY\t%s\n", response.ProbeLatency)
+	fmt.Fprintf(writer, "SESSION\t%s\n", response.Session.State)
+	fmt.Fprintf(writer, "SESSION TIMEOUT\t%s\n", response.Store.SessionTimeout)
+	fmt.Fprintf(writer, "LAST ACTIVE\t%s\n", timeString(response.Session.LastActive))
+	fmt.Fprintln(writer)
+
+	fmt.Fprintln(writer, "PRIMITIVE\tOPERATIONS\tERRORS\tCONFLICTS\tLAST SUCCESS\tLAST FAILURE\tLAST ERROR")
+	for _, primitive := range response.Primitives {
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%d\t%s\t%s\t%s\n", primitive.Name, primitive.Operations, primitive.Errors,
+			primitive.Conflicts, timeString(primitive.LastSuccess), timeString(primitive.LastFailure), primitive.LastError)
+	}
+	return nil
+}
+
+func printDeviceCounts(ctx context.Context, client diags.TopoDiagsClient, writer io.Writer) error {
+	response, err := client.GetDeviceCounts(ctx, &diags.GetDeviceCountsRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(writer, "TYPE\tROLE\tSTATE\tLIFECYCLE\tDEVICES")
+	for _, count := range response.Counts {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\n", count.Type, count.Role, count.ConnectivityState, count.LifecycleState, count.Count)
+	}
+	fmt.Fprintf(writer, "TOTAL\t\t\t\t%d\n", response.Total)
+	return nil
+}
+
+func printSubscribers(ctx context.Context, client diags.TopoDiagsClient, writer io.Writer) error {
+	response, err := client.ListSubscribers(ctx, &diags.ListSubscribersRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(writer, "ID\tCLIENT\tFILTER\tSTARTED\tEVENTS\tLAST EVENT\tQUEUE")
+	for _, subscriber := range response.Subscribers {
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%d\t%s\t%d/%d\n", subscriber.ID, subscriber.Client, filterString(subscriber),
+			timeString(&subscriber.Started), subscriber.EventsSent, timeString(subscriber.LastEvent),
+			subscriber.QueueDepth, subscriber.QueueCapacity)
+	}
+	return nil
+}
+
+func printLatency(ctx context.Context, client diags.TopoDiagsClient, writer io.Writer) error {
+	response, err := client.GetLatency(ctx, &diags.GetLatencyRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(writer, "SERVICE\tMETHOD\tCALLS\tERRORS\tMEAN\tP50\tP90\tP99\tMAX")
+	for _, method := range response.Methods {
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n", method.Service, method.Method, method.Count, method.Errors,
+			method.Mean, method.P50, method.P90, method.P99, method.Max)
+	}
+	return nil
+}
+
+// filterString returns a summary of the List filters of the given subscriber
+func filterString(subscriber *diags.Subscriber) string {
+	filter := subscriber.Filter
+	if filter == nil || (filter.Versions == "" && len(filter.LifecycleStates) == 0) {
+		return "-"
+	}
+	var s string
+	if filter.Versions != "" {
+		s = "versions=" + filter.Versions
+	}
+	if len(filter.LifecycleStates) > 0 {
+		if s != "" {
+			s += " "
+		}
+		s += "states="
+		for i, state := range filter.LifecycleStates {
+			if i > 0 {
+				s += ","
+			}
+			s += state.String()
+		}
+	}
+	return s
+}
+
+// timeString formats the given time, or '-' if the time is not set
+func timeString(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,57 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for diags CLI
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_Diags(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	diags := getDiagsCommand()
+	diags.SetArgs([]string{})
+	assert.NilError(t, diags.Execute())
+
+	output := outputBuffer.String()
+	assert.Assert(t, strings.Contains(output, "HEALTH            HEALTHY"))
+	assert.Assert(t, strings.Contains(output, "SESSION           ACTIVE"))
+	assert.Assert(t, strings.Contains(output, "devices     10"))
+	assert.Assert(t, strings.Contains(output, "Stratum   leaf   REACHABLE   IN_SERVICE   2"))
+	assert.Assert(t, strings.Contains(output, "10.0.0.1:5000 cn=onos-config   versions=1.x"))
+	assert.Assert(t, strings.Contains(output, "3/64"))
+	assert.Assert(t, strings.Contains(output, "topo.device.DeviceService   Get      3"))
+}
+
+func Test_DiagsSubscribers(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	diags := getDiagsCommand()
+	diags.SetArgs([]string{"subscribers"})
+	assert.NilError(t, diags.Execute())
+
+	output := outputBuffer.String()
+	assert.Assert(t, strings.Contains(output, "cn=onos-config"))
+	assert.Assert(t, !strings.Contains(output, "HEALTH"))
+}
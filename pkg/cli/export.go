@@ -0,0 +1,111 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/onosproject/onos-topo/api/device"
+	"github.com/spf13/cobra"
+)
+
+// exportFormats are the names of the export formats accepted by the export command
+var exportFormats = map[string]admin.ExportFormat{
+	"dot":        admin.ExportFormat_DOT,
+	"graphml":    admin.ExportFormat_GRAPHML,
+	"json-graph": admin.ExportFormat_JSON_GRAPH,
+}
+
+func getExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Export the topology as a graph for visualization",
+		Long: "Export the devices and the links between them as a Graphviz DOT, GraphML or JSON Graph Format\n" +
+			"document. Devices are labelled by ID, type, role and connectivity state; unreachable devices\n" +
+			"are drawn red and devices that are not in service are drawn dashed.",
+		RunE: runExportCommand,
+	}
+	cmd.Flags().String("format", "dot", "the format of the graph: dot, graphml or json-graph")
+	cmd.Flags().StringP("output", "o", "", "the file to which to write the graph; defaults to stdout")
+	cmd.Flags().StringSlice("devices", []string{}, "the IDs of the devices to export")
+	cmd.Flags().StringSlice("types", []string{}, "the types by which to filter devices")
+	cmd.Flags().StringSlice("roles", []string{}, "the roles by which to filter devices")
+	cmd.Flags().StringSlice("states", []string{}, "the lifecycle states by which to filter devices, e.g. in-service")
+	return cmd
+}
+
+func runExportCommand(cmd *cobra.Command, args []string) error {
+	formatName, _ := cmd.Flags().GetString("format")
+	output, _ := cmd.Flags().GetString("output")
+	ids, _ := cmd.Flags().GetStringSlice("devices")
+	types, _ := cmd.Flags().GetStringSlice("types")
+	roles, _ := cmd.Flags().GetStringSlice("roles")
+	stateNames, _ := cmd.Flags().GetStringSlice("states")
+
+	format, ok := exportFormats[formatName]
+	if !ok {
+		return fmt.Errorf("unknown export format '%s'", formatName)
+	}
+	request := &admin.ExportRequest{
+		Format: format,
+	}
+	for _, id := range ids {
+		request.Devices = append(request.Devices, device.ID(id))
+	}
+	for _, t := range types {
+		request.Types = append(request.Types, device.Type(t))
+	}
+	for _, role := range roles {
+		request.Roles = append(request.Roles, device.Role(role))
+	}
+	for _, name := range stateNames {
+		state, err := parseLifecycleState(name)
+		if err != nil {
+			return err
+		}
+		request.LifecycleStates = append(request.LifecycleStates, state)
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	response, err := client.Export(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if output == "" || output == "-" {
+		_, err = GetOutput().Write(response.Data)
+		return err
+	}
+	if err := ioutil.WriteFile(output, response.Data, 0644); err != nil {
+		return err
+	}
+	Output("Exported %d devices and %d links to %s\n", response.Devices, response.Links, output)
+	return nil
+}
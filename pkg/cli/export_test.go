@@ -0,0 +1,68 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for export CLI
+package cli
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/onosproject/onos-topo/api/device"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_Export(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	mock := &mockTopoAdminServiceClient{}
+	admin.TopoAdminServiceClientFactory = func(cc *grpc.ClientConn) admin.TopoAdminServiceClient {
+		return mock
+	}
+
+	export := getExportCommand()
+	export.SetArgs([]string{"--roles", "leaf,spine", "--states", "in-service"})
+	assert.NilError(t, export.Execute())
+	assert.Equal(t, outputBuffer.String(), "digraph topology {\n  // DOT\n}\n")
+	assert.DeepEqual(t, mock.exported.Roles, []device.Role{"leaf", "spine"})
+	assert.DeepEqual(t, mock.exported.LifecycleStates, []device.LifecycleState{device.LifecycleState_IN_SERVICE})
+
+	dir, err := ioutil.TempDir("", "onos-topo")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "topo.graphml")
+
+	outputBuffer.Reset()
+	export = getExportCommand()
+	export.SetArgs([]string{"--format", "graphml", "-o", file, "--types", "Stratum"})
+	assert.NilError(t, export.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "Exported 2 devices and 1 links to "+file))
+	assert.Equal(t, mock.exported.Format, admin.ExportFormat_GRAPHML)
+	assert.DeepEqual(t, mock.exported.Types, []device.Type{"Stratum"})
+	contents, err := ioutil.ReadFile(file)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(contents), "GRAPHML"))
+
+	export = getExportCommand()
+	export.SetArgs([]string{"--format", "png"})
+	assert.ErrorContains(t, export.Execute(), "unknown export format 'png'")
+}
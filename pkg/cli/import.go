@@ -0,0 +1,119 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/spf13/cobra"
+)
+
+// importFormats are the names of the inventory formats accepted by the import command
+var importFormats = map[string]admin.ImportFormat{
+	"csv":         admin.ImportFormat_CSV,
+	"netbox-json": admin.ImportFormat_NETBOX_JSON,
+}
+
+func getImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Args:  cobra.NoArgs,
+		Short: "Import devices from an inventory file",
+		Long: "Import devices from a CSV file with a header row or from the JSON returned by the NetBox devices API.\n" +
+			"Records are mapped to device fields by --map, e.g. --map id=name,attributes.serial=serial; the\n" +
+			"device fields are id, address, target, version, type, role, timeout, lifecycleState and\n" +
+			"attributes.<name>. CSV columns named after device fields are mapped by default, as are the\n" +
+			"name, primary_ip.address, custom_fields.version, device_type.model, device_role.slug,\n" +
+			"status.value, serial and site.slug fields of NetBox devices.",
+		RunE: runImportCommand,
+	}
+	cmd.Flags().String("format", "csv", "the format of the inventory: csv or netbox-json")
+	cmd.Flags().StringP("file", "f", "", "the inventory file to import")
+	cmd.Flags().StringToString("map", map[string]string{}, "the record fields from which to import device fields")
+	cmd.Flags().StringToString("defaults", map[string]string{}, "the values of device fields for created devices whose records do not set them")
+	cmd.Flags().Uint32("default-port", 0, "the port to append to imported addresses without a port")
+	cmd.Flags().Bool("dry-run", false, "report the changes the import would make without applying them")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func runImportCommand(cmd *cobra.Command, args []string) error {
+	formatName, _ := cmd.Flags().GetString("format")
+	file, _ := cmd.Flags().GetString("file")
+	mapping, _ := cmd.Flags().GetStringToString("map")
+	defaults, _ := cmd.Flags().GetStringToString("defaults")
+	defaultPort, _ := cmd.Flags().GetUint32("default-port")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	format, ok := importFormats[formatName]
+	if !ok {
+		return fmt.Errorf("unknown import format '%s'", formatName)
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := admin.CreateTopoAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	response, err := client.Import(ctx, &admin.ImportRequest{
+		Format:      format,
+		Data:        data,
+		Mapping:     mapping,
+		Defaults:    defaults,
+		DefaultPort: defaultPort,
+		DryRun:      dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	fmt.Fprintln(writer, "RECORD\tID\tACTION\tMESSAGE")
+	for _, result := range response.Results {
+		id := string(result.ID)
+		if id == "" {
+			id = "-"
+		}
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", result.Record, id, result.Action, result.Message)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	prefix := "Imported"
+	if response.DryRun {
+		prefix = "Dry run: would import"
+	}
+	Output("%s %d records: %d created, %d updated, %d unchanged, %d conflicts, %d invalid, %d duplicates\n", prefix,
+		response.Records, response.Created, response.Updated, response.Unchanged, response.Conflicts, response.Invalid,
+		response.Duplicates)
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for import CLI
+package cli
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_Import(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	mock := &mockTopoAdminServiceClient{}
+	admin.TopoAdminServiceClientFactory = func(cc *grpc.ClientConn) admin.TopoAdminServiceClient {
+		return mock
+	}
+
+	dir, err := ioutil.TempDir("", "onos-topo")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "devices.json")
+	assert.NilError(t, ioutil.WriteFile(file, []byte(`{"results": []}`), 0644))
+
+	importCmd := getImportCommand()
+	importCmd.SetArgs([]string{"--format", "netbox-json", "-f", file, "--map", "attributes.rack=rack.name",
+		"--defaults", "version=1.0.0", "--default-port", "9559", "--dry-run"})
+	assert.NilError(t, importCmd.Execute())
+	assert.Equal(t, mock.imported.Format, admin.ImportFormat_NETBOX_JSON)
+	assert.Equal(t, string(mock.imported.Data), `{"results": []}`)
+	assert.DeepEqual(t, mock.imported.Mapping, map[string]string{"attributes.rack": "rack.name"})
+	assert.DeepEqual(t, mock.imported.Defaults, map[string]string{"version": "1.0.0"})
+	assert.Equal(t, mock.imported.DefaultPort, uint32(9559))
+	assert.Equal(t, mock.imported.DryRun, true)
+
+	output := outputBuffer.String()
+	assert.Assert(t, strings.Contains(output, "RECORD   ID       ACTION     MESSAGE"))
+	assert.Assert(t, strings.Contains(output, "2        leaf-1   CREATE"))
+	assert.Assert(t, strings.Contains(output, "3        -        INVALID    record has no value for the device id field 'id'"))
+	assert.Assert(t, strings.Contains(output, "Dry run: would import 3 records: 1 created, 0 updated, 0 unchanged, 1 conflicts, 1 invalid, 0 duplicates"))
+
+	importCmd = getImportCommand()
+	importCmd.SetArgs([]string{"--format", "xlsx", "-f", file})
+	assert.ErrorContains(t, importCmd.Execute(), "unknown import format 'xlsx'")
+}
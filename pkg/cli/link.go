@@ -0,0 +1,241 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/api/graph"
+	"github.com/spf13/cobra"
+)
+
+func getGetLinkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "link <id>",
+		Aliases: []string{"links"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Get a link",
+		RunE:    runGetLinkCommand,
+	}
+	cmd.Flags().Bool("no-headers", false, "disables output headers")
+	addAllNamespacesFlag(cmd)
+	return cmd
+}
+
+func runGetLinkCommand(cmd *cobra.Command, args []string) error {
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := graph.CreateLinkServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	if len(args) == 0 {
+		ctx, allNamespaces := withAllNamespaces(ctx, cmd)
+		stream, err := client.List(ctx, &graph.ListLinksRequest{})
+		if err != nil {
+			return err
+		}
+		var links []*graph.Link
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+			links = append(links, response.Link)
+		}
+		sort.Slice(links, func(i, j int) bool {
+			if links[i].Namespace != links[j].Namespace {
+				return links[i].Namespace < links[j].Namespace
+			}
+			return links[i].ID < links[j].ID
+		})
+
+		if !noHeaders {
+			if allNamespaces {
+				fmt.Fprint(writer, "NAMESPACE\t")
+			}
+			fmt.Fprintln(writer, "ID\tSOURCE\tTARGET\tDIRECTED\tWEIGHT\tATTRIBUTES")
+		}
+		for _, link := range links {
+			if allNamespaces {
+				fmt.Fprintf(writer, "%s\t", link.Namespace)
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%t\t%d\t%s\n", link.ID, linkEndString(link.Source, link.SourcePort),
+				linkEndString(link.Target, link.TargetPort), link.Directed, linkWeight(link), linkAttributesString(link.Attributes))
+		}
+	} else {
+		response, err := client.Get(ctx, &graph.GetLinkRequest{
+			ID: graph.ID(args[0]),
+		})
+		if err != nil {
+			return err
+		}
+		link := response.Link
+		fmt.Fprintf(writer, "ID\t%s\n", link.ID)
+		fmt.Fprintf(writer, "NAMESPACE\t%s\n", link.Namespace)
+		fmt.Fprintf(writer, "REVISION\t%d\n", link.Revision)
+		fmt.Fprintf(writer, "SOURCE\t%s\n", linkEndString(link.Source, link.SourcePort))
+		fmt.Fprintf(writer, "TARGET\t%s\n", linkEndString(link.Target, link.TargetPort))
+		fmt.Fprintf(writer, "DIRECTED\t%t\n", link.Directed)
+		fmt.Fprintf(writer, "WEIGHT\t%d\n", linkWeight(link))
+		fmt.Fprintf(writer, "ATTRIBUTES\t%s\n", linkAttributesString(link.Attributes))
+	}
+	return writer.Flush()
+}
+
+// linkEndString formats the end of a link as device[port]
+func linkEndString(id device.ID, port string) string {
+	if port == "" {
+		return string(id)
+	}
+	return fmt.Sprintf("%s[%s]", id, port)
+}
+
+// linkWeight returns the effective weight of the given link
+func linkWeight(link *graph.Link) uint32 {
+	if link.Weight == 0 {
+		return 1
+	}
+	return link.Weight
+}
+
+// linkAttributesString formats link attributes as comma separated key=value pairs ordered by key
+func linkAttributesString(attributes map[string]string) string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = key + "=" + attributes[key]
+	}
+	return strings.Join(values, ",")
+}
+
+func getAddLinkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "link <id> [args]",
+		Aliases: []string{"links"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Add a link between two devices",
+		RunE:    runAddLinkCommand,
+	}
+	cmd.Flags().StringP("source", "s", "", "the ID of the device at the source of the link")
+	cmd.Flags().String("source-port", "", "the port of the link on the source device")
+	cmd.Flags().StringP("target", "t", "", "the ID of the device at the target of the link")
+	cmd.Flags().String("target-port", "", "the port of the link on the target device")
+	cmd.Flags().Bool("directed", false, "whether the link may only be traversed from its source to its target")
+	cmd.Flags().Uint32("weight", 1, "the cost of traversing the link")
+	cmd.Flags().StringToString("attributes", map[string]string{}, "an arbitrary mapping of link attributes")
+
+	_ = cmd.MarkFlagRequired("source")
+	_ = cmd.MarkFlagRequired("target")
+	return cmd
+}
+
+func runAddLinkCommand(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	source, _ := cmd.Flags().GetString("source")
+	sourcePort, _ := cmd.Flags().GetString("source-port")
+	target, _ := cmd.Flags().GetString("target")
+	targetPort, _ := cmd.Flags().GetString("target-port")
+	directed, _ := cmd.Flags().GetBool("directed")
+	weight, _ := cmd.Flags().GetUint32("weight")
+	attributes, _ := cmd.Flags().GetStringToString("attributes")
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := graph.CreateLinkServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err = client.Add(ctx, &graph.AddLinkRequest{
+		Link: &graph.Link{
+			ID:         graph.ID(id),
+			Source:     device.ID(source),
+			SourcePort: sourcePort,
+			Target:     device.ID(target),
+			TargetPort: targetPort,
+			Directed:   directed,
+			Weight:     weight,
+			Attributes: attributes,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	Output("Added link %s", id)
+	return nil
+}
+
+func getRemoveLinkCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "link <id> [args]",
+		Aliases: []string{"links"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Remove a link",
+		RunE:    runRemoveLinkCommand,
+	}
+}
+
+func runRemoveLinkCommand(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := graph.CreateLinkServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err = client.Remove(ctx, &graph.RemoveLinkRequest{
+		Link: &graph.Link{
+			ID: graph.ID(id),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	Output("Removed link %s", id)
+	return nil
+}
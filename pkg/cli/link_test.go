@@ -0,0 +1,89 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for link CLI
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/graph"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func generateLinkData() []*graph.Link {
+	return []*graph.Link{
+		{ID: "leaf-1-spine-1", Revision: 1, Source: "leaf-1", SourcePort: "1", Target: "spine-1", TargetPort: "1", Weight: 10},
+		{ID: "leaf-2-spine-1", Revision: 1, Source: "leaf-2", Target: "spine-1", Attributes: map[string]string{"speed": "100G"}},
+	}
+}
+
+func Test_GetLinks(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	getLinks := getGetLinkCommand()
+	getLinks.SetArgs([]string{})
+	assert.NilError(t, getLinks.Execute())
+	output := outputBuffer.String()
+	assert.Assert(t, strings.Contains(output, "leaf-1-spine-1   leaf-1[1]   spine-1[1]   false      10"))
+	assert.Assert(t, strings.Contains(output, "leaf-2-spine-1   leaf-2      spine-1      false      1        speed=100G"))
+
+	outputBuffer.Reset()
+	getLink := getGetLinkCommand()
+	getLink.SetArgs([]string{"leaf-1-spine-1"})
+	assert.NilError(t, getLink.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "SOURCE       leaf-1[1]"))
+}
+
+func Test_AddLink(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	mock := &mockLinkServiceClient{}
+	graph.LinkServiceClientFactory = func(cc *grpc.ClientConn) graph.LinkServiceClient {
+		return mock
+	}
+
+	addLink := getAddLinkCommand()
+	addLink.SetArgs([]string{"leaf-1-spine-1", "--source", "leaf-1", "--source-port", "1", "--target", "spine-1",
+		"--weight", "10", "--attributes", "speed=100G"})
+	assert.NilError(t, addLink.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "Added link leaf-1-spine-1"))
+	assert.Equal(t, len(mock.added), 1)
+	assert.Equal(t, string(mock.added[0].Source), "leaf-1")
+	assert.Equal(t, mock.added[0].SourcePort, "1")
+	assert.Equal(t, mock.added[0].Weight, uint32(10))
+	assert.Equal(t, mock.added[0].Attributes["speed"], "100G")
+
+	addLink = getAddLinkCommand()
+	addLink.SetArgs([]string{"leaf-1-spine-1", "--source", "leaf-1"})
+	assert.ErrorContains(t, addLink.Execute(), "required flag")
+}
+
+func Test_RemoveLink(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	removeLink := getRemoveLinkCommand()
+	removeLink.SetArgs([]string{"leaf-1-spine-1"})
+	assert.NilError(t, removeLink.Execute())
+	assert.Assert(t, strings.Contains(outputBuffer.String(), "Removed link leaf-1-spine-1"))
+}
@@ -17,7 +17,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
 	"github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/api/diags"
+	"github.com/onosproject/onos-topo/api/graph"
 	"google.golang.org/grpc"
 )
 
@@ -47,9 +54,290 @@ func (m *mockDeviceServiceClient) Remove(ctx context.Context, request *device.Re
 	return &device.RemoveResponse{}, nil
 }
 
+type mockDeviceTypeServiceClient struct{}
+
+func (m *mockDeviceTypeServiceClient) Add(ctx context.Context, request *device.AddDeviceTypeRequest, opts ...grpc.CallOption) (*device.AddDeviceTypeResponse, error) {
+	return &device.AddDeviceTypeResponse{DeviceType: request.DeviceType}, nil
+}
+
+func (m *mockDeviceTypeServiceClient) Update(ctx context.Context, request *device.UpdateDeviceTypeRequest, opts ...grpc.CallOption) (*device.UpdateDeviceTypeResponse, error) {
+	return &device.UpdateDeviceTypeResponse{DeviceType: request.DeviceType}, nil
+}
+
+func (m *mockDeviceTypeServiceClient) Get(ctx context.Context, request *device.GetDeviceTypeRequest, opts ...grpc.CallOption) (*device.GetDeviceTypeResponse, error) {
+	return &device.GetDeviceTypeResponse{DeviceType: generateDeviceTypeData()}, nil
+}
+
+func (m *mockDeviceTypeServiceClient) List(ctx context.Context, request *device.ListDeviceTypesRequest, opts ...grpc.CallOption) (*device.ListDeviceTypesResponse, error) {
+	return &device.ListDeviceTypesResponse{DeviceTypes: []*device.DeviceType{generateDeviceTypeData()}}, nil
+}
+
+func (m *mockDeviceTypeServiceClient) Remove(ctx context.Context, request *device.RemoveDeviceTypeRequest, opts ...grpc.CallOption) (*device.RemoveDeviceTypeResponse, error) {
+	return &device.RemoveDeviceTypeResponse{}, nil
+}
+
+// mockTopoAdminServiceClient backs up the snapshot it holds and records the requests restored to it
+type mockTopoAdminServiceClient struct {
+	snapshot []*admin.SnapshotEntry
+	restored []*admin.RestoreRequest
+	mode     admin.Mode
+	exported *admin.ExportRequest
+	imported *admin.ImportRequest
+}
+
+func (m *mockTopoAdminServiceClient) Backup(ctx context.Context, request *admin.BackupRequest, opts ...grpc.CallOption) (admin.TopoAdminService_BackupClient, error) {
+	return &mockBackupClient{entries: m.snapshot}, nil
+}
+
+func (m *mockTopoAdminServiceClient) Restore(ctx context.Context, opts ...grpc.CallOption) (admin.TopoAdminService_RestoreClient, error) {
+	return &mockRestoreClient{client: m}, nil
+}
+
+func (m *mockTopoAdminServiceClient) GetLogLevels(ctx context.Context, request *admin.GetLogLevelsRequest, opts ...grpc.CallOption) (*admin.GetLogLevelsResponse, error) {
+	return &admin.GetLogLevelsResponse{Verbosity: 1, Packages: map[string]int32{"pkg/manager": 4}}, nil
+}
+
+func (m *mockTopoAdminServiceClient) SetLogLevel(ctx context.Context, request *admin.SetLogLevelRequest, opts ...grpc.CallOption) (*admin.SetLogLevelResponse, error) {
+	response := &admin.SetLogLevelResponse{Verbosity: 1, Packages: map[string]int32{}}
+	if request.Package == "" {
+		response.Verbosity = request.Verbosity
+	} else if request.Verbosity >= 0 {
+		response.Packages[request.Package] = request.Verbosity
+	}
+	return response, nil
+}
+
+func (m *mockTopoAdminServiceClient) StartTrace(ctx context.Context, request *admin.StartTraceRequest, opts ...grpc.CallOption) (*admin.StartTraceResponse, error) {
+	return &admin.StartTraceResponse{
+		Trace: &admin.Trace{ID: 1, DeviceID: request.DeviceID, Client: request.Client, Expires: time.Date(2019, 12, 1, 0, 5, 0, 0, time.UTC)},
+	}, nil
+}
+
+func (m *mockTopoAdminServiceClient) StopTrace(ctx context.Context, request *admin.StopTraceRequest, opts ...grpc.CallOption) (*admin.StopTraceResponse, error) {
+	return &admin.StopTraceResponse{}, nil
+}
+
+func (m *mockTopoAdminServiceClient) ListTraces(ctx context.Context, request *admin.ListTracesRequest, opts ...grpc.CallOption) (*admin.ListTracesResponse, error) {
+	return &admin.ListTracesResponse{
+		Traces: []*admin.Trace{
+			{ID: 1, DeviceID: "device-1", Expires: time.Date(2019, 12, 1, 0, 5, 0, 0, time.UTC)},
+		},
+	}, nil
+}
+
+func (m *mockTopoAdminServiceClient) GetMode(ctx context.Context, request *admin.GetModeRequest, opts ...grpc.CallOption) (*admin.GetModeResponse, error) {
+	mode := m.mode
+	return &admin.GetModeResponse{Mode: &mode}, nil
+}
+
+func (m *mockTopoAdminServiceClient) SetMode(ctx context.Context, request *admin.SetModeRequest, opts ...grpc.CallOption) (*admin.SetModeResponse, error) {
+	updated := time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)
+	m.mode = admin.Mode{ReadOnly: request.ReadOnly, Reason: request.Reason, SetBy: "cn=onos-cli", Updated: &updated}
+	mode := m.mode
+	return &admin.SetModeResponse{Mode: &mode}, nil
+}
+
+func (m *mockTopoAdminServiceClient) Export(ctx context.Context, request *admin.ExportRequest, opts ...grpc.CallOption) (*admin.ExportResponse, error) {
+	m.exported = request
+	return &admin.ExportResponse{
+		Data:    []byte(fmt.Sprintf("digraph topology {\n  // %s\n}\n", request.Format)),
+		Devices: 2,
+		Links:   1,
+	}, nil
+}
+
+func (m *mockTopoAdminServiceClient) Import(ctx context.Context, request *admin.ImportRequest, opts ...grpc.CallOption) (*admin.ImportResponse, error) {
+	m.imported = request
+	return &admin.ImportResponse{
+		DryRun:    request.DryRun,
+		Records:   3,
+		Created:   1,
+		Conflicts: 1,
+		Invalid:   1,
+		Results: []*admin.ImportResult{
+			{Record: 2, ID: "leaf-1", Action: admin.ImportAction_CREATE},
+			{Record: 3, Action: admin.ImportAction_INVALID, Message: "record has no value for the device id field 'id'"},
+			{Record: 4, ID: "leaf-2", Action: admin.ImportAction_CONFLICT, Message: "device 'leaf-2' cannot transition from DECOMMISSIONED to IN_SERVICE"},
+		},
+	}, nil
+}
+
+type mockBackupClient struct {
+	grpc.ClientStream
+	entries []*admin.SnapshotEntry
+}
+
+func (m *mockBackupClient) Recv() (*admin.BackupResponse, error) {
+	if len(m.entries) == 0 {
+		return nil, io.EOF
+	}
+	entry := m.entries[0]
+	m.entries = m.entries[1:]
+	return &admin.BackupResponse{Entry: entry}, nil
+}
+
+type mockRestoreClient struct {
+	grpc.ClientStream
+	client *mockTopoAdminServiceClient
+}
+
+func (m *mockRestoreClient) Send(request *admin.RestoreRequest) error {
+	m.client.restored = append(m.client.restored, request)
+	return nil
+}
+
+func (m *mockRestoreClient) CloseAndRecv() (*admin.RestoreResponse, error) {
+	response := &admin.RestoreResponse{}
+	for _, request := range m.client.restored {
+		response.DryRun = request.DryRun
+		if request.Entry.GetDevice() != nil {
+			response.DevicesAdded++
+		} else if request.Entry.GetDeviceType() != nil {
+			response.DeviceTypesAdded++
+		}
+	}
+	return response, nil
+}
+
+type mockTopoDiagsClient struct{}
+
+func (m *mockTopoDiagsClient) GetServiceInfo(ctx context.Context, request *diags.GetServiceInfoRequest, opts ...grpc.CallOption) (*diags.GetServiceInfoResponse, error) {
+	return &diags.GetServiceInfoResponse{}, nil
+}
+
+func (m *mockTopoDiagsClient) GetLeadership(ctx context.Context, request *diags.GetLeadershipRequest, opts ...grpc.CallOption) (*diags.GetLeadershipResponse, error) {
+	return &diags.GetLeadershipResponse{}, nil
+}
+
+func (m *mockTopoDiagsClient) GetStoreStatus(ctx context.Context, request *diags.GetStoreStatusRequest, opts ...grpc.CallOption) (*diags.GetStoreStatusResponse, error) {
+	lastActive := time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC)
+	return &diags.GetStoreStatusResponse{
+		Store:        diags.StoreInfo{Type: "atomix", Group: "onos-topo-raft", SessionTimeout: 30 * time.Second},
+		Healthy:      true,
+		ProbeLatency: time.Millisecond,
+		Session:      diags.SessionStatus{State: diags.SessionState_ACTIVE, LastActive: &lastActive},
+		Primitives: []*diags.PrimitiveStatus{
+			{Name: "devices", Operations: 10, Conflicts: 1, LastSuccess: &lastActive},
+		},
+	}, nil
+}
+
+func (m *mockTopoDiagsClient) GetDeviceCounts(ctx context.Context, request *diags.GetDeviceCountsRequest, opts ...grpc.CallOption) (*diags.GetDeviceCountsResponse, error) {
+	return &diags.GetDeviceCountsResponse{
+		Total: 2,
+		Counts: []*diags.DeviceCount{
+			{Type: "Stratum", Role: "leaf", ConnectivityState: device.ConnectivityState_REACHABLE, Count: 2},
+		},
+	}, nil
+}
+
+func (m *mockTopoDiagsClient) ListSubscribers(ctx context.Context, request *diags.ListSubscribersRequest, opts ...grpc.CallOption) (*diags.ListSubscribersResponse, error) {
+	return &diags.ListSubscribersResponse{
+		Subscribers: []*diags.Subscriber{
+			{
+				ID:            1,
+				Client:        "10.0.0.1:5000 cn=onos-config",
+				Filter:        &device.ListRequest{Subscribe: true, Versions: "1.x"},
+				Started:       time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC),
+				EventsSent:    5,
+				QueueDepth:    3,
+				QueueCapacity: 64,
+			},
+		},
+	}, nil
+}
+
+func (m *mockTopoDiagsClient) GetLatency(ctx context.Context, request *diags.GetLatencyRequest, opts ...grpc.CallOption) (*diags.GetLatencyResponse, error) {
+	return &diags.GetLatencyResponse{
+		Methods: []*diags.MethodLatency{
+			{Service: "topo.device.DeviceService", Method: "Get", Count: 3, Mean: time.Millisecond, Max: 2 * time.Millisecond},
+		},
+	}, nil
+}
+
+type mockLinkServiceClient struct {
+	added []*graph.Link
+}
+
+func (m *mockLinkServiceClient) Add(ctx context.Context, request *graph.AddLinkRequest, opts ...grpc.CallOption) (*graph.AddLinkResponse, error) {
+	m.added = append(m.added, request.Link)
+	return &graph.AddLinkResponse{Link: request.Link}, nil
+}
+
+func (m *mockLinkServiceClient) Update(ctx context.Context, request *graph.UpdateLinkRequest, opts ...grpc.CallOption) (*graph.UpdateLinkResponse, error) {
+	return &graph.UpdateLinkResponse{Link: request.Link}, nil
+}
+
+func (m *mockLinkServiceClient) Get(ctx context.Context, request *graph.GetLinkRequest, opts ...grpc.CallOption) (*graph.GetLinkResponse, error) {
+	return &graph.GetLinkResponse{Link: generateLinkData()[0]}, nil
+}
+
+func (m *mockLinkServiceClient) List(ctx context.Context, request *graph.ListLinksRequest, opts ...grpc.CallOption) (graph.LinkService_ListClient, error) {
+	return &mockListLinksClient{links: generateLinkData()}, nil
+}
+
+func (m *mockLinkServiceClient) Remove(ctx context.Context, request *graph.RemoveLinkRequest, opts ...grpc.CallOption) (*graph.RemoveLinkResponse, error) {
+	return &graph.RemoveLinkResponse{}, nil
+}
+
+type mockListLinksClient struct {
+	grpc.ClientStream
+	links []*graph.Link
+}
+
+func (m *mockListLinksClient) Recv() (*graph.ListLinksResponse, error) {
+	if len(m.links) == 0 {
+		return nil, io.EOF
+	}
+	link := m.links[0]
+	m.links = m.links[1:]
+	return &graph.ListLinksResponse{Link: link}, nil
+}
+
+type mockTopoGraphServiceClient struct {
+	request *graph.GetShortestPathsRequest
+}
+
+func (m *mockTopoGraphServiceClient) GetNeighbors(ctx context.Context, request *graph.GetNeighborsRequest, opts ...grpc.CallOption) (*graph.GetNeighborsResponse, error) {
+	return &graph.GetNeighborsResponse{}, nil
+}
+
+func (m *mockTopoGraphServiceClient) GetShortestPaths(ctx context.Context, request *graph.GetShortestPathsRequest, opts ...grpc.CallOption) (*graph.GetShortestPathsResponse, error) {
+	m.request = request
+	if request.Target == "leaf-3" {
+		return &graph.GetShortestPathsResponse{}, nil
+	}
+	return &graph.GetShortestPathsResponse{
+		Paths: []*graph.Path{
+			{Devices: []device.ID{request.Source, "spine-1", request.Target}, Links: []graph.ID{"leaf-1-spine-1", "leaf-2-spine-1"}, Cost: 2},
+			{Devices: []device.ID{request.Source, "spine-2", request.Target}, Links: []graph.ID{"leaf-1-spine-2", "leaf-2-spine-2"}, Cost: 2},
+		},
+	}, nil
+}
+
+func (m *mockTopoGraphServiceClient) GetComponents(ctx context.Context, request *graph.GetComponentsRequest, opts ...grpc.CallOption) (*graph.GetComponentsResponse, error) {
+	return &graph.GetComponentsResponse{}, nil
+}
+
+func (m *mockTopoGraphServiceClient) GetReachable(ctx context.Context, request *graph.GetReachableRequest, opts ...grpc.CallOption) (*graph.GetReachableResponse, error) {
+	return &graph.GetReachableResponse{}, nil
+}
+
 // setUpMockClients sets up factories to create mocks of top level clients used by the CLI
 func setUpMockClients() {
 	device.DeviceServiceClientFactory = func(cc *grpc.ClientConn) device.DeviceServiceClient {
 		return &mockDeviceServiceClient{test: ""}
 	}
+	device.DeviceTypeServiceClientFactory = func(cc *grpc.ClientConn) device.DeviceTypeServiceClient {
+		return &mockDeviceTypeServiceClient{}
+	}
+	diags.TopoDiagsClientFactory = func(cc *grpc.ClientConn) diags.TopoDiagsClient {
+		return &mockTopoDiagsClient{}
+	}
+	graph.LinkServiceClientFactory = func(cc *grpc.ClientConn) graph.LinkServiceClient {
+		return &mockLinkServiceClient{}
+	}
+	graph.TopoGraphServiceClientFactory = func(cc *grpc.ClientConn) graph.TopoGraphServiceClient {
+		return &mockTopoGraphServiceClient{}
+	}
 }
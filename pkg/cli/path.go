@@ -0,0 +1,102 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/api/graph"
+	"github.com/spf13/cobra"
+)
+
+func getPathCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "path <source> <target>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Show the shortest paths between two devices",
+		RunE:  runPathCommand,
+	}
+	cmd.Flags().StringSlice("exclude", []string{}, "the IDs of devices the paths may not traverse")
+	cmd.Flags().StringSlice("exclude-links", []string{}, "the IDs of links the paths may not traverse")
+	cmd.Flags().StringToString("link-attributes", map[string]string{}, "attributes links must have to be traversed")
+	cmd.Flags().String("weight-attribute", "", "a numeric link attribute to use as the link weight")
+	cmd.Flags().Uint32("max-paths", 1, "the maximum number of equal cost paths to show")
+	return cmd
+}
+
+func runPathCommand(cmd *cobra.Command, args []string) error {
+	exclude, _ := cmd.Flags().GetStringSlice("exclude")
+	excludeLinks, _ := cmd.Flags().GetStringSlice("exclude-links")
+	linkAttributes, _ := cmd.Flags().GetStringToString("link-attributes")
+	weightAttribute, _ := cmd.Flags().GetString("weight-attribute")
+	maxPaths, _ := cmd.Flags().GetUint32("max-paths")
+
+	constraints := &graph.Constraints{
+		LinkAttributes: linkAttributes,
+	}
+	for _, id := range exclude {
+		constraints.ExcludeDevices = append(constraints.ExcludeDevices, device.ID(id))
+	}
+	for _, id := range excludeLinks {
+		constraints.ExcludeLinks = append(constraints.ExcludeLinks, graph.ID(id))
+	}
+
+	conn, err := getConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := graph.CreateTopoGraphServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	response, err := client.GetShortestPaths(ctx, &graph.GetShortestPathsRequest{
+		Source:          device.ID(args[0]),
+		Target:          device.ID(args[1]),
+		Constraints:     constraints,
+		WeightAttribute: weightAttribute,
+		MaxPaths:        maxPaths,
+	})
+	if err != nil {
+		return err
+	}
+	if len(response.Paths) == 0 {
+		return fmt.Errorf("no path from %s to %s", args[0], args[1])
+	}
+
+	writer := new(tabwriter.Writer)
+	writer.Init(GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	fmt.Fprintln(writer, "PATH\tCOST\tHOPS\tDEVICES\tLINKS")
+	for i, path := range response.Paths {
+		devices := make([]string, len(path.Devices))
+		for j, id := range path.Devices {
+			devices[j] = string(id)
+		}
+		links := make([]string, len(path.Links))
+		for j, id := range path.Links {
+			links[j] = string(id)
+		}
+		fmt.Fprintf(writer, "%d\t%d\t%d\t%s\t%s\n", i+1, path.Cost, len(path.Links),
+			strings.Join(devices, " > "), strings.Join(links, ","))
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,52 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Unit tests for path CLI
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/api/graph"
+	"google.golang.org/grpc"
+	"gotest.tools/assert"
+)
+
+func Test_Path(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	CaptureOutput(outputBuffer)
+
+	setUpMockClients()
+	mock := &mockTopoGraphServiceClient{}
+	graph.TopoGraphServiceClientFactory = func(cc *grpc.ClientConn) graph.TopoGraphServiceClient {
+		return mock
+	}
+
+	path := getPathCommand()
+	path.SetArgs([]string{"leaf-1", "leaf-2", "--exclude", "spine-3", "--link-attributes", "speed=100G", "--max-paths", "2"})
+	assert.NilError(t, path.Execute())
+	output := outputBuffer.String()
+	assert.Assert(t, strings.Contains(output, "1      2      2      leaf-1 > spine-1 > leaf-2   leaf-1-spine-1,leaf-2-spine-1"))
+	assert.Assert(t, strings.Contains(output, "2      2      2      leaf-1 > spine-2 > leaf-2   leaf-1-spine-2,leaf-2-spine-2"))
+	assert.DeepEqual(t, mock.request.Constraints.ExcludeDevices, []device.ID{"spine-3"})
+	assert.Equal(t, mock.request.Constraints.LinkAttributes["speed"], "100G")
+	assert.Equal(t, mock.request.MaxPaths, uint32(2))
+
+	path = getPathCommand()
+	path.SetArgs([]string{"leaf-1", "leaf-3"})
+	assert.ErrorContains(t, path.Execute(), "no path from leaf-1 to leaf-3")
+}
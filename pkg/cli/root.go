@@ -14,18 +14,29 @@
 
 package cli
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
 
 // GetCommand returns the root command for the topo service
 func GetCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "topo {get,add,update,remove,watch} [args]",
+		Use: "topo {get,add,update,remove,watch,set-state,path,export,import,admin,diags} [args]",
 	}
+	cmd.PersistentFlags().StringP("namespace", "n", "", "the namespace of the topology resources")
+	_ = viper.BindPFlag("namespace", cmd.PersistentFlags().Lookup("namespace"))
 
 	cmd.AddCommand(getGetCommand())
 	cmd.AddCommand(getAddCommand())
 	cmd.AddCommand(getUpdateCommand())
 	cmd.AddCommand(getRemoveCommand())
 	cmd.AddCommand(getWatchCommand())
+	cmd.AddCommand(getSetStateCommand())
+	cmd.AddCommand(getPathCommand())
+	cmd.AddCommand(getExportCommand())
+	cmd.AddCommand(getImportCommand())
+	cmd.AddCommand(getAdminCommand())
+	cmd.AddCommand(getDiagsCommand())
 	return cmd
 }
@@ -16,6 +16,9 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc/metadata"
 	"gotest.tools/assert"
 	"strings"
 	"testing"
@@ -34,6 +37,12 @@ func Test_RootUsage(t *testing.T) {
 		{description: "Remove command", expected: `Remove a topology resource`},
 		{description: "Update command", expected: `Update a topology resource`},
 		{description: "Watch command", expected: `Watch for changes to a topology resource type`},
+		{description: "Set state command", expected: `Set the lifecycle state of a topology resource`},
+		{description: "Path command", expected: `Show the shortest paths between two devices`},
+		{description: "Export command", expected: `Export the topology as a graph for visualization`},
+		{description: "Import command", expected: `Import devices from an inventory file`},
+		{description: "Admin command", expected: `Topology administration commands`},
+		{description: "Diags command", expected: `Show diagnostic information about the topology service`},
 		{description: "Usage header", expected: `Usage:`},
 		{description: "Usage config command", expected: `topo [command]`},
 	}
@@ -67,6 +76,12 @@ func Test_SubCommands(t *testing.T) {
 		{commandName: "remove", expectedShort: "Remove a topology resource"},
 		{commandName: "update", expectedShort: "Update a topology resource"},
 		{commandName: "watch", expectedShort: "Watch for changes to a topology resource type"},
+		{commandName: "set-state", expectedShort: "Set the lifecycle state of a topology resource"},
+		{commandName: "path", expectedShort: "Show the shortest paths between two devices"},
+		{commandName: "export", expectedShort: "Export the topology as a graph for visualization"},
+		{commandName: "import", expectedShort: "Import devices from an inventory file"},
+		{commandName: "admin", expectedShort: "Topology administration commands"},
+		{commandName: "diags", expectedShort: "Show diagnostic information about the topology service"},
 	}
 
 	var subCommandsFound = make(map[string]bool)
@@ -92,3 +107,22 @@ func Test_SubCommands(t *testing.T) {
 		assert.Assert(t, entry, "command %s was not found", testCase.commandName)
 	}
 }
+
+// Test_Namespaces tests the namespace flags of the root and get commands
+func Test_Namespaces(t *testing.T) {
+	cmd := GetCommand()
+	assert.Assert(t, cmd.PersistentFlags().Lookup("namespace") != nil)
+
+	getDevices := getGetDeviceCommand()
+	ctx, all := withAllNamespaces(context.Background(), getDevices)
+	assert.Assert(t, !all)
+	_, ok := metadata.FromOutgoingContext(ctx)
+	assert.Assert(t, !ok)
+
+	assert.NilError(t, getDevices.Flags().Set("all-namespaces", "true"))
+	ctx, all = withAllNamespaces(context.Background(), getDevices)
+	assert.Assert(t, all)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, []string{northbound.AllNamespaces}, md.Get(northbound.NamespaceHeader))
+}
@@ -0,0 +1,289 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+)
+
+const (
+	// TypeIndex is the name of the index of devices by type
+	TypeIndex = "type"
+
+	// RoleIndex is the name of the index of devices by role
+	RoleIndex = "role"
+)
+
+// IndexFunc returns the values under which a device is indexed
+type IndexFunc func(device *deviceapi.Device) []string
+
+// indexByType indexes devices by type
+func indexByType(device *deviceapi.Device) []string {
+	return []string{string(device.Type)}
+}
+
+// indexByRole indexes devices by role
+func indexByRole(device *deviceapi.Device) []string {
+	if device.Role == "" {
+		return nil
+	}
+	return []string{string(device.Role)}
+}
+
+// indexByID indexes devices by ID, which is unique within a namespace only
+func indexByID(device *deviceapi.Device) []string {
+	return []string{string(device.ID)}
+}
+
+// deviceKey identifies a device across namespaces
+type deviceKey struct {
+	namespace string
+	id        deviceapi.ID
+}
+
+// keyOf returns the key of the given device. Devices without a namespace are in the default namespace.
+func keyOf(device *deviceapi.Device) deviceKey {
+	return newDeviceKey(device.Namespace, device.ID)
+}
+
+func newDeviceKey(namespace string, id deviceapi.ID) deviceKey {
+	if namespace == "" {
+		namespace = northbound.DefaultNamespace
+	}
+	return deviceKey{namespace: namespace, id: id}
+}
+
+// index is a set of devices keyed by indexed value
+type index struct {
+	fn     IndexFunc
+	values map[string]map[deviceKey]bool
+}
+
+func newIndex(fn IndexFunc) *index {
+	return &index{fn: fn, values: make(map[string]map[deviceKey]bool)}
+}
+
+func (i *index) add(device *deviceapi.Device) {
+	for _, value := range i.fn(device) {
+		keys, ok := i.values[value]
+		if !ok {
+			keys = make(map[deviceKey]bool)
+			i.values[value] = keys
+		}
+		keys[keyOf(device)] = true
+	}
+}
+
+func (i *index) remove(device *deviceapi.Device) {
+	for _, value := range i.fn(device) {
+		if keys, ok := i.values[value]; ok {
+			delete(keys, keyOf(device))
+			if len(keys) == 0 {
+				delete(i.values, value)
+			}
+		}
+	}
+}
+
+// cache is a thread-safe indexed set of devices keyed by namespace and ID. Writes are ordered by device
+// revision: a device is only replaced by a later revision of itself, and the revisions of removed devices
+// are remembered so that stale copies of them are not added back, until a resync supersedes them.
+type cache struct {
+	mu         sync.RWMutex
+	devices    map[deviceKey]*deviceapi.Device
+	tombstones map[deviceKey]deviceapi.Revision
+	ids        *index
+	indexes    map[string]*index
+}
+
+func newCache() *cache {
+	c := &cache{
+		devices:    make(map[deviceKey]*deviceapi.Device),
+		tombstones: make(map[deviceKey]deviceapi.Revision),
+		ids:        newIndex(indexByID),
+		indexes:    make(map[string]*index),
+	}
+	c.addIndex(TypeIndex, indexByType)
+	c.addIndex(RoleIndex, indexByRole)
+	return c
+}
+
+// addIndex adds an index of the devices, replacing any index of the same name
+func (c *cache) addIndex(name string, fn IndexFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	i := newIndex(fn)
+	for _, device := range c.devices {
+		i.add(device)
+	}
+	c.indexes[name] = i
+}
+
+// get returns the device with the given namespace and ID, or nil if the device is not in the cache
+func (c *cache) get(namespace string, id deviceapi.ID) *deviceapi.Device {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.devices[newDeviceKey(namespace, id)]
+}
+
+// getByID returns the devices with the given ID in any namespace, sorted by namespace
+func (c *cache) getByID(id deviceapi.ID) []*deviceapi.Device {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lookup(c.ids, string(id))
+}
+
+// list returns all devices sorted by ID and namespace
+func (c *cache) list() []*deviceapi.Device {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	devices := make([]*deviceapi.Device, 0, len(c.devices))
+	for _, device := range c.devices {
+		devices = append(devices, device)
+	}
+	sortDevices(devices)
+	return devices
+}
+
+// byIndex returns the devices indexed under the given value sorted by ID and namespace
+func (c *cache) byIndex(name string, value string) ([]*deviceapi.Device, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	i, ok := c.indexes[name]
+	if !ok {
+		return nil, fmt.Errorf("index '%s' does not exist", name)
+	}
+	return c.lookup(i, value), nil
+}
+
+// lookup returns the devices indexed under the given value sorted by ID and namespace. The cache lock
+// must be held.
+func (c *cache) lookup(i *index, value string) []*deviceapi.Device {
+	devices := make([]*deviceapi.Device, 0, len(i.values[value]))
+	for key := range i.values[value] {
+		devices = append(devices, c.devices[key])
+	}
+	sortDevices(devices)
+	return devices
+}
+
+// update adds or replaces a device unless the cache holds the same or a later revision of it.
+// It returns the replaced device, if any, and whether the cache changed.
+func (c *cache) update(device *deviceapi.Device) (*deviceapi.Device, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := keyOf(device)
+	if revision, ok := c.tombstones[key]; ok {
+		if device.Revision <= revision {
+			return nil, false
+		}
+		delete(c.tombstones, key)
+	}
+	old := c.devices[key]
+	if old != nil {
+		if device.Revision <= old.Revision {
+			return old, false
+		}
+		c.unindex(old)
+	}
+	c.devices[key] = device
+	c.ids.add(device)
+	for _, i := range c.indexes {
+		i.add(device)
+	}
+	return old, true
+}
+
+// remove removes a device unless the cache holds a later revision of it. It returns the removed
+// device, if any.
+func (c *cache) remove(device *deviceapi.Device) *deviceapi.Device {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := keyOf(device)
+	old := c.devices[key]
+	if old == nil {
+		if device.Revision > c.tombstones[key] {
+			c.tombstones[key] = device.Revision
+		}
+		return nil
+	}
+	if device.Revision < old.Revision {
+		return nil
+	}
+	c.unindex(old)
+	delete(c.devices, key)
+	c.tombstones[key] = device.Revision
+	return old
+}
+
+// prune forgets the removed devices that are not in the given set of devices. Revisions are only ordered
+// within a store partition, so a removal cannot be compared to the revisions of other devices; a device
+// missing from a listing is known to be removed, though, and its removal need no longer be remembered.
+func (c *cache) prune(devices []*deviceapi.Device) {
+	keys := keySet(devices)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.tombstones {
+		if !keys[key] {
+			delete(c.tombstones, key)
+		}
+	}
+}
+
+// unindex removes a device from the indexes
+func (c *cache) unindex(device *deviceapi.Device) {
+	c.ids.remove(device)
+	for _, i := range c.indexes {
+		i.remove(device)
+	}
+}
+
+// missing returns the cached devices that are not in the given set of devices
+func (c *cache) missing(devices []*deviceapi.Device) []*deviceapi.Device {
+	keys := keySet(devices)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var missing []*deviceapi.Device
+	for key, device := range c.devices {
+		if !keys[key] {
+			missing = append(missing, device)
+		}
+	}
+	sortDevices(missing)
+	return missing
+}
+
+// keySet returns the set of keys of the given devices
+func keySet(devices []*deviceapi.Device) map[deviceKey]bool {
+	keys := make(map[deviceKey]bool)
+	for _, device := range devices {
+		keys[keyOf(device)] = true
+	}
+	return keys
+}
+
+func sortDevices(devices []*deviceapi.Device) {
+	sort.Slice(devices, func(i, j int) bool {
+		if devices[i].ID != devices[j].ID {
+			return devices[i].ID < devices[j].ID
+		}
+		return keyOf(devices[i]).namespace < keyOf(devices[j]).namespace
+	})
+}
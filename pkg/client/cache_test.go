@@ -0,0 +1,114 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheRevisions(t *testing.T) {
+	c := newCache()
+
+	device := newTestDevice("leaf-1", "leaf")
+	device.Revision = 2
+	old, changed := c.update(device)
+	assert.Nil(t, old)
+	assert.True(t, changed)
+
+	// Earlier and identical revisions are ignored
+	stale := newTestDevice("leaf-1", "spine")
+	stale.Revision = 1
+	_, changed = c.update(stale)
+	assert.False(t, changed)
+	_, changed = c.update(device)
+	assert.False(t, changed)
+
+	updated := newTestDevice("leaf-1", "spine")
+	updated.Revision = 3
+	old, changed = c.update(updated)
+	assert.True(t, changed)
+	assert.Equal(t, deviceapi.Revision(2), old.Revision)
+	leaves, err := c.byIndex(RoleIndex, "leaf")
+	assert.NoError(t, err)
+	assert.Len(t, leaves, 0)
+	spines, err := c.byIndex(RoleIndex, "spine")
+	assert.NoError(t, err)
+	assert.Len(t, spines, 1)
+
+	// Removing an earlier revision is ignored
+	assert.Nil(t, c.remove(device))
+	assert.NotNil(t, c.get("", "leaf-1"))
+	assert.NotNil(t, c.remove(updated))
+	assert.Nil(t, c.get("", "leaf-1"))
+
+	// Removed revisions are not added back, but later revisions are
+	_, changed = c.update(updated)
+	assert.False(t, changed)
+	readded := newTestDevice("leaf-1", "leaf")
+	readded.Revision = 5
+	_, changed = c.update(readded)
+	assert.True(t, changed)
+
+	assert.Len(t, c.missing([]*deviceapi.Device{newTestDevice("leaf-2", "leaf")}), 1)
+	assert.Len(t, c.missing([]*deviceapi.Device{newTestDevice("leaf-1", "leaf")}), 0)
+}
+
+func TestCachePrune(t *testing.T) {
+	c := newCache()
+	for i, id := range []deviceapi.ID{"leaf-1", "leaf-2", "leaf-3"} {
+		device := newTestDevice(id, "leaf")
+		device.Revision = deviceapi.Revision(i + 1)
+		c.update(device)
+		c.remove(device)
+	}
+	assert.Len(t, c.tombstones, 3)
+
+	// Removals of devices missing from a listing are forgotten; others still block stale copies
+	listed := newTestDevice("leaf-3", "leaf")
+	listed.Revision = 2
+	c.update(listed)
+	c.prune([]*deviceapi.Device{listed})
+	assert.Len(t, c.tombstones, 1)
+	assert.Nil(t, c.get("", "leaf-3"))
+}
+
+func TestCacheNamespaces(t *testing.T) {
+	c := newCache()
+	device := newTestDevice("leaf-1", "leaf")
+	device.Revision = 2
+	c.update(device)
+	other := newTestDevice("leaf-1", "spine")
+	other.Namespace = "lab-a"
+	other.Revision = 1
+	_, changed := c.update(other)
+	assert.True(t, changed)
+
+	// Devices with the same ID in different namespaces are distinct
+	assert.Equal(t, deviceapi.Role("leaf"), c.get("default", "leaf-1").Role)
+	assert.Equal(t, deviceapi.Role("spine"), c.get("lab-a", "leaf-1").Role)
+	assert.Len(t, c.getByID("leaf-1"), 2)
+	assert.Len(t, c.list(), 2)
+	assert.Len(t, c.missing([]*deviceapi.Device{device}), 1)
+
+	assert.NotNil(t, c.remove(device))
+	assert.Nil(t, c.get("", "leaf-1"))
+	assert.NotNil(t, c.get("lab-a", "leaf-1"))
+	spines, err := c.byIndex(RoleIndex, "spine")
+	assert.NoError(t, err)
+	assert.Len(t, spines, 1)
+}
@@ -0,0 +1,348 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client provides a client for the topology service maintaining a local cache of the devices.
+//
+// A DeviceClient watches the devices of the topology, reconnecting with backoff when the watch fails
+// and resynchronizing its cache with the topology on each connection. Devices are read from the
+// cache, and handlers are notified of the changes to the cache:
+//
+//	devices := client.NewDeviceClient(conn, client.DeviceClientConfig{})
+//	devices.AddHandler(client.HandlerFuncs{
+//		AddFunc: func(device *deviceapi.Device) { ... },
+//	})
+//	if err := devices.Start(); err != nil { ... }
+//	defer devices.Stop()
+//	if err := devices.WaitForSync(ctx); err != nil { ... }
+//	leaves, err := devices.ByIndex(client.RoleIndex, "leaf")
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc"
+	log "k8s.io/klog"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// DeviceClientConfig configures a DeviceClient
+type DeviceClientConfig struct {
+	// MinBackoff is the delay before the first attempt to reconnect a failed watch
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between attempts to reconnect a failed watch; the delay doubles
+	// on each consecutive failure
+	MaxBackoff time.Duration
+
+	// ResyncPeriod is the interval at which the cache is resynchronized with the topology while the watch
+	// is connected; the cache is only resynchronized on connection if zero
+	ResyncPeriod time.Duration
+
+	// Namespace is the namespace whose devices are watched. The namespace of the client identity, or
+	// the default namespace, is watched if empty. Administrators may watch all namespaces by setting
+	// northbound.AllNamespaces.
+	Namespace string
+}
+
+// NewDeviceClient returns a new DeviceClient watching the devices served over the given connection
+func NewDeviceClient(conn *grpc.ClientConn, config DeviceClientConfig) *DeviceClient {
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = defaultMinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = defaultMaxBackoff
+		if config.MaxBackoff < config.MinBackoff {
+			config.MaxBackoff = config.MinBackoff
+		}
+	}
+	return &DeviceClient{
+		client:  deviceapi.CreateDeviceServiceClient(conn),
+		config:  config,
+		cache:   newCache(),
+		synced:  make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// DeviceClient maintains a local cache of the devices of the topology. The devices returned by the
+// client are shared with the cache and must not be modified.
+type DeviceClient struct {
+	client   deviceapi.DeviceServiceClient
+	config   DeviceClientConfig
+	cache    *cache
+	mu       sync.Mutex
+	handlers []Handler
+	synced   chan struct{}
+	syncOnce sync.Once
+	cancel   context.CancelFunc
+	stopped  chan struct{}
+}
+
+// Start starts watching the devices in the background until the client is stopped
+func (c *DeviceClient) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		return errors.New("the device client is already started")
+	}
+	if c.config.Namespace != "" && c.config.Namespace != northbound.AllNamespaces {
+		if err := northbound.ValidateNamespace(c.config.Namespace); err != nil {
+			return err
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if c.config.Namespace != "" {
+		ctx = northbound.WithNamespace(ctx, c.config.Namespace)
+	}
+	c.cancel = cancel
+	go c.run(ctx)
+	return nil
+}
+
+// Stop stops watching the devices. The cache is retained.
+func (c *DeviceClient) Stop() {
+	c.mu.Lock()
+	cancel := c.cancel
+	c.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-c.stopped
+	}
+}
+
+// HasSynced returns whether the cache has been synchronized with the topology at least once
+func (c *DeviceClient) HasSynced() bool {
+	select {
+	case <-c.synced:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForSync waits until the cache has been synchronized with the topology at least once
+func (c *DeviceClient) WaitForSync(ctx context.Context) error {
+	select {
+	case <-c.synced:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Get returns the cached device with the given ID, or nil if the device is not in the cache. A client
+// watching all namespaces returns the device in the default namespace; see GetInNamespace.
+func (c *DeviceClient) Get(id deviceapi.ID) *deviceapi.Device {
+	if c.config.Namespace == northbound.AllNamespaces {
+		return c.cache.get(northbound.DefaultNamespace, id)
+	}
+	// A client watching a single namespace holds at most one device with the ID
+	if devices := c.cache.getByID(id); len(devices) > 0 {
+		return devices[0]
+	}
+	return nil
+}
+
+// GetInNamespace returns the cached device with the given ID in the given namespace, or nil if the
+// device is not in the cache
+func (c *DeviceClient) GetInNamespace(namespace string, id deviceapi.ID) *deviceapi.Device {
+	return c.cache.get(namespace, id)
+}
+
+// List returns the cached devices sorted by ID
+func (c *DeviceClient) List() []*deviceapi.Device {
+	return c.cache.list()
+}
+
+// AddIndex adds an index of the cached devices; ByIndex returns the devices for which the IndexFunc
+// returns a given value. Devices are indexed by TypeIndex and RoleIndex by default.
+func (c *DeviceClient) AddIndex(name string, fn IndexFunc) {
+	c.cache.addIndex(name, fn)
+}
+
+// ByIndex returns the cached devices indexed under the given value of the named index, sorted by ID
+func (c *DeviceClient) ByIndex(name string, value string) ([]*deviceapi.Device, error) {
+	return c.cache.byIndex(name, value)
+}
+
+// AddHandler adds a handler notified of the changes to the cache. The handler is first notified of
+// the addition of the devices already in the cache. Handlers must not add handlers or stop the client.
+func (c *DeviceClient) AddHandler(handler Handler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers = append(c.handlers, handler)
+	for _, device := range c.cache.list() {
+		handler.OnAdd(device)
+	}
+}
+
+// run watches the devices, reconnecting with backoff until the context is cancelled
+func (c *DeviceClient) run(ctx context.Context) {
+	defer close(c.stopped)
+	backoff := c.config.MinBackoff
+	for {
+		synced, err := c.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if synced {
+			backoff = c.config.MinBackoff
+		}
+		log.Warningf("Device watch failed, reconnecting in %s: %v", backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > c.config.MaxBackoff {
+			backoff = c.config.MaxBackoff
+		}
+	}
+}
+
+// watch subscribes to the devices, resynchronizes the cache and applies the device events until the
+// subscription fails. The subscription is opened before the devices are listed so that no change
+// following the listing is missed. It returns whether the cache was synchronized.
+func (c *DeviceClient) watch(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.List(ctx, &deviceapi.ListRequest{Subscribe: true})
+	if err != nil {
+		return false, err
+	}
+	if err := c.resync(ctx); err != nil {
+		return false, err
+	}
+	c.syncOnce.Do(func() {
+		close(c.synced)
+	})
+	log.Infof("Synchronized %d devices", len(c.cache.list()))
+
+	responses := make(chan *deviceapi.ListResponse)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case responses <- response:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var resyncCh <-chan time.Time
+	if c.config.ResyncPeriod > 0 {
+		ticker := time.NewTicker(c.config.ResyncPeriod)
+		defer ticker.Stop()
+		resyncCh = ticker.C
+	}
+
+	for {
+		select {
+		case response := <-responses:
+			if response.Type == deviceapi.ListResponse_REMOVED {
+				c.remove(response.Device)
+			} else {
+				c.update(response.Device)
+			}
+		case <-resyncCh:
+			if err := c.resync(ctx); err != nil {
+				return true, err
+			}
+		case err := <-errCh:
+			if err == io.EOF {
+				err = errors.New("the device subscription was closed")
+			}
+			return true, err
+		case <-ctx.Done():
+			return true, ctx.Err()
+		}
+	}
+}
+
+// resync lists the devices and updates the cache to match them, removing missing devices and then
+// updating the listed devices in order of ID. The removals of devices missing from the listing are
+// then forgotten, since the listing supersedes them.
+func (c *DeviceClient) resync(ctx context.Context) error {
+	stream, err := c.client.List(ctx, &deviceapi.ListRequest{})
+	if err != nil {
+		return err
+	}
+	var devices []*deviceapi.Device
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		devices = append(devices, response.Device)
+	}
+	sortDevices(devices)
+
+	for _, device := range c.cache.missing(devices) {
+		c.remove(device)
+	}
+	for _, device := range devices {
+		c.update(device)
+	}
+	c.cache.prune(devices)
+	return nil
+}
+
+// update updates the cache with a device and notifies the handlers of the change, if any
+func (c *DeviceClient) update(device *deviceapi.Device) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old, changed := c.cache.update(device)
+	if !changed {
+		return
+	}
+	for _, handler := range c.handlers {
+		if old == nil {
+			handler.OnAdd(device)
+		} else {
+			handler.OnUpdate(old, device)
+		}
+	}
+}
+
+// remove removes a device from the cache and notifies the handlers, if the device was cached
+func (c *DeviceClient) remove(device *deviceapi.Device) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := c.cache.remove(device)
+	if old == nil {
+		return
+	}
+	for _, handler := range c.handlers {
+		handler.OnDelete(old)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testServer is a device service that can be stopped and restarted behind the same connection
+type testServer struct {
+	store   device.Store
+	service *device.Server
+	mu      sync.Mutex
+	lis     *bufconn.Listener
+	server  *grpc.Server
+}
+
+func newTestServer(t *testing.T) *testServer {
+	store, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	s := &testServer{store: store, service: device.NewServer(store)}
+	s.start()
+	return s
+}
+
+func (s *testServer) start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lis = bufconn.Listen(1024 * 1024)
+	s.server = grpc.NewServer()
+	deviceapi.RegisterDeviceServiceServer(s.server, s.service)
+	go func(server *grpc.Server, lis net.Listener) {
+		_ = server.Serve(lis)
+	}(s.server, s.lis)
+}
+
+func (s *testServer) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.server.Stop()
+}
+
+func (s *testServer) dial(t *testing.T) *grpc.ClientConn {
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		s.mu.Lock()
+		lis := s.lis
+		s.mu.Unlock()
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+	return conn
+}
+
+func (s *testServer) close() {
+	s.stop()
+	_ = s.store.Close()
+}
+
+func newTestDevice(id deviceapi.ID, role deviceapi.Role) *deviceapi.Device {
+	return &deviceapi.Device{
+		ID:      id,
+		Type:    "Stratum",
+		Role:    role,
+		Address: string(id) + ":9339",
+		Version: "1.0.0",
+	}
+}
+
+// recorder is a Handler recording the changes of which it is notified
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) OnAdd(device *deviceapi.Device) {
+	r.record("add " + string(device.ID))
+}
+
+func (r *recorder) OnUpdate(oldDevice *deviceapi.Device, newDevice *deviceapi.Device) {
+	r.record("update " + string(newDevice.ID) + " " + newDevice.Version)
+}
+
+func (r *recorder) OnDelete(device *deviceapi.Device) {
+	r.record("delete " + string(device.ID))
+}
+
+func (r *recorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.events...)
+}
+
+func TestDeviceClient(t *testing.T) {
+	server := newTestServer(t)
+	defer server.close()
+	assert.NoError(t, server.store.Store(newTestDevice("leaf-1", "leaf")))
+	assert.NoError(t, server.store.Store(newTestDevice("spine-1", "spine")))
+
+	conn := server.dial(t)
+	defer conn.Close()
+	client := NewDeviceClient(conn, DeviceClientConfig{MinBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond})
+	handler := &recorder{}
+	client.AddHandler(handler)
+	assert.False(t, client.HasSynced())
+	assert.NoError(t, client.Start())
+	defer client.Stop()
+	assert.Error(t, client.Start())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, client.WaitForSync(ctx))
+	assert.True(t, client.HasSynced())
+	assert.Len(t, client.List(), 2)
+	assert.Equal(t, deviceapi.Role("spine"), client.Get("spine-1").Role)
+	assert.Nil(t, client.Get("leaf-2"))
+	assert.Equal(t, []string{"add leaf-1", "add spine-1"}, handler.get())
+
+	leaves, err := client.ByIndex(RoleIndex, "leaf")
+	assert.NoError(t, err)
+	assert.Len(t, leaves, 1)
+	_, err = client.ByIndex("rack", "r1")
+	assert.Error(t, err)
+
+	// Changes are applied to the cache and notified to the handlers
+	assert.NoError(t, server.store.Store(newTestDevice("leaf-2", "leaf")))
+	leaf1 := client.Get("leaf-1")
+	updated := newTestDevice("leaf-1", "leaf")
+	updated.Revision = leaf1.Revision
+	updated.Version = "1.1.0"
+	assert.NoError(t, server.store.Store(updated))
+	assert.Eventually(t, func() bool {
+		return len(handler.get()) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"add leaf-1", "add spine-1", "add leaf-2", "update leaf-1 1.1.0"}, handler.get())
+	leaves, err = client.ByIndex(RoleIndex, "leaf")
+	assert.NoError(t, err)
+	assert.Len(t, leaves, 2)
+
+	// Late handlers are notified of the devices already in the cache
+	late := &recorder{}
+	client.AddHandler(late)
+	assert.Equal(t, []string{"add leaf-1", "add leaf-2", "add spine-1"}, late.get())
+
+	// Changes made while disconnected are applied when the client reconnects
+	server.stop()
+	assert.NoError(t, server.store.Delete(client.Get("spine-1")))
+	server.start()
+	assert.Eventually(t, func() bool {
+		return client.Get("spine-1") == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "delete spine-1", handler.get()[4])
+	spines, err := client.ByIndex(RoleIndex, "spine")
+	assert.NoError(t, err)
+	assert.Len(t, spines, 0)
+
+	// The removal is superseded by the resync, so it is not remembered
+	assert.Eventually(t, func() bool {
+		client.cache.mu.RLock()
+		defer client.cache.mu.RUnlock()
+		return len(client.cache.tombstones) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	client.Stop()
+	assert.Len(t, client.List(), 2)
+}
+
+func TestCustomIndex(t *testing.T) {
+	server := newTestServer(t)
+	defer server.close()
+	leaf1 := newTestDevice("leaf-1", "leaf")
+	leaf1.Attributes = map[string]string{"rack": "r1"}
+	assert.NoError(t, server.store.Store(leaf1))
+	assert.NoError(t, server.store.Store(newTestDevice("leaf-2", "leaf")))
+
+	conn := server.dial(t)
+	defer conn.Close()
+	client := NewDeviceClient(conn, DeviceClientConfig{ResyncPeriod: 10 * time.Millisecond})
+	assert.NoError(t, client.Start())
+	defer client.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, client.WaitForSync(ctx))
+
+	client.AddIndex("rack", func(device *deviceapi.Device) []string {
+		if rack, ok := device.Attributes["rack"]; ok {
+			return []string{rack}
+		}
+		return nil
+	})
+	devices, err := client.ByIndex("rack", "r1")
+	assert.NoError(t, err)
+	assert.Len(t, devices, 1)
+	assert.Equal(t, deviceapi.ID("leaf-1"), devices[0].ID)
+
+	devices, err = client.ByIndex(TypeIndex, "Stratum")
+	assert.NoError(t, err)
+	assert.Len(t, devices, 2)
+}
+
+func TestAllNamespaces(t *testing.T) {
+	server := newTestServer(t)
+	defer server.close()
+	assert.NoError(t, server.store.Store(newTestDevice("leaf-1", "leaf")))
+	other := newTestDevice("leaf-1", "spine")
+	other.Namespace = "lab-a"
+	assert.NoError(t, server.store.Store(other))
+
+	conn := server.dial(t)
+	defer conn.Close()
+	client := NewDeviceClient(conn, DeviceClientConfig{Namespace: northbound.AllNamespaces})
+	assert.NoError(t, client.Start())
+	defer client.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, client.WaitForSync(ctx))
+
+	// Devices with the same ID in different namespaces are cached separately
+	assert.Len(t, client.List(), 2)
+	assert.Equal(t, deviceapi.Role("leaf"), client.Get("leaf-1").Role)
+	assert.Equal(t, deviceapi.Role("spine"), client.GetInNamespace("lab-a", "leaf-1").Role)
+	assert.Nil(t, client.GetInNamespace("lab-b", "leaf-1"))
+}
@@ -0,0 +1,60 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+)
+
+// Handler is notified of changes to the devices in a DeviceClient's cache. Handlers are called one
+// at a time in the order of the changes, and must neither block nor modify the devices.
+type Handler interface {
+	// OnAdd is called when a device is added to the cache
+	OnAdd(device *deviceapi.Device)
+
+	// OnUpdate is called when a device in the cache is replaced by a later revision
+	OnUpdate(oldDevice *deviceapi.Device, newDevice *deviceapi.Device)
+
+	// OnDelete is called when a device is removed from the cache
+	OnDelete(device *deviceapi.Device)
+}
+
+// HandlerFuncs is a Handler calling the functions that are set
+type HandlerFuncs struct {
+	AddFunc    func(device *deviceapi.Device)
+	UpdateFunc func(oldDevice *deviceapi.Device, newDevice *deviceapi.Device)
+	DeleteFunc func(device *deviceapi.Device)
+}
+
+// OnAdd calls AddFunc if it is set
+func (h HandlerFuncs) OnAdd(device *deviceapi.Device) {
+	if h.AddFunc != nil {
+		h.AddFunc(device)
+	}
+}
+
+// OnUpdate calls UpdateFunc if it is set
+func (h HandlerFuncs) OnUpdate(oldDevice *deviceapi.Device, newDevice *deviceapi.Device) {
+	if h.UpdateFunc != nil {
+		h.UpdateFunc(oldDevice, newDevice)
+	}
+}
+
+// OnDelete calls DeleteFunc if it is set
+func (h HandlerFuncs) OnDelete(device *deviceapi.Device) {
+	if h.DeleteFunc != nil {
+		h.DeleteFunc(device)
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fake provides an in-process topology service for the unit tests of its clients.
+//
+// A DeviceService serves the real device service over an in-memory store and an in-process
+// connection. Tests seed it with devices, inject errors and latency into its methods and assert
+// on the calls it received:
+//
+//	service, err := fake.NewDeviceService()
+//	if err != nil { ... }
+//	defer service.Close()
+//	_ = service.Seed(&deviceapi.Device{ID: "leaf-1", ...})
+//	service.InjectError(fake.Get, status.Error(codes.Unavailable, "injected"))
+//	restore := service.InstallClientFactory()
+//	defer restore()
+//	... // code under test calling deviceapi.CreateDeviceServiceClient
+//	calls := service.Calls(fake.Get)
+package fake
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// The names of the DeviceService methods
+const (
+	Add    = "Add"
+	Update = "Update"
+	Get    = "Get"
+	List   = "List"
+	Remove = "Remove"
+)
+
+// Call is a call received by a DeviceService
+type Call struct {
+	// Method is the name of the method called, e.g. Get
+	Method string
+
+	// Request is the request message, e.g. a *deviceapi.GetRequest
+	Request interface{}
+
+	// Err is the error returned by the call, if any
+	Err error
+
+	// Time is the time at which the call was received
+	Time time.Time
+}
+
+// NewDeviceService starts a new DeviceService with an empty inventory
+func NewDeviceService() (*DeviceService, error) {
+	store, err := device.NewLocalStore()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &DeviceService{
+		store:   store,
+		lis:     bufconn.Listen(1024 * 1024),
+		errors:  make(map[string]error),
+		latency: make(map[string]time.Duration),
+	}
+	s.server = grpc.NewServer(grpc.UnaryInterceptor(s.unaryInterceptor), grpc.StreamInterceptor(s.streamInterceptor))
+	deviceapi.RegisterDeviceServiceServer(s.server, device.NewServer(store))
+	go func() {
+		_ = s.server.Serve(s.lis)
+	}()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return s.lis.Dial()
+	}
+	s.conn, err = grpc.DialContext(context.Background(), "fake", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		s.server.Stop()
+		_ = store.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeviceService is a fake topology device service. Devices added through the service are validated
+// as they are by the topology service.
+type DeviceService struct {
+	store   device.Store
+	lis     *bufconn.Listener
+	server  *grpc.Server
+	conn    *grpc.ClientConn
+	mu      sync.RWMutex
+	errors  map[string]error
+	latency map[string]time.Duration
+	calls   []Call
+	// generation is incremented by each Reset, so that calls recorded before a reset are not updated after it
+	generation int
+}
+
+// Conn returns a connection to the service
+func (s *DeviceService) Conn() *grpc.ClientConn {
+	return s.conn
+}
+
+// Client returns a client of the service
+func (s *DeviceService) Client() deviceapi.DeviceServiceClient {
+	return deviceapi.NewDeviceServiceClient(s.conn)
+}
+
+// InstallClientFactory sets both deviceapi.DeviceServiceClientFactory and device.DeviceServiceClientFactory
+// to return clients of the service whatever the connection, and returns a function restoring the previous factories
+func (s *DeviceService) InstallClientFactory() func() {
+	apiFactory := deviceapi.DeviceServiceClientFactory
+	factory := device.DeviceServiceClientFactory
+	deviceapi.DeviceServiceClientFactory = func(cc *grpc.ClientConn) deviceapi.DeviceServiceClient {
+		return s.Client()
+	}
+	device.DeviceServiceClientFactory = func(cc *grpc.ClientConn) deviceapi.DeviceServiceClient {
+		return s.Client()
+	}
+	return func() {
+		deviceapi.DeviceServiceClientFactory = apiFactory
+		device.DeviceServiceClientFactory = factory
+	}
+}
+
+// Seed stores the given devices as they are, without validating them or recording calls, in their
+// namespace or else the default namespace. The revisions of the devices are set to their stored revisions.
+func (s *DeviceService) Seed(devices ...*deviceapi.Device) error {
+	for _, d := range devices {
+		d.Revision = 0
+		if existing, err := s.store.Load(d.Namespace, d.ID); err != nil {
+			return err
+		} else if existing != nil {
+			d.Revision = existing.Revision
+		}
+		if err := s.store.Store(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Devices returns the devices of all namespaces in the service's inventory
+func (s *DeviceService) Devices() ([]*deviceapi.Device, error) {
+	ch := make(chan *deviceapi.Device)
+	if err := s.store.List(ch); err != nil {
+		return nil, err
+	}
+	var devices []*deviceapi.Device
+	for d := range ch {
+		devices = append(devices, d)
+	}
+	return devices, nil
+}
+
+// InjectError makes calls to the given method fail with the given error until it is cleared by
+// injecting a nil error. The error should be a gRPC status error, e.g. from status.Error.
+func (s *DeviceService) InjectError(method string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.errors, method)
+	} else {
+		s.errors[method] = err
+	}
+}
+
+// InjectLatency delays calls to the given method by the given duration; a zero duration removes
+// the delay. Delays end early if the call is cancelled.
+func (s *DeviceService) InjectLatency(method string, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if latency <= 0 {
+		delete(s.latency, method)
+	} else {
+		s.latency[method] = latency
+	}
+}
+
+// Calls returns the calls received by the given method, or by all methods if the method is empty,
+// in the order in which they were received
+func (s *DeviceService) Calls(method string) []Call {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var calls []Call
+	for _, call := range s.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset clears the recorded calls and the injected errors and latencies. The inventory is retained.
+func (s *DeviceService) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+	s.generation++
+	s.errors = make(map[string]error)
+	s.latency = make(map[string]time.Duration)
+}
+
+// Close stops the service and closes its connection
+func (s *DeviceService) Close() error {
+	_ = s.conn.Close()
+	s.server.Stop()
+	return s.store.Close()
+}
+
+// intercept delays the call and returns the injected error of the called method, if any
+func (s *DeviceService) intercept(ctx context.Context, fullMethod string) (string, error) {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	s.mu.RLock()
+	err, latency := s.errors[method], s.latency[method]
+	s.mu.RUnlock()
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-ctx.Done():
+			return method, ctx.Err()
+		}
+	}
+	return method, err
+}
+
+// callRef identifies a recorded call by the generation in which it was recorded and its index
+type callRef struct {
+	generation int
+	index      int
+}
+
+// record records a call and returns a reference to it
+func (s *DeviceService) record(method string, request interface{}, err error) callRef {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, Call{
+		Method:  method,
+		Request: request,
+		Err:     err,
+		Time:    time.Now(),
+	})
+	return callRef{generation: s.generation, index: len(s.calls) - 1}
+}
+
+// complete records the error returned by a recorded call, unless the calls have been reset since it was recorded
+func (s *DeviceService) complete(ref callRef, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ref.generation == s.generation && ref.index < len(s.calls) {
+		s.calls[ref.index].Err = err
+	}
+}
+
+func (s *DeviceService) unaryInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method, err := s.intercept(ctx, info.FullMethod)
+	var response interface{}
+	if err == nil {
+		response, err = handler(ctx, request)
+	}
+	s.record(method, request, err)
+	return response, err
+}
+
+// streamInterceptor records streaming calls as soon as their request is received, so that open
+// subscriptions are recorded, and records their error once they complete
+func (s *DeviceService) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	method, err := s.intercept(stream.Context(), info.FullMethod)
+	if err != nil {
+		s.record(method, nil, err)
+		return err
+	}
+	var ref *callRef
+	err = handler(srv, &recordingStream{
+		ServerStream: stream,
+		onRequest: func(request interface{}) {
+			r := s.record(method, request, nil)
+			ref = &r
+		},
+	})
+	if ref == nil {
+		s.record(method, nil, err)
+	} else {
+		s.complete(*ref, err)
+	}
+	return err
+}
+
+// recordingStream is a server stream reporting the request of a server streaming call
+type recordingStream struct {
+	grpc.ServerStream
+	onRequest func(request interface{})
+	received  bool
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		s.onRequest(m)
+	}
+	return err
+}
@@ -0,0 +1,187 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestDevice(id deviceapi.ID) *deviceapi.Device {
+	return &deviceapi.Device{
+		ID:      id,
+		Type:    "Stratum",
+		Address: string(id) + ":9339",
+		Version: "1.0.0",
+	}
+}
+
+func TestDeviceService(t *testing.T) {
+	service, err := NewDeviceService()
+	assert.NoError(t, err)
+	defer service.Close()
+
+	leaf1 := newTestDevice("leaf-1")
+	assert.NoError(t, service.Seed(leaf1, newTestDevice("leaf-2")))
+	assert.NotEqual(t, deviceapi.Revision(0), leaf1.Revision)
+	assert.Len(t, service.Calls(""), 0)
+
+	client := service.Client()
+	response, err := client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "leaf-1:9339", response.Device.Address)
+
+	// Devices are validated as they are by the topology service
+	_, err = client.Add(context.Background(), &deviceapi.AddRequest{Device: &deviceapi.Device{ID: "leaf-3"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.Add(context.Background(), &deviceapi.AddRequest{Device: newTestDevice("leaf-3")})
+	assert.NoError(t, err)
+	devices, err := service.Devices()
+	assert.NoError(t, err)
+	assert.Len(t, devices, 3)
+
+	calls := service.Calls(Add)
+	assert.Len(t, calls, 2)
+	assert.Equal(t, codes.InvalidArgument, status.Code(calls[0].Err))
+	assert.Equal(t, deviceapi.ID("leaf-3"), calls[1].Request.(*deviceapi.AddRequest).Device.ID)
+	assert.NoError(t, calls[1].Err)
+	assert.Len(t, service.Calls(""), 3)
+
+	stream, err := client.List(context.Background(), &deviceapi.ListRequest{})
+	assert.NoError(t, err)
+	n := 0
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		n++
+	}
+	assert.Equal(t, 3, n)
+	calls = service.Calls(List)
+	assert.Len(t, calls, 1)
+	assert.False(t, calls[0].Request.(*deviceapi.ListRequest).Subscribe)
+
+	service.Reset()
+	assert.Len(t, service.Calls(""), 0)
+}
+
+func TestInjection(t *testing.T) {
+	service, err := NewDeviceService()
+	assert.NoError(t, err)
+	defer service.Close()
+	assert.NoError(t, service.Seed(newTestDevice("leaf-1")))
+	client := service.Client()
+
+	service.InjectError(Get, status.Error(codes.Unavailable, "injected"))
+	_, err = client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	service.InjectError(List, status.Error(codes.Unavailable, "injected"))
+	stream, err := client.List(context.Background(), &deviceapi.ListRequest{Subscribe: true})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Len(t, service.Calls(List), 1)
+
+	service.InjectError(Get, nil)
+	_, err = client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+
+	service.InjectLatency(Get, 200*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.Get(ctx, &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	start := time.Now()
+	_, err = client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) >= 200*time.Millisecond)
+
+	service.InjectLatency(Get, 0)
+	_, err = client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+}
+
+func TestInstallClientFactory(t *testing.T) {
+	service, err := NewDeviceService()
+	assert.NoError(t, err)
+	defer service.Close()
+	assert.NoError(t, service.Seed(newTestDevice("leaf-1")))
+
+	apiFactory := deviceapi.DeviceServiceClientFactory
+	factory := device.DeviceServiceClientFactory
+	restore := service.InstallClientFactory()
+	response, err := device.CreateDeviceServiceClient(&grpc.ClientConn{}).Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, deviceapi.ID("leaf-1"), response.Device.ID)
+	client := deviceapi.CreateDeviceServiceClient(&grpc.ClientConn{})
+	response, err = client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, deviceapi.ID("leaf-1"), response.Device.ID)
+
+	// Open subscriptions are recorded before they complete
+	stream, err := client.List(context.Background(), &deviceapi.ListRequest{Subscribe: true})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	calls := service.Calls(List)
+	assert.Len(t, calls, 1)
+	assert.True(t, calls[0].Request.(*deviceapi.ListRequest).Subscribe)
+
+	restore()
+	assert.Equal(t, reflect.ValueOf(apiFactory).Pointer(), reflect.ValueOf(deviceapi.DeviceServiceClientFactory).Pointer())
+	assert.Equal(t, reflect.ValueOf(factory).Pointer(), reflect.ValueOf(device.DeviceServiceClientFactory).Pointer())
+}
+
+func TestResetOpenStream(t *testing.T) {
+	service, err := NewDeviceService()
+	assert.NoError(t, err)
+	defer service.Close()
+	assert.NoError(t, service.Seed(newTestDevice("leaf-1")))
+	client := service.Client()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.List(ctx, &deviceapi.ListRequest{Subscribe: true})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, service.Calls(List), 1)
+
+	// A subscription completing after a reset does not update the calls recorded since
+	service.Reset()
+	_, err = client.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	time.Sleep(100 * time.Millisecond)
+
+	calls := service.Calls("")
+	assert.Len(t, calls, 1)
+	assert.Equal(t, Get, calls[0].Method)
+	assert.NoError(t, calls[0].Err)
+}
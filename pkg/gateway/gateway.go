@@ -0,0 +1,557 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gateway implements an HTTP/JSON gateway to the topology gRPC services.
+//
+// The gateway maps the DeviceService and DeviceTypeService to the REST routes declared by the
+// google.api.http annotations in api/device/device.proto:
+//
+//	POST   /api/v1/devices               Add
+//	GET    /api/v1/devices               List (streamed; ?subscribe=true to watch, ?versions=<range>&states=<s1,s2> to filter)
+//	GET    /api/v1/devices/{id}          Get
+//	PUT    /api/v1/devices/{id}          Update
+//	DELETE /api/v1/devices/{id}          Remove (?revision=<n> for an optimistic remove)
+//	POST   /api/v1/device-types          Add a device type
+//	GET    /api/v1/device-types          List device types
+//	GET    /api/v1/device-types/{name}   Get a device type
+//	PUT    /api/v1/device-types/{name}   Update a device type
+//	DELETE /api/v1/device-types/{name}   Remove a device type (?revision=<n> for an optimistic remove)
+//	GET    /api/v1/openapi.json          the OpenAPI document for the above routes
+//
+// Streamed List responses are written as newline-delimited JSON objects of the form
+// {"result": <ListResponse>}, or as server-sent events if the client accepts text/event-stream.
+//
+// Requests operate in the namespace named by their Grpc-Metadata-Onos-Topo-Namespace header, if any.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+const (
+	devicesPath     = "/api/v1/devices"
+	typesPath       = "/api/v1/device-types"
+	openAPIPath     = "/api/v1/openapi.json"
+	eventStreamType = "text/event-stream"
+	requestTimeout  = 15 * time.Second
+
+	// namespaceHeader is the HTTP header naming the namespace of a request, which is forwarded to the
+	// gRPC services as metadata
+	namespaceHeader = "Grpc-Metadata-" + northbound.NamespaceHeader
+)
+
+// NewServer returns a new gateway forwarding requests to the topology services over the given connection
+func NewServer(conn *grpc.ClientConn) *Server {
+	return &Server{
+		client:     deviceapi.CreateDeviceServiceClient(conn),
+		typeClient: deviceapi.CreateDeviceTypeServiceClient(conn),
+		marshaler:  &jsonpb.Marshaler{},
+	}
+}
+
+// Server is an HTTP server translating REST/JSON requests into gRPC calls
+type Server struct {
+	client     deviceapi.DeviceServiceClient
+	typeClient deviceapi.DeviceTypeServiceClient
+	marshaler  *jsonpb.Marshaler
+	mu         sync.Mutex
+	server     *http.Server
+	closed     bool
+}
+
+// Handler returns the HTTP handler for the gateway routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(devicesPath, s.handleDevices)
+	mux.HandleFunc(devicesPath+"/", s.handleDevice)
+	mux.HandleFunc(typesPath, s.handleTypes)
+	mux.HandleFunc(typesPath+"/", s.handleType)
+	mux.HandleFunc(openAPIPath, s.handleOpenAPI)
+	return mux
+}
+
+// Serve starts the gateway HTTP server on the given address and port; an empty address listens on all
+// interfaces. Serve blocks until the server fails, or returns http.ErrServerClosed once it is shut down.
+func (s *Server) Serve(address string, port int) error {
+	address = net.JoinHostPort(address, strconv.Itoa(port))
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return http.ErrServerClosed
+	}
+	s.server = &http.Server{
+		Addr:    address,
+		Handler: s.Handler(),
+	}
+	server := s.server
+	s.mu.Unlock()
+
+	log.Infof("Starting REST gateway on address: %s", address)
+	return server.ListenAndServe()
+}
+
+// Shutdown stops the gateway from accepting new requests and waits for pending requests to complete
+// until the given context is done. A gateway shut down before it is served never starts.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	server := s.server
+	s.mu.Unlock()
+	if server == nil {
+		return nil
+	}
+	return server.Shutdown(ctx)
+}
+
+// handleDevices handles requests to the device collection
+func (s *Server) handleDevices(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listDevices(w, r)
+	case http.MethodPost:
+		s.addDevice(w, r)
+	default:
+		s.writeMethodNotAllowed(w, r, http.MethodGet, http.MethodPost)
+	}
+}
+
+// handleDevice handles requests to a single device
+func (s *Server) handleDevice(w http.ResponseWriter, r *http.Request) {
+	id := deviceapi.ID(strings.TrimPrefix(r.URL.Path, devicesPath+"/"))
+	if id == "" || strings.Contains(string(id), "/") {
+		s.writeError(w, status.Errorf(codes.NotFound, "path %s not found", r.URL.Path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.getDevice(w, r, id)
+	case http.MethodPut:
+		s.updateDevice(w, r, id)
+	case http.MethodDelete:
+		s.removeDevice(w, r, id)
+	default:
+		s.writeMethodNotAllowed(w, r, http.MethodGet, http.MethodPut, http.MethodDelete)
+	}
+}
+
+func (s *Server) addDevice(w http.ResponseWriter, r *http.Request) {
+	device := &deviceapi.Device{}
+	if err := s.readBody(r, device); err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.client.Add(ctx, &deviceapi.AddRequest{
+		Device: device,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) getDevice(w http.ResponseWriter, r *http.Request, id deviceapi.ID) {
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.client.Get(ctx, &deviceapi.GetRequest{
+		ID: id,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) updateDevice(w http.ResponseWriter, r *http.Request, id deviceapi.ID) {
+	device := &deviceapi.Device{}
+	if err := s.readBody(r, device); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	if device.ID == "" {
+		device.ID = id
+	} else if device.ID != id {
+		s.writeError(w, status.Errorf(codes.InvalidArgument, "device ID '%s' does not match path", device.ID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.client.Update(ctx, &deviceapi.UpdateRequest{
+		Device: device,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) removeDevice(w http.ResponseWriter, r *http.Request, id deviceapi.ID) {
+	device := &deviceapi.Device{
+		ID: id,
+	}
+	if value := r.URL.Query().Get("revision"); value != "" {
+		revision, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			s.writeError(w, status.Errorf(codes.InvalidArgument, "revision '%s' is invalid", value))
+			return
+		}
+		device.Revision = deviceapi.Revision(revision)
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.client.Remove(ctx, &deviceapi.RemoveRequest{
+		Device: device,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) listDevices(w http.ResponseWriter, r *http.Request) {
+	subscribe, _ := strconv.ParseBool(r.URL.Query().Get("subscribe"))
+	var states []deviceapi.LifecycleState
+	if param := r.URL.Query().Get("states"); param != "" {
+		for _, name := range strings.Split(param, ",") {
+			state, ok := deviceapi.LifecycleState_value[strings.ToUpper(strings.Replace(name, "-", "_", -1))]
+			if !ok {
+				s.writeError(w, status.Errorf(codes.InvalidArgument, "unknown lifecycle state '%s'", name))
+				return
+			}
+			states = append(states, deviceapi.LifecycleState(state))
+		}
+	}
+	stream, err := s.client.List(requestContext(r), &deviceapi.ListRequest{
+		Subscribe:       subscribe,
+		Versions:        r.URL.Query().Get("versions"),
+		LifecycleStates: states,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	// Errors are returned by the server before it sends anything, so the status is not committed until
+	// the subscription has been established or the first response has been received
+	var response *deviceapi.ListResponse
+	header, err := stream.Header()
+	if err == nil && len(header.Get(deviceapi.SubscribedHeader)) == 0 {
+		response, err = stream.Recv()
+	}
+	if err != nil && err != io.EOF {
+		s.writeError(w, err)
+		return
+	}
+
+	events := strings.Contains(r.Header.Get("Accept"), eventStreamType)
+	if events {
+		w.Header().Set("Content-Type", eventStreamType)
+		w.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	for err == nil {
+		if response != nil {
+			if !s.writeListResponse(w, response, events) {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		response, err = stream.Recv()
+	}
+	if err != io.EOF && status.Code(err) != codes.Canceled {
+		log.Warning("List stream failed ", err)
+	}
+}
+
+// writeListResponse writes the given List response as a server-sent event or a JSON line, returning
+// whether it was written
+func (s *Server) writeListResponse(w http.ResponseWriter, response *deviceapi.ListResponse, events bool) bool {
+	event, err := s.marshaler.MarshalToString(response)
+	if err != nil {
+		log.Error("Failed to encode device event ", err)
+		return false
+	}
+	if events {
+		_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", response.Type, event)
+	} else {
+		_, err = fmt.Fprintf(w, "{\"result\":%s}\n", event)
+	}
+	return err == nil
+}
+
+// handleTypes handles requests to the device type collection
+func (s *Server) handleTypes(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listTypes(w, r)
+	case http.MethodPost:
+		s.addType(w, r)
+	default:
+		s.writeMethodNotAllowed(w, r, http.MethodGet, http.MethodPost)
+	}
+}
+
+// handleType handles requests to a single device type
+func (s *Server) handleType(w http.ResponseWriter, r *http.Request) {
+	name := deviceapi.Type(strings.TrimPrefix(r.URL.Path, typesPath+"/"))
+	if name == "" || strings.Contains(string(name), "/") {
+		s.writeError(w, status.Errorf(codes.NotFound, "path %s not found", r.URL.Path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.getType(w, r, name)
+	case http.MethodPut:
+		s.updateType(w, r, name)
+	case http.MethodDelete:
+		s.removeType(w, r, name)
+	default:
+		s.writeMethodNotAllowed(w, r, http.MethodGet, http.MethodPut, http.MethodDelete)
+	}
+}
+
+func (s *Server) addType(w http.ResponseWriter, r *http.Request) {
+	deviceType := &deviceapi.DeviceType{}
+	if err := s.readBody(r, deviceType); err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.typeClient.Add(ctx, &deviceapi.AddDeviceTypeRequest{
+		DeviceType: deviceType,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) listTypes(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.typeClient.List(ctx, &deviceapi.ListDeviceTypesRequest{})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) getType(w http.ResponseWriter, r *http.Request, name deviceapi.Type) {
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.typeClient.Get(ctx, &deviceapi.GetDeviceTypeRequest{
+		Name: name,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) updateType(w http.ResponseWriter, r *http.Request, name deviceapi.Type) {
+	deviceType := &deviceapi.DeviceType{}
+	if err := s.readBody(r, deviceType); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	if deviceType.Name == "" {
+		deviceType.Name = name
+	} else if deviceType.Name != name {
+		s.writeError(w, status.Errorf(codes.InvalidArgument, "device type name '%s' does not match path", deviceType.Name))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.typeClient.Update(ctx, &deviceapi.UpdateDeviceTypeRequest{
+		DeviceType: deviceType,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) removeType(w http.ResponseWriter, r *http.Request, name deviceapi.Type) {
+	deviceType := &deviceapi.DeviceType{
+		Name: name,
+	}
+	if value := r.URL.Query().Get("revision"); value != "" {
+		revision, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			s.writeError(w, status.Errorf(codes.InvalidArgument, "revision '%s' is invalid", value))
+			return
+		}
+		deviceType.Revision = deviceapi.Revision(revision)
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(r), requestTimeout)
+	defer cancel()
+	response, err := s.typeClient.Remove(ctx, &deviceapi.RemoveDeviceTypeRequest{
+		DeviceType: deviceType,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeMessage(w, http.StatusOK, response)
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeMethodNotAllowed(w, r, http.MethodGet)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, deviceapi.OpenAPI)
+}
+
+// requestContext returns the context of the gRPC calls forwarding the given request, naming the namespace
+// named by the request, if any
+func requestContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if namespace := r.Header.Get(namespaceHeader); namespace != "" {
+		ctx = northbound.WithNamespace(ctx, namespace)
+	}
+	return ctx
+}
+
+// readBody decodes the JSON request body into the given message
+func (s *Server) readBody(r *http.Request, message proto.Message) error {
+	if err := jsonpb.Unmarshal(r.Body, message); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// writeMessage writes the given message to the response as JSON
+func (s *Server) writeMessage(w http.ResponseWriter, code int, message proto.Message) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := s.marshaler.Marshal(w, message); err != nil {
+		log.Error("Failed to encode response ", err)
+	}
+}
+
+// errorBody is the JSON representation of an error response
+type errorBody struct {
+	Error   string `json:"error"`
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+
+	// CurrentRevision is the current revision of a resource that failed to be updated or removed due to a conflict
+	CurrentRevision uint64 `json:"currentRevision,omitempty"`
+}
+
+// writeMethodNotAllowed rejects a request whose method is not one of the given methods allowed on its path
+func (s *Server) writeMethodNotAllowed(w http.ResponseWriter, r *http.Request, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	s.writeErrorStatus(w, http.StatusMethodNotAllowed, status.Errorf(codes.Unimplemented, "method %s not allowed", r.Method))
+}
+
+// writeError writes the given gRPC error to the response
+func (s *Server) writeError(w http.ResponseWriter, err error) {
+	s.writeErrorStatus(w, httpStatusFromCode(status.Code(err)), err)
+}
+
+// writeErrorStatus writes the given gRPC error to the response with the given HTTP status
+func (s *Server) writeErrorStatus(w http.ResponseWriter, httpStatus int, err error) {
+	st := status.Convert(err)
+	body := &errorBody{
+		Error:   st.Message(),
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+	if revision, ok := deviceapi.GetCurrentRevision(err); ok {
+		body.CurrentRevision = uint64(revision)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// httpStatusFromCode maps a gRPC status code to an HTTP status code
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestGateway(t *testing.T) (*httptest.Server, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+
+	store, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	typeStore, err := device.NewLocalTypeStore()
+	assert.NoError(t, err)
+	deviceapi.RegisterDeviceServiceServer(s, device.NewServer(store, device.NewTypePlugin(typeStore, device.TypePolicyAllow)))
+	deviceapi.RegisterDeviceTypeServiceServer(s, device.NewTypeServer(typeStore, store))
+
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(NewServer(conn).Handler())
+	return server, func() {
+		server.Close()
+		conn.Close()
+		s.Stop()
+		store.Close()
+		typeStore.Close()
+	}
+}
+
+func TestGateway(t *testing.T) {
+	server, closer := newTestGateway(t)
+	defer closer()
+
+	response, err := http.Get(server.URL + "/api/v1/devices/device-1")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	response.Body.Close()
+
+	response, err = http.Post(server.URL+"/api/v1/devices", "application/json",
+		strings.NewReader(`{"id":"device-1","type":"Stratum","address":"device-1:1234","version":"1.0.0","timeout":"10s"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	addResponse := &deviceapi.AddResponse{}
+	assert.NoError(t, jsonpb.Unmarshal(response.Body, addResponse))
+	response.Body.Close()
+	assert.Equal(t, deviceapi.ID("device-1"), addResponse.Device.ID)
+	assert.NotEqual(t, deviceapi.Revision(0), addResponse.Device.Revision)
+
+	response, err = http.Post(server.URL+"/api/v1/devices", "application/json",
+		strings.NewReader(`{"id":"device-2","type":"Stratum","address":"device-2","version":"1.0.0"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response.Body.Close()
+
+	response, err = http.Get(server.URL + "/api/v1/devices/device-1")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	getResponse := &deviceapi.GetResponse{}
+	assert.NoError(t, jsonpb.Unmarshal(response.Body, getResponse))
+	response.Body.Close()
+	assert.Equal(t, "device-1:1234", getResponse.Device.Address)
+
+	device := getResponse.Device
+	device.Role = "leaf"
+	body, err := (&jsonpb.Marshaler{}).MarshalToString(device)
+	assert.NoError(t, err)
+	request, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/devices/device-1", strings.NewReader(body))
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	updateResponse := &deviceapi.UpdateResponse{}
+	assert.NoError(t, jsonpb.Unmarshal(response.Body, updateResponse))
+	response.Body.Close()
+	assert.Equal(t, deviceapi.Role("leaf"), updateResponse.Device.Role)
+
+	// Updating a stale revision of the device is a conflict
+	request, err = http.NewRequest(http.MethodPut, server.URL+"/api/v1/devices/device-1", strings.NewReader(body))
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+	errBody := &errorBody{}
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(errBody))
+	response.Body.Close()
+	assert.Equal(t, uint64(updateResponse.Device.Revision), errBody.CurrentRevision)
+
+	response, err = http.Get(server.URL + "/api/v1/devices")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	scanner := bufio.NewScanner(response.Body)
+	assert.True(t, scanner.Scan())
+	assert.Contains(t, scanner.Text(), `{"result":{"device":{"id":"device-1"`)
+	assert.False(t, scanner.Scan())
+	response.Body.Close()
+
+	request, err = http.NewRequest(http.MethodDelete, server.URL+"/api/v1/devices/device-1", nil)
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response.Body.Close()
+
+	request, err = http.NewRequest(http.MethodDelete, server.URL+"/api/v1/devices/device-1", nil)
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	response.Body.Close()
+
+	// Unsupported methods are not allowed
+	request, err = http.NewRequest(http.MethodPatch, server.URL+"/api/v1/devices/device-1", nil)
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	assert.Equal(t, "GET, PUT, DELETE", response.Header.Get("Allow"))
+	response.Body.Close()
+	request, err = http.NewRequest(http.MethodDelete, server.URL+"/api/v1/devices", nil)
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	assert.Equal(t, "GET, POST", response.Header.Get("Allow"))
+	response.Body.Close()
+}
+
+func TestGatewayDeviceTypes(t *testing.T) {
+	server, closer := newTestGateway(t)
+	defer closer()
+
+	response, err := http.Post(server.URL+"/api/v1/device-types", "application/json",
+		strings.NewReader(`{"name":"Stratum","versions":"1.x","attributes":[{"name":"pipeconf","required":true}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response.Body.Close()
+
+	response, err = http.Post(server.URL+"/api/v1/devices", "application/json",
+		strings.NewReader(`{"id":"device-1","type":"Stratum","address":"device-1:1234","version":"1.0.0"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response.Body.Close()
+
+	response, err = http.Get(server.URL + "/api/v1/device-types")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	listResponse := &deviceapi.ListDeviceTypesResponse{}
+	assert.NoError(t, jsonpb.Unmarshal(response.Body, listResponse))
+	response.Body.Close()
+	assert.Len(t, listResponse.DeviceTypes, 1)
+	assert.Equal(t, deviceapi.Type("Stratum"), listResponse.DeviceTypes[0].Name)
+
+	request, err := http.NewRequest(http.MethodDelete, server.URL+"/api/v1/device-types/Stratum", nil)
+	assert.NoError(t, err)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response.Body.Close()
+
+	response, err = http.Get(server.URL + "/api/v1/device-types/Stratum")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	response.Body.Close()
+}
+
+func TestGatewayEvents(t *testing.T) {
+	server, closer := newTestGateway(t)
+	defer closer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/devices?subscribe=true", nil)
+	assert.NoError(t, err)
+	request.Header.Set("Accept", "text/event-stream")
+	response, err := http.DefaultClient.Do(request.WithContext(ctx))
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	added, err := http.Post(server.URL+"/api/v1/devices", "application/json",
+		strings.NewReader(`{"id":"device-1","type":"Stratum","address":"device-1:1234","version":"1.0.0"}`))
+	assert.NoError(t, err)
+	added.Body.Close()
+
+	reader := bufio.NewReader(response.Body)
+	line, err := reader.ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "event: ADDED\n", line)
+	line, err = reader.ReadString('\n')
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(line, `data: {"type":"ADDED","device":{"id":"device-1"`))
+}
+
+func TestGatewayListErrors(t *testing.T) {
+	server, closer := newTestGateway(t)
+	defer closer()
+
+	for _, path := range []string{
+		"/api/v1/devices?versions=%3E%3Dgarbage!!",
+		"/api/v1/devices?versions=%3E%3Dgarbage!!&subscribe=true",
+	} {
+		response, err := http.Get(server.URL + path)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+		body := &errorBody{}
+		assert.NoError(t, json.NewDecoder(response.Body).Decode(body))
+		response.Body.Close()
+		assert.Equal(t, int32(codes.InvalidArgument), body.Code)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	server, closer := newTestGateway(t)
+	defer closer()
+
+	response, err := http.Get(server.URL + "/api/v1/openapi.json")
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"/api/v1/devices/{id}"`)
+}
+
+func TestGatewayNamespaces(t *testing.T) {
+	server, closer := newTestGateway(t)
+	defer closer()
+
+	do := func(method string, path string, namespace string, body string) *http.Response {
+		request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		assert.NoError(t, err)
+		if namespace != "" {
+			request.Header.Set("Grpc-Metadata-Onos-Topo-Namespace", namespace)
+		}
+		response, err := http.DefaultClient.Do(request)
+		assert.NoError(t, err)
+		return response
+	}
+
+	response := do(http.MethodPost, "/api/v1/devices", "lab-a",
+		`{"id":"device-1","type":"Stratum","address":"device-1:1234","version":"1.0.0"}`)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	addResponse := &deviceapi.AddResponse{}
+	assert.NoError(t, jsonpb.Unmarshal(response.Body, addResponse))
+	response.Body.Close()
+	assert.Equal(t, "lab-a", addResponse.Device.Namespace)
+
+	response = do(http.MethodGet, "/api/v1/devices/device-1", "lab-a", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response.Body.Close()
+	response = do(http.MethodGet, "/api/v1/devices/device-1", "", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	response.Body.Close()
+
+	response = do(http.MethodGet, "/api/v1/devices", "", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	body, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	response.Body.Close()
+	assert.Empty(t, string(body))
+	response = do(http.MethodGet, "/api/v1/devices", "lab-a", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	body, err = ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	response.Body.Close()
+	assert.Contains(t, string(body), `"id":"device-1"`)
+}
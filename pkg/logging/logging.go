@@ -0,0 +1,133 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logging provides runtime control of log verbosity and tracing of requests for the topology subsystem.
+package logging
+
+import (
+	"errors"
+	"flag"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "k8s.io/klog"
+)
+
+// modulePath is the import path prefix stripped from package names
+const modulePath = "github.com/onosproject/onos-topo/"
+
+var (
+	levelMu   sync.RWMutex
+	klogFlags *flag.FlagSet
+	verbosity int32
+	packages  = make(map[string]int32)
+)
+
+// Init loads the global verbosity from the given klog flags and keeps the flags in sync with
+// verbosity changes made at runtime
+func Init(flags *flag.FlagSet) error {
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	klogFlags = flags
+	if f := flags.Lookup("v"); f != nil {
+		level, err := strconv.Atoi(f.Value.String())
+		if err != nil {
+			return err
+		}
+		verbosity = int32(level)
+	}
+	return nil
+}
+
+// Verbosity returns the global verbosity and the verbosity of the packages that override it
+func Verbosity() (int32, map[string]int32) {
+	levelMu.RLock()
+	defer levelMu.RUnlock()
+	overrides := make(map[string]int32, len(packages))
+	for pkg, level := range packages {
+		overrides[pkg] = level
+	}
+	return verbosity, overrides
+}
+
+// SetVerbosity sets the global verbosity
+func SetVerbosity(level int32) error {
+	if level < 0 {
+		return errors.New("verbosity must not be negative")
+	}
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	if klogFlags != nil {
+		if err := klogFlags.Set("v", strconv.Itoa(int(level))); err != nil {
+			return err
+		}
+	}
+	verbosity = level
+	log.Infof("Set log verbosity to %d", level)
+	return nil
+}
+
+// SetPackageVerbosity sets the verbosity of the given package, overriding the global verbosity.
+// The package is named by its import path, optionally relative to the module, e.g. pkg/manager.
+// A negative level removes the override.
+func SetPackageVerbosity(pkg string, level int32) error {
+	pkg = packageName(pkg)
+	if pkg == "" {
+		return errors.New("package is required")
+	}
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	if level < 0 {
+		delete(packages, pkg)
+		log.Infof("Cleared log verbosity of package %s", pkg)
+	} else {
+		packages[pkg] = level
+		log.Infof("Set log verbosity of package %s to %d", pkg, level)
+	}
+	return nil
+}
+
+// V reports whether verbosity at the call site is at least the requested level. The verbosity of
+// the calling package is used if it has been set, and the global verbosity otherwise.
+func V(level int32) log.Verbose {
+	levelMu.RLock()
+	defer levelMu.RUnlock()
+	if len(packages) > 0 {
+		if pc, _, _, ok := runtime.Caller(1); ok {
+			if fn := runtime.FuncForPC(pc); fn != nil {
+				if pkgLevel, ok := packages[callerPackage(fn.Name())]; ok {
+					return log.Verbose(pkgLevel >= level)
+				}
+			}
+		}
+	}
+	return log.Verbose(verbosity >= level)
+}
+
+// packageName returns the given import path relative to the module
+func packageName(pkg string) string {
+	return strings.Trim(strings.TrimPrefix(strings.TrimSpace(pkg), modulePath), "/")
+}
+
+// callerPackage returns the package of the given fully qualified function name,
+// e.g. pkg/manager for github.com/onosproject/onos-topo/pkg/manager.(*Prober).probe
+func callerPackage(function string) string {
+	slash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[slash+1:], "."); dot >= 0 {
+		function = function[:slash+1+dot]
+	}
+	return packageName(function)
+}
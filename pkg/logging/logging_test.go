@@ -0,0 +1,67 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	log "k8s.io/klog"
+)
+
+func TestCallerPackage(t *testing.T) {
+	assert.Equal(t, "pkg/manager", callerPackage("github.com/onosproject/onos-topo/pkg/manager.(*Prober).probe.func1"))
+	assert.Equal(t, "pkg/logging", callerPackage("github.com/onosproject/onos-topo/pkg/logging.TestCallerPackage"))
+	assert.Equal(t, "google.golang.org/grpc", callerPackage("google.golang.org/grpc.(*Server).Serve"))
+	assert.Equal(t, "main", callerPackage("main.main"))
+}
+
+func TestVerbosity(t *testing.T) {
+	flags := flag.NewFlagSet("klog", flag.ContinueOnError)
+	log.InitFlags(flags)
+	assert.NoError(t, flags.Set("v", "1"))
+	assert.NoError(t, Init(flags))
+	defer func() {
+		_ = SetVerbosity(0)
+		_ = SetPackageVerbosity("pkg/logging", -1)
+	}()
+
+	verbosity, packages := Verbosity()
+	assert.Equal(t, int32(1), verbosity)
+	assert.Empty(t, packages)
+	assert.True(t, bool(V(1)))
+	assert.False(t, bool(V(2)))
+
+	assert.NoError(t, SetVerbosity(2))
+	assert.Equal(t, "2", flags.Lookup("v").Value.String())
+	assert.True(t, bool(V(2)))
+	assert.Error(t, SetVerbosity(-1))
+
+	// A package verbosity overrides the global verbosity for calls from the package
+	assert.NoError(t, SetPackageVerbosity(modulePath+"pkg/logging/", 0))
+	_, packages = Verbosity()
+	assert.Equal(t, map[string]int32{"pkg/logging": 0}, packages)
+	assert.False(t, bool(V(1)))
+
+	assert.NoError(t, SetPackageVerbosity("pkg/logging", 4))
+	assert.True(t, bool(V(4)))
+	assert.False(t, bool(V(5)))
+
+	assert.NoError(t, SetPackageVerbosity("pkg/logging", -1))
+	assert.True(t, bool(V(2)))
+	assert.False(t, bool(V(3)))
+	assert.Error(t, SetPackageVerbosity("", 1))
+}
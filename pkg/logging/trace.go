@@ -0,0 +1,309 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+const (
+	// DefaultTraceDuration is the duration of a trace started without a duration
+	DefaultTraceDuration = 5 * time.Minute
+
+	// MaxTraceDuration is the maximum duration of a trace
+	MaxTraceDuration = time.Hour
+)
+
+// redacted replaces the values of secret fields in traced messages
+const redacted = "<redacted>"
+
+// secretFields are the JSON names of message fields whose values are redacted from traces
+var secretFields = map[string]bool{
+	"password": true,
+	"key":      true,
+	"token":    true,
+	"secret":   true,
+}
+
+// Trace is a request trace logging the requests and responses of RPCs concerning a device or made by a client
+type Trace struct {
+	// ID is the identifier of the trace
+	ID uint64
+	// DeviceID is the ID of the device whose requests are traced; any device if empty
+	DeviceID deviceapi.ID
+	// Client is a substring of the identity of the clients whose requests are traced; any client if empty
+	Client string
+	// Expires is the time at which the trace stops
+	Expires time.Time
+}
+
+// matches returns whether the trace matches a request by the given client concerning the given devices
+func (t *Trace) matches(client string, devices []deviceapi.ID) bool {
+	if t.Client != "" && !strings.Contains(client, t.Client) {
+		return false
+	}
+	if t.DeviceID == "" {
+		return true
+	}
+	for _, id := range devices {
+		if id == t.DeviceID {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	traceMu     sync.RWMutex
+	nextTraceID uint64
+	traces      = make(map[uint64]*Trace)
+	// activeTraces is the number of traces, read without locking to skip tracing when there are none
+	activeTraces int32
+)
+
+// StartTrace starts tracing the requests concerning the given device and/or made by clients whose
+// identity contains the given string for the given duration
+func StartTrace(deviceID deviceapi.ID, client string, duration time.Duration) (Trace, error) {
+	if deviceID == "" && client == "" {
+		return Trace{}, errors.New("a device ID or client is required")
+	}
+	if duration < 0 || duration > MaxTraceDuration {
+		return Trace{}, fmt.Errorf("trace duration must be between 0 and %s", MaxTraceDuration)
+	} else if duration == 0 {
+		duration = DefaultTraceDuration
+	}
+
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	nextTraceID++
+	trace := &Trace{
+		ID:       nextTraceID,
+		DeviceID: deviceID,
+		Client:   client,
+		Expires:  time.Now().Add(duration),
+	}
+	traces[trace.ID] = trace
+	atomic.StoreInt32(&activeTraces, int32(len(traces)))
+	log.Infof("Started trace %d of device '%s' and client '%s' until %s", trace.ID, deviceID, client, trace.Expires.Format(time.RFC3339))
+	return *trace, nil
+}
+
+// StopTrace stops the given trace, returning whether the trace was active
+func StopTrace(id uint64) bool {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	expireTraces()
+	if _, ok := traces[id]; !ok {
+		return false
+	}
+	delete(traces, id)
+	atomic.StoreInt32(&activeTraces, int32(len(traces)))
+	log.Infof("Stopped trace %d", id)
+	return true
+}
+
+// Traces returns the active traces ordered by ID
+func Traces() []Trace {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	expireTraces()
+	active := make([]Trace, 0, len(traces))
+	for _, trace := range traces {
+		active = append(active, *trace)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].ID < active[j].ID
+	})
+	return active
+}
+
+// expireTraces removes the traces that have expired. traceMu must be held.
+func expireTraces() {
+	now := time.Now()
+	for id, trace := range traces {
+		if now.After(trace.Expires) {
+			delete(traces, id)
+			log.Infof("Trace %d expired", id)
+		}
+	}
+	atomic.StoreInt32(&activeTraces, int32(len(traces)))
+}
+
+// matchTraces returns the IDs of the active traces matching a request by the given client
+// concerning the devices of the given messages
+func matchTraces(client string, messages ...interface{}) []uint64 {
+	if atomic.LoadInt32(&activeTraces) == 0 {
+		return nil
+	}
+	var devices []deviceapi.ID
+	for _, message := range messages {
+		if id := deviceID(message); id != "" {
+			devices = append(devices, id)
+		}
+	}
+
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	expireTraces()
+	var ids []uint64
+	for _, trace := range traces {
+		if trace.matches(client, devices) {
+			ids = append(ids, trace.ID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// deviceID returns the ID of the device concerned by the given request or response message
+func deviceID(message interface{}) deviceapi.ID {
+	if isNil(message) {
+		return ""
+	}
+	switch m := message.(type) {
+	case interface{ GetDevice() *deviceapi.Device }:
+		if d := m.GetDevice(); d != nil {
+			return d.ID
+		}
+	case interface{ GetID() deviceapi.ID }:
+		return m.GetID()
+	}
+	return ""
+}
+
+// redact returns the given message formatted as JSON with the values of secret fields redacted
+func redact(message interface{}) string {
+	if isNil(message) {
+		return "null"
+	}
+	pb, ok := message.(proto.Message)
+	if !ok {
+		return fmt.Sprintf("%v", message)
+	}
+	text, err := (&jsonpb.Marshaler{}).MarshalToString(pb)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(value)); err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// redactValue replaces the values of secret fields in the given decoded JSON value
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if secretFields[strings.ToLower(name)] {
+				v[name] = redacted
+			} else {
+				v[name] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactValue(element)
+		}
+	}
+	return value
+}
+
+func isNil(message interface{}) bool {
+	if message == nil {
+		return true
+	}
+	v := reflect.ValueOf(message)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor logging the requests and responses of unary RPCs matching an active trace.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if atomic.LoadInt32(&activeTraces) == 0 {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		client := northbound.ClientIdentity(ctx)
+		if ids := matchTraces(client, req, resp); len(ids) > 0 {
+			log.Infof("Trace %v: %s from %s returned %s in %s; request: %s; response: %s",
+				ids, info.FullMethod, client, status.Code(err), time.Since(start), redact(req), redact(resp))
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor logging the messages of streaming RPCs matching an active trace.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &tracedStream{
+			ServerStream: stream,
+			method:       info.FullMethod,
+			client:       northbound.ClientIdentity(stream.Context()),
+		})
+	}
+}
+
+// tracedStream is a ServerStream logging the messages matching an active trace
+type tracedStream struct {
+	grpc.ServerStream
+	method string
+	client string
+}
+
+func (s *tracedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		if ids := matchTraces(s.client, m); len(ids) > 0 {
+			log.Infof("Trace %v: %s from %s received %s", ids, s.method, s.client, redact(m))
+		}
+	}
+	return err
+}
+
+func (s *tracedStream) SendMsg(m interface{}) error {
+	if ids := matchTraces(s.client, m); len(ids) > 0 {
+		log.Infof("Trace %v: %s to %s sent %s", ids, s.method, s.client, redact(m))
+	}
+	return s.ServerStream.SendMsg(m)
+}
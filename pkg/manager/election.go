@@ -0,0 +1,288 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"hash/fnv"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/atomix/atomix-go-client/pkg/client/election"
+	"github.com/atomix/atomix-go-client/pkg/client/primitive"
+	"github.com/atomix/atomix-go-client/pkg/client/session"
+	"github.com/onosproject/onos-topo/pkg/util"
+	"google.golang.org/grpc"
+	log "k8s.io/klog"
+)
+
+const (
+	electionName          = "onos-topo-leader"
+	electionTimeout       = 15 * time.Second
+	electionRetryInterval = time.Second
+)
+
+// Leadership is the leadership state of the onos-topo replicas
+type Leadership struct {
+	// ID is the ID of the local replica
+	ID string
+
+	// Term is the current election term
+	Term uint64
+
+	// Leader is the ID of the current leader
+	Leader string
+
+	// Candidates is the sorted list of IDs of the replicas participating in the election
+	Candidates []string
+
+	// Partitioned indicates whether background work is partitioned across all candidates
+	// rather than performed by the leader alone
+	Partitioned bool
+}
+
+// IsLeader returns whether the local replica is the leader
+func (l Leadership) IsLeader() bool {
+	return l.Leader != "" && l.Leader == l.ID
+}
+
+// Owns returns whether the local replica is responsible for background work on the device with the
+// given namespaced key. If work is partitioned, each device is owned by the candidate at the index of
+// the key's hash in the sorted list of candidates; otherwise all devices are owned by the leader.
+func (l Leadership) Owns(key string) bool {
+	if !l.Partitioned {
+		return l.IsLeader()
+	}
+	for i, candidate := range l.Candidates {
+		if candidate == l.ID {
+			hash := fnv.New32a()
+			_, _ = hash.Write([]byte(key))
+			return int(hash.Sum32()%uint32(len(l.Candidates))) == i
+		}
+	}
+	return false
+}
+
+// NewAtomixElector returns an Elector using an Atomix election primitive in the onos-topo partition group
+func NewAtomixElector(id string, partitioned bool) (*Elector, error) {
+	client, err := util.GetAtomixClient()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := client.GetGroup(context.Background(), util.GetAtomixRaftGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := group.GetElection(context.Background(), electionName, session.WithID(id), session.WithTimeout(electionTimeout))
+	if err != nil {
+		return nil, err
+	}
+	return newElector(e, nil, partitioned), nil
+}
+
+// NewLocalElector returns an Elector using an in-memory election, for running a single replica without Atomix
+func NewLocalElector(id string, partitioned bool) (*Elector, error) {
+	node, conn := util.StartLocalNode()
+	name := primitive.Name{
+		Namespace: "local",
+		Name:      electionName,
+	}
+
+	e, err := election.New(context.Background(), name, []*grpc.ClientConn{conn}, session.WithID(id))
+	if err != nil {
+		_ = node.Stop()
+		return nil, err
+	}
+	return newElector(e, util.NewNodeCloser(node), partitioned), nil
+}
+
+func newElector(e election.Election, closer io.Closer, partitioned bool) *Elector {
+	return &Elector{
+		election: e,
+		closer:   closer,
+		leadership: Leadership{
+			ID:          e.ID(),
+			Partitioned: partitioned,
+		},
+	}
+}
+
+// Elector elects a leader among the onos-topo replicas and tracks the election's candidates
+type Elector struct {
+	election election.Election
+	// closer closes the resources backing the election, if any
+	closer     io.Closer
+	leadership Leadership
+	cancel     context.CancelFunc
+	mu         sync.RWMutex
+}
+
+// Start enters the local replica into the election
+func (e *Elector) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *election.Event)
+	if err := e.election.Watch(ctx, ch); err != nil {
+		cancel()
+		return err
+	}
+
+	e.mu.Lock()
+	e.cancel = cancel
+	e.mu.Unlock()
+
+	go e.watch(ctx, ch)
+
+	if err := e.enter(ctx); err != nil {
+		cancel()
+		return err
+	}
+	return nil
+}
+
+// enter enters the local replica into the election and updates the leadership from the resulting term
+func (e *Elector) enter(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, electionTimeout)
+	defer cancel()
+	term, err := e.election.Enter(ctx)
+	if err != nil {
+		return err
+	}
+	e.setTerm(term)
+	return nil
+}
+
+// watch updates the leadership from the election events on the given channel until the context is cancelled.
+// If the channel is closed before then, e.g. because the replica's session expired, the local replica can no
+// longer tell whether it is still a candidate, so its leadership is cleared until it has watched and entered
+// the election again.
+func (e *Elector) watch(ctx context.Context, ch chan *election.Event) {
+	for {
+		for event := range ch {
+			e.setTerm(&event.Term)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warning("Election watch closed; clearing leadership and re-entering the election")
+		e.clearLeadership()
+
+		for {
+			select {
+			case <-time.After(electionRetryInterval):
+			case <-ctx.Done():
+				return
+			}
+			ch = make(chan *election.Event)
+			if err := e.election.Watch(ctx, ch); err != nil {
+				log.Warning("Failed to watch election ", err)
+				continue
+			}
+			go e.reenter(ctx)
+			break
+		}
+	}
+}
+
+// reenter enters the local replica into the election, retrying until it succeeds or the context is cancelled
+func (e *Elector) reenter(ctx context.Context) {
+	for {
+		err := e.enter(ctx)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		log.Warning("Failed to enter election ", err)
+		select {
+		case <-time.After(electionRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// clearLeadership forgets the current leader and candidates, so that the local replica owns no devices
+func (e *Elector) clearLeadership() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.leadership.IsLeader() {
+		log.Infof("Relinquished leadership of term %d", e.leadership.Term)
+	}
+	e.leadership.Term = 0
+	e.leadership.Leader = ""
+	e.leadership.Candidates = nil
+}
+
+// setTerm updates the leadership from the given election term
+func (e *Elector) setTerm(term *election.Term) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if term.ID < e.leadership.Term {
+		return
+	}
+	candidates := make([]string, len(term.Candidates))
+	copy(candidates, term.Candidates)
+	sort.Strings(candidates)
+
+	wasLeader := e.leadership.IsLeader()
+	e.leadership.Term = term.ID
+	e.leadership.Leader = term.Leader
+	e.leadership.Candidates = candidates
+	if isLeader := e.leadership.IsLeader(); isLeader != wasLeader {
+		if isLeader {
+			log.Infof("Elected leader for term %d", term.ID)
+		} else {
+			log.Infof("Lost leadership to %s in term %d", term.Leader, term.ID)
+		}
+	}
+}
+
+// Leadership returns the current leadership state
+func (e *Elector) Leadership() Leadership {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	leadership := e.leadership
+	leadership.Candidates = append([]string(nil), e.leadership.Candidates...)
+	return leadership
+}
+
+// Owns returns whether the local replica is responsible for background work on the device with the given namespaced key
+func (e *Elector) Owns(key string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leadership.Owns(key)
+}
+
+// Close leaves the election and closes the election primitive
+func (e *Elector) Close() error {
+	e.mu.Lock()
+	cancel := e.cancel
+	e.mu.Unlock()
+	if cancel != nil {
+		ctx, leaveCancel := context.WithTimeout(context.Background(), electionTimeout)
+		if _, err := e.election.Leave(ctx); err != nil {
+			log.Warning("Failed to leave election ", err)
+		}
+		leaveCancel()
+		cancel()
+	}
+	_ = e.election.Close()
+	if e.closer != nil {
+		return e.closer.Close()
+	}
+	return nil
+}
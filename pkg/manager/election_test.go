@@ -0,0 +1,184 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/atomix/atomix-go-client/pkg/client/election"
+	"github.com/atomix/atomix-go-client/pkg/client/primitive"
+	"github.com/atomix/atomix-go-client/pkg/client/session"
+	"github.com/onosproject/onos-topo/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestLocalElector(t *testing.T) {
+	elector, err := NewLocalElector("onos-topo-1", false)
+	assert.NoError(t, err)
+	assert.NoError(t, elector.Start())
+	defer elector.Close()
+
+	leadership := elector.Leadership()
+	assert.Equal(t, "onos-topo-1", leadership.ID)
+	assert.Equal(t, "onos-topo-1", leadership.Leader)
+	assert.True(t, leadership.IsLeader())
+	assert.True(t, elector.Owns("device-1"))
+}
+
+func TestElectorFailover(t *testing.T) {
+	node, conn := util.StartLocalNode()
+	defer node.Stop()
+
+	newTestElector := func(id string) *Elector {
+		e, err := election.New(context.Background(), primitive.Name{Namespace: "test", Name: electionName},
+			[]*grpc.ClientConn{conn}, session.WithID(id))
+		assert.NoError(t, err)
+		return newElector(e, nil, true)
+	}
+
+	elector1 := newTestElector("onos-topo-1")
+	assert.NoError(t, elector1.Start())
+	elector2 := newTestElector("onos-topo-2")
+	assert.NoError(t, elector2.Start())
+	defer elector2.Close()
+
+	assert.Eventually(t, func() bool {
+		return len(elector1.Leadership().Candidates) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, elector1.Leadership().IsLeader())
+	assert.False(t, elector2.Leadership().IsLeader())
+	assert.Equal(t, "onos-topo-1", elector2.Leadership().Leader)
+
+	// Each device is owned by exactly one of the candidates
+	for i := 0; i < 100; i++ {
+		id := fmt.Sprintf("device-%d", i)
+		assert.NotEqual(t, elector1.Owns(id), elector2.Owns(id))
+	}
+
+	assert.NoError(t, elector1.Close())
+	assert.Eventually(t, func() bool {
+		return elector2.Leadership().IsLeader()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"onos-topo-2"}, elector2.Leadership().Candidates)
+	assert.True(t, elector2.Owns("device-1"))
+}
+
+// closingElection is an election whose watches can be closed, as when the replica's session expires
+type closingElection struct {
+	election.Election
+	mu      sync.Mutex
+	watches int
+	stop    chan struct{}
+}
+
+func (e *closingElection) Watch(ctx context.Context, ch chan<- *election.Event) error {
+	events := make(chan *election.Event)
+	if err := e.Election.Watch(ctx, events); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	e.watches++
+	e.stop = make(chan struct{})
+	stop := e.stop
+	e.mu.Unlock()
+
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				ch <- event
+			case <-stop:
+				go func() {
+					for range events {
+					}
+				}()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// expire leaves the election and closes the current watch as an expired session would, returning the
+// number of watches opened so far
+func (e *closingElection) expire(t *testing.T) int {
+	_, err := e.Election.Leave(context.Background())
+	assert.NoError(t, err)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	close(e.stop)
+	return e.watches
+}
+
+func (e *closingElection) watchCount() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.watches
+}
+
+func TestElectorWatchClosed(t *testing.T) {
+	node, conn := util.StartLocalNode()
+	defer node.Stop()
+
+	e, err := election.New(context.Background(), primitive.Name{Namespace: "test", Name: electionName},
+		[]*grpc.ClientConn{conn}, session.WithID("onos-topo-1"))
+	assert.NoError(t, err)
+	closing := &closingElection{Election: e}
+	elector := newElector(closing, nil, false)
+	assert.NoError(t, elector.Start())
+	defer elector.Close()
+	assert.True(t, elector.Leadership().IsLeader())
+
+	// The leadership is cleared when the watch closes, and restored once the election is watched and entered again
+	assert.Equal(t, 1, closing.expire(t))
+	assert.Eventually(t, func() bool {
+		return !elector.Owns("device-1")
+	}, 5*time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return closing.watchCount() == 2 && elector.Leadership().IsLeader()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"onos-topo-1"}, elector.Leadership().Candidates)
+	assert.True(t, elector.Owns("device-1"))
+}
+
+func TestLeadershipOwns(t *testing.T) {
+	leadership := Leadership{
+		ID:         "onos-topo-2",
+		Leader:     "onos-topo-1",
+		Candidates: []string{"onos-topo-1", "onos-topo-2", "onos-topo-3"},
+	}
+	assert.False(t, leadership.Owns("device-1"))
+
+	leadership.Partitioned = true
+	owned := 0
+	for i := 0; i < 300; i++ {
+		if leadership.Owns(fmt.Sprintf("device-%d", i)) {
+			owned++
+		}
+	}
+	assert.True(t, owned > 50 && owned < 150)
+
+	leadership.Candidates = []string{"onos-topo-1"}
+	assert.False(t, leadership.Owns("device-1"))
+}
@@ -16,31 +16,48 @@
 package manager
 
 import (
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
 	log "k8s.io/klog"
 )
 
 var mgr Manager
 
 // NewManager initializes the network control manager subsystem.
-func NewManager() (*Manager, error) {
+// Background work on devices is performed only by the replicas elected by the given Elector, and
+// device states are not updated while the mode persisted in the given mode store is read-only.
+func NewManager(deviceStore device.Store, modeStore device.ModeStore, elector *Elector, proberConfig ProberConfig) (*Manager, error) {
 	log.Info("Creating Manager")
-	mgr = Manager{}
+	mgr = Manager{
+		DeviceStore: deviceStore,
+		Elector:     elector,
+		prober:      NewProber(deviceStore, modeStore, elector, proberConfig),
+	}
 	return &mgr, nil
 }
 
 // Manager single point of entry for the topology system.
 type Manager struct {
+	DeviceStore device.Store
+	Elector     *Elector
+	prober      *Prober
 }
 
 // Run starts a synchronizer based on the devices and the northbound services.
-func (m *Manager) Run() {
+func (m *Manager) Run() error {
 	log.Info("Starting Manager")
-	// Start the main dispatcher system
+	if err := m.Elector.Start(); err != nil {
+		return err
+	}
+	return m.prober.Start()
 }
 
-//Close kills the channels and manager related objects
+// Close kills the channels and manager related objects
 func (m *Manager) Close() {
 	log.Info("Closing Manager")
+	m.prober.Stop()
+	if err := m.Elector.Close(); err != nil {
+		log.Warning("Failed to close elector ", err)
+	}
 }
 
 // GetManager returns the initialized and running instance of manager.
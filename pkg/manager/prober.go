@@ -0,0 +1,486 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/logging"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+const (
+	defaultProbeInterval = 30 * time.Second
+	defaultProbeTimeout  = 5 * time.Second
+	defaultProbeRetries  = 5
+)
+
+// ProberConfig configures the device reachability prober
+type ProberConfig struct {
+	// Interval is the interval at which each device is probed
+	Interval time.Duration
+
+	// Jitter is the maximum random delay added to each interval to spread probes over time
+	Jitter time.Duration
+
+	// Retries is the number of times a state update is retried when the device is concurrently modified
+	Retries int
+
+	// Protocols is the set of protocols probed on devices that do not declare any protocol states
+	Protocols []deviceapi.Protocol
+}
+
+// Owner determines whether the local replica is responsible for background work on a device
+type Owner interface {
+	// Owns returns whether the local replica owns the device with the given namespaced key
+	Owns(key string) bool
+}
+
+// NewProber returns a new Prober updating the devices in the given store that are owned by the
+// given Owner. If the owner is nil, all devices are probed. Device states are not updated while the
+// mode persisted in the given mode store is read-only.
+func NewProber(store device.Store, modeStore device.ModeStore, owner Owner, config ProberConfig) *Prober {
+	if config.Interval <= 0 {
+		config.Interval = defaultProbeInterval
+	}
+	if config.Jitter < 0 {
+		config.Jitter = 0
+	}
+	if config.Retries <= 0 {
+		config.Retries = defaultProbeRetries
+	}
+	return &Prober{
+		store:     store,
+		modeStore: modeStore,
+		owner:     owner,
+		config:    config,
+		probes:    make(map[string]context.CancelFunc),
+		services:  serviceProbers,
+		dialer:    &net.Dialer{},
+		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		stopped:   make(chan struct{}),
+	}
+}
+
+// Prober periodically dials each device's address and records the result as the device's ConnectivityState.
+// If the device is reachable, the prober then opens a gRPC channel to the device for each of its protocols
+// and checks the protocol's service, recording the results as the protocol's ChannelState and ServiceState.
+type Prober struct {
+	store     device.Store
+	modeStore device.ModeStore
+	owner     Owner
+	config    ProberConfig
+	probes    map[string]context.CancelFunc
+	services  map[deviceapi.Protocol]ServiceProber
+	dialer    *net.Dialer
+	random    *rand.Rand
+	mu        sync.Mutex
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	stopped   chan struct{}
+}
+
+// Start starts probing the devices in the store.
+// A probe is started for each existing device and for each device subsequently added to the store.
+func (p *Prober) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *device.Event)
+	if err := p.store.Watch(ctx, ch); err != nil {
+		cancel()
+		return err
+	}
+
+	p.mu.Lock()
+	p.cancel = cancel
+	p.mu.Unlock()
+
+	go func() {
+		defer close(p.stopped)
+		for {
+			// The watch channel is not guaranteed to be closed when the context is cancelled
+			select {
+			case event, ok := <-ch:
+				if !ok {
+					return
+				}
+				switch event.Type {
+				case device.EventNone, device.EventInserted, device.EventUpdated:
+					p.startProbe(ctx, event.Device.Namespace, event.Device.ID)
+				case device.EventRemoved:
+					p.stopProbe(device.NamespacedKey(event.Device.Namespace, string(event.Device.ID)))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops probing devices and waits for in-flight probes to complete
+func (p *Prober) Stop() {
+	p.mu.Lock()
+	cancel := p.cancel
+	p.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-p.stopped
+	p.wg.Wait()
+}
+
+// startProbe starts probing the given device if it's not already being probed
+func (p *Prober) startProbe(ctx context.Context, namespace string, id deviceapi.ID) {
+	key := device.NamespacedKey(namespace, string(id))
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.probes[key]; ok {
+		return
+	}
+	probeCtx, cancel := context.WithCancel(ctx)
+	p.probes[key] = cancel
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.run(probeCtx, namespace, id)
+	}()
+}
+
+// stopProbe stops probing the device with the given namespaced key
+func (p *Prober) stopProbe(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cancel, ok := p.probes[key]; ok {
+		cancel()
+		delete(p.probes, key)
+	}
+}
+
+// run probes the given device until the context is cancelled or the device is removed
+func (p *Prober) run(ctx context.Context, namespace string, id deviceapi.ID) {
+	key := device.NamespacedKey(namespace, string(id))
+	timer := time.NewTimer(p.jitter())
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		// Skip devices that are probed by another replica
+		if p.owner != nil && !p.owner.Owns(key) {
+			timer.Reset(p.config.Interval + p.jitter())
+			continue
+		}
+
+		d, err := p.store.Load(namespace, id)
+		if err != nil {
+			log.Warningf("Failed to load device %s: %v", key, err)
+		} else if d == nil {
+			p.stopProbe(key)
+			return
+		} else if !d.LifecycleState.IsActive() {
+			// Devices that are not active, e.g. devices in maintenance, are not expected to be reachable
+			logging.V(1).Infof("Skipping probe of device %s in lifecycle state %s", key, d.LifecycleState)
+		} else {
+			p.probeDevice(ctx, d)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+		timer.Reset(p.config.Interval + p.jitter())
+	}
+}
+
+// jitter returns a random delay up to the configured jitter
+func (p *Prober) jitter() time.Duration {
+	if p.config.Jitter <= 0 {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return time.Duration(p.random.Int63n(int64(p.config.Jitter)))
+}
+
+// probeDevice probes the device's reachability and protocols and stores the results
+func (p *Prober) probeDevice(ctx context.Context, d *deviceapi.Device) {
+	protocols := p.protocols(d)
+	err := p.update(d.Namespace, d.ID, func(d *deviceapi.Device) bool {
+		return setConnecting(d, protocols)
+	})
+	if err != nil {
+		log.Warningf("Failed to update protocol state of device %s: %v", d.ID, err)
+	}
+
+	state, err := p.probe(ctx, d)
+	results := make([]protocolResult, 0, len(protocols))
+	for _, protocol := range protocols {
+		if state == deviceapi.ConnectivityState_REACHABLE {
+			results = append(results, p.probeProtocol(ctx, d, protocol))
+		} else {
+			results = append(results, protocolResult{
+				protocol: protocol,
+				channel:  deviceapi.ChannelState_DISCONNECTED,
+				service:  deviceapi.ServiceState_UNAVAILABLE,
+				err:      err,
+			})
+		}
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	err = p.update(d.Namespace, d.ID, func(d *deviceapi.Device) bool {
+		modified := setConnectivityState(d, state)
+		now := time.Now()
+		for _, result := range results {
+			if setProtocolState(d, result, now) {
+				modified = true
+			}
+		}
+		return modified
+	})
+	if err != nil {
+		log.Warningf("Failed to update state of device %s: %v", d.ID, err)
+	}
+}
+
+// protocols returns the protocols to probe on the given device
+func (p *Prober) protocols(d *deviceapi.Device) []deviceapi.Protocol {
+	var protocols []deviceapi.Protocol
+	probed := make(map[deviceapi.Protocol]bool)
+	for _, state := range d.Protocols {
+		if _, ok := p.services[state.Protocol]; ok && !probed[state.Protocol] {
+			protocols = append(protocols, state.Protocol)
+			probed[state.Protocol] = true
+		}
+	}
+	for _, endpoint := range d.Endpoints {
+		if _, ok := p.services[endpoint.Protocol]; ok && !probed[endpoint.Protocol] {
+			protocols = append(protocols, endpoint.Protocol)
+			probed[endpoint.Protocol] = true
+		}
+	}
+	if len(protocols) > 0 {
+		return protocols
+	}
+	for _, protocol := range p.config.Protocols {
+		if _, ok := p.services[protocol]; ok {
+			protocols = append(protocols, protocol)
+		}
+	}
+	return protocols
+}
+
+// probeTimeout returns the given request timeout, or the default probe timeout if not set
+func probeTimeout(timeout *time.Duration) time.Duration {
+	if timeout != nil && *timeout > 0 {
+		return *timeout
+	}
+	return defaultProbeTimeout
+}
+
+// dialAddress returns the host:port at which to dial the given address, stripping any scheme from the address
+func dialAddress(address string) string {
+	parsed, err := deviceapi.ParseAddress(address)
+	if err != nil {
+		return address
+	}
+	return parsed.HostPort()
+}
+
+// probe dials the device's address within the device's timeout and returns the resulting connectivity state
+func (p *Prober) probe(ctx context.Context, d *deviceapi.Device) (deviceapi.ConnectivityState, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout(d.Timeout))
+	defer cancel()
+
+	conn, err := p.dialer.DialContext(ctx, "tcp", dialAddress(d.Address))
+	if err != nil {
+		logging.V(1).Infof("Device %s is unreachable at %s: %v", d.ID, d.Address, err)
+		return deviceapi.ConnectivityState_UNREACHABLE, err
+	}
+	_ = conn.Close()
+	return deviceapi.ConnectivityState_REACHABLE, nil
+}
+
+// protocolResult is the result of probing a protocol on a device
+type protocolResult struct {
+	protocol deviceapi.Protocol
+	channel  deviceapi.ChannelState
+	service  deviceapi.ServiceState
+	err      error
+}
+
+// probeProtocol opens a gRPC channel to the device's endpoint for the protocol and probes the protocol's
+// service within the endpoint's timeout
+func (p *Prober) probeProtocol(ctx context.Context, d *deviceapi.Device, protocol deviceapi.Protocol) protocolResult {
+	result := protocolResult{
+		protocol: protocol,
+		channel:  deviceapi.ChannelState_DISCONNECTED,
+		service:  deviceapi.ServiceState_UNAVAILABLE,
+	}
+
+	endpoint := d.ResolveEndpoint(protocol)
+	opts, err := dialOptions(endpoint)
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout(endpoint.Timeout))
+	defer cancel()
+	opts = append(opts, grpc.WithBlock(), grpc.FailOnNonTempDialError(true))
+	conn, err := grpc.DialContext(ctx, dialAddress(endpoint.Address), opts...)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	defer conn.Close()
+	result.channel = deviceapi.ChannelState_CONNECTED
+
+	if err := p.services[protocol].Probe(ctx, conn); err != nil {
+		logging.V(1).Infof("Device %s %s service is unavailable: %v", d.ID, protocol, err)
+		result.err = err
+		return result
+	}
+	result.service = deviceapi.ServiceState_AVAILABLE
+	return result
+}
+
+// update applies the given mutation to the stored device, retrying if the device is concurrently modified.
+// The device is stored only if the mutation reports it was modified and the topology is writable.
+func (p *Prober) update(namespace string, id deviceapi.ID, mutate func(*deviceapi.Device) bool) error {
+	if err := device.CheckWritable(p.modeStore); err != nil {
+		logging.V(1).Infof("Skipping state update of device %s: %s", id, status.Convert(err).Message())
+		return nil
+	}
+	var err error
+	for i := 0; i < p.config.Retries; i++ {
+		var d *deviceapi.Device
+		d, err = p.store.Load(namespace, id)
+		if err != nil || d == nil {
+			return err
+		}
+		if !mutate(d) {
+			return nil
+		}
+		if err = p.store.Store(d); err == nil {
+			return nil
+		} else if !device.IsConflict(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// protocolState returns the device's state for the given protocol, adding it if necessary.
+// An UNKNOWN_PROTOCOL state carrying only the device's connectivity state is replaced by the added state.
+func protocolState(d *deviceapi.Device, protocol deviceapi.Protocol) *deviceapi.ProtocolState {
+	for _, state := range d.Protocols {
+		if state.Protocol == protocol {
+			return state
+		}
+	}
+	for _, state := range d.Protocols {
+		if state.Protocol == deviceapi.Protocol_UNKNOWN_PROTOCOL {
+			state.Protocol = protocol
+			return state
+		}
+	}
+	state := &deviceapi.ProtocolState{
+		Protocol: protocol,
+	}
+	if len(d.Protocols) > 0 {
+		state.ConnectivityState = d.Protocols[0].ConnectivityState
+	}
+	d.Protocols = append(d.Protocols, state)
+	return state
+}
+
+// setConnecting marks the service state of the given protocols as CONNECTING if it's not yet known,
+// returning whether the device was modified
+func setConnecting(d *deviceapi.Device, protocols []deviceapi.Protocol) bool {
+	modified := false
+	for _, protocol := range protocols {
+		state := protocolState(d, protocol)
+		if state.ServiceState == deviceapi.ServiceState_UNKNOWN_SERVICE_STATE {
+			state.ServiceState = deviceapi.ServiceState_CONNECTING
+			modified = true
+		}
+	}
+	return modified
+}
+
+// setProtocolState records the result of a protocol probe, returning whether the device was modified.
+// The time of the last state change is updated only when the channel or service state changes.
+func setProtocolState(d *deviceapi.Device, result protocolResult, now time.Time) bool {
+	state := protocolState(d, result.protocol)
+	modified := false
+	if state.ChannelState != result.channel || state.ServiceState != result.service {
+		if state.ServiceState != result.service {
+			log.Infof("Device %s %s service is %s", d.ID, result.protocol, result.service)
+		}
+		state.ChannelState = result.channel
+		state.ServiceState = result.service
+		state.LastStateChange = &now
+		modified = true
+	}
+	lastError := ""
+	if result.err != nil {
+		lastError = result.err.Error()
+	}
+	if state.LastError != lastError {
+		state.LastError = lastError
+		modified = true
+	}
+	return modified
+}
+
+// setConnectivityState sets the connectivity state of all the device's protocols, returning whether the
+// device was modified. L3 reachability is independent of the protocol, so a device with no protocol
+// states is given an UNKNOWN_PROTOCOL state to carry it.
+func setConnectivityState(d *deviceapi.Device, state deviceapi.ConnectivityState) bool {
+	if len(d.Protocols) == 0 {
+		d.Protocols = []*deviceapi.ProtocolState{
+			{
+				Protocol:          deviceapi.Protocol_UNKNOWN_PROTOCOL,
+				ConnectivityState: state,
+			},
+		}
+		return true
+	}
+
+	modified := false
+	for _, protocol := range d.Protocols {
+		if protocol.ConnectivityState != state {
+			protocol.ConnectivityState = state
+			modified = true
+		}
+	}
+	if modified {
+		log.Infof("Device %s is %s", d.ID, state)
+	}
+	return modified
+}
@@ -0,0 +1,195 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/stretchr/testify/assert"
+)
+
+// connectivityState returns the connectivity state of the device in the store
+func connectivityState(t *testing.T, store device.Store, id deviceapi.ID) deviceapi.ConnectivityState {
+	d, err := store.Load(northbound.DefaultNamespace, id)
+	assert.NoError(t, err)
+	if d == nil || len(d.Protocols) == 0 {
+		return deviceapi.ConnectivityState_UNKNOWN_CONNECTIVITY_STATE
+	}
+	return d.Protocols[0].ConnectivityState
+}
+
+func TestProber(t *testing.T) {
+	store, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer lis.Close()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	closedAddress := closed.Addr().String()
+	closed.Close()
+
+	timeout := time.Second
+	device1 := &deviceapi.Device{
+		ID:      "device-1",
+		Address: lis.Addr().String(),
+		Timeout: &timeout,
+	}
+	assert.NoError(t, store.Store(device1))
+
+	prober := NewProber(store, nil, nil, ProberConfig{
+		Interval: 50 * time.Millisecond,
+		Jitter:   10 * time.Millisecond,
+	})
+	assert.NoError(t, prober.Start())
+	defer prober.Stop()
+
+	assert.Eventually(t, func() bool {
+		return connectivityState(t, store, "device-1") == deviceapi.ConnectivityState_REACHABLE
+	}, 5*time.Second, 10*time.Millisecond)
+
+	device2 := &deviceapi.Device{
+		ID:      "device-2",
+		Address: closedAddress,
+		Timeout: &timeout,
+		Protocols: []*deviceapi.ProtocolState{
+			{
+				Protocol:          deviceapi.Protocol_GNMI,
+				ConnectivityState: deviceapi.ConnectivityState_REACHABLE,
+			},
+		},
+	}
+	assert.NoError(t, store.Store(device2))
+	assert.Eventually(t, func() bool {
+		return connectivityState(t, store, "device-2") == deviceapi.ConnectivityState_UNREACHABLE
+	}, 5*time.Second, 10*time.Millisecond)
+
+	d, err := store.Load(northbound.DefaultNamespace, "device-2")
+	assert.NoError(t, err)
+	assert.Len(t, d.Protocols, 1)
+	assert.Equal(t, deviceapi.Protocol_GNMI, d.Protocols[0].Protocol)
+
+	// Devices in maintenance are not probed
+	device3 := &deviceapi.Device{
+		ID:             "device-3",
+		Address:        closedAddress,
+		Timeout:        &timeout,
+		LifecycleState: deviceapi.LifecycleState_MAINTENANCE,
+		Protocols: []*deviceapi.ProtocolState{
+			{
+				Protocol:          deviceapi.Protocol_GNMI,
+				ConnectivityState: deviceapi.ConnectivityState_REACHABLE,
+			},
+		},
+	}
+	assert.NoError(t, store.Store(device3))
+	time.Sleep(250 * time.Millisecond)
+	assert.Equal(t, deviceapi.ConnectivityState_REACHABLE, connectivityState(t, store, "device-3"))
+
+	lis.Close()
+	assert.Eventually(t, func() bool {
+		return connectivityState(t, store, "device-1") == deviceapi.ConnectivityState_UNREACHABLE
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestProberRemovedDevice(t *testing.T) {
+	store, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer lis.Close()
+
+	prober := NewProber(store, nil, nil, ProberConfig{
+		Interval: 20 * time.Millisecond,
+	})
+	assert.NoError(t, prober.Start())
+	defer prober.Stop()
+
+	d := &deviceapi.Device{
+		ID:      "device-1",
+		Address: lis.Addr().String(),
+	}
+	assert.NoError(t, store.Store(d))
+	assert.Eventually(t, func() bool {
+		return connectivityState(t, store, "device-1") == deviceapi.ConnectivityState_REACHABLE
+	}, 5*time.Second, 10*time.Millisecond)
+
+	d, err = store.Load(northbound.DefaultNamespace, "device-1")
+	assert.NoError(t, err)
+	assert.NoError(t, store.Delete(d))
+	assert.Eventually(t, func() bool {
+		prober.mu.Lock()
+		defer prober.mu.Unlock()
+		return len(prober.probes) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	d, err = store.Load(northbound.DefaultNamespace, "device-1")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+}
+
+func TestProberReadOnly(t *testing.T) {
+	store, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	modeStore, err := device.NewLocalModeStore()
+	assert.NoError(t, err)
+	defer modeStore.Close()
+	assert.NoError(t, modeStore.Store(&admin.Mode{ReadOnly: true, Reason: "Atomix migration"}))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer lis.Close()
+	assert.NoError(t, store.Store(&deviceapi.Device{
+		ID:      "device-1",
+		Address: lis.Addr().String(),
+	}))
+
+	prober := NewProber(store, modeStore, nil, ProberConfig{
+		Interval: 20 * time.Millisecond,
+	})
+	assert.NoError(t, prober.Start())
+	defer prober.Stop()
+
+	// The device is probed but its state is not stored while the topology is read-only
+	time.Sleep(200 * time.Millisecond)
+	d, err := store.Load(northbound.DefaultNamespace, "device-1")
+	assert.NoError(t, err)
+	assert.Empty(t, d.Protocols)
+
+	assert.NoError(t, modeStore.Store(&admin.Mode{}))
+	assert.Eventually(t, func() bool {
+		return connectivityState(t, store, "device-1") == deviceapi.ConnectivityState_REACHABLE
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSetConnectivityState(t *testing.T) {
+	d := &deviceapi.Device{}
+	assert.True(t, setConnectivityState(d, deviceapi.ConnectivityState_REACHABLE))
+	assert.Equal(t, deviceapi.Protocol_UNKNOWN_PROTOCOL, d.Protocols[0].Protocol)
+	assert.False(t, setConnectivityState(d, deviceapi.ConnectivityState_REACHABLE))
+	assert.True(t, setConnectivityState(d, deviceapi.ConnectivityState_UNREACHABLE))
+}
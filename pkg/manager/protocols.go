@@ -0,0 +1,172 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	p4RuntimeService = "p4.v1.P4Runtime"
+	gnoiService      = "gnoi.system.System"
+)
+
+// ServiceProber checks the availability of a protocol's gRPC service on a device
+type ServiceProber interface {
+	// Probe returns an error if the service is not available over the given channel
+	Probe(ctx context.Context, conn *grpc.ClientConn) error
+}
+
+// ServiceProberFunc is a function implementing ServiceProber
+type ServiceProberFunc func(ctx context.Context, conn *grpc.ClientConn) error
+
+// Probe calls the function
+func (f ServiceProberFunc) Probe(ctx context.Context, conn *grpc.ClientConn) error {
+	return f(ctx, conn)
+}
+
+// serviceProbers is the default set of service probers by protocol
+var serviceProbers = map[deviceapi.Protocol]ServiceProber{
+	deviceapi.Protocol_GNMI:      ServiceProberFunc(probeGNMI),
+	deviceapi.Protocol_P4RUNTIME: namedServiceProber(p4RuntimeService),
+	deviceapi.Protocol_GNOI:      namedServiceProber(gnoiService),
+}
+
+// probeGNMI checks the gNMI service by requesting the device's capabilities
+func probeGNMI(ctx context.Context, conn *grpc.ClientConn) error {
+	_, err := gnmi.NewGNMIClient(conn).Capabilities(ctx, &gnmi.CapabilityRequest{})
+	return err
+}
+
+// namedServiceProber returns a prober checking the named service with the gRPC health service, falling
+// back to server reflection for devices that do not implement the health service
+func namedServiceProber(service string) ServiceProber {
+	return ServiceProberFunc(func(ctx context.Context, conn *grpc.ClientConn) error {
+		response, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{
+			Service: service,
+		})
+		if err == nil {
+			if response.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+				return fmt.Errorf("service %s is %s", service, response.Status)
+			}
+			return nil
+		} else if code := status.Code(err); code != codes.Unimplemented && code != codes.NotFound {
+			return err
+		}
+		return probeReflection(ctx, conn, service)
+	})
+}
+
+// probeReflection checks that the named service is listed by the device's reflection service
+func probeReflection(ctx context.Context, conn *grpc.ClientConn, service string) error {
+	stream, err := grpc_reflection_v1alpha.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = stream.CloseSend()
+	}()
+
+	err = stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		return err
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if services := response.GetListServicesResponse(); services != nil {
+		for _, s := range services.Service {
+			if s.Name == service {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("service %s not found", service)
+}
+
+// dialOptions returns the options for dialing the endpoint using its TLS configuration and credentials.
+// The endpoint's TLS configuration and credentials must be resolved.
+func dialOptions(endpoint *deviceapi.Endpoint) ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+	if endpoint.TLS.Plain {
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		tlsConfig := &tls.Config{
+			InsecureSkipVerify: endpoint.TLS.Insecure,
+		}
+		if endpoint.TLS.Cert != "" || endpoint.TLS.Key != "" {
+			cert, err := tls.LoadX509KeyPair(endpoint.TLS.Cert, endpoint.TLS.Key)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		if endpoint.TLS.CaCert != "" {
+			ca, err := ioutil.ReadFile(endpoint.TLS.CaCert)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("failed to parse CA certificate %s", endpoint.TLS.CaCert)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+
+	if endpoint.Credentials.User != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&userCredentials{
+			user:     endpoint.Credentials.User,
+			password: endpoint.Credentials.Password,
+			secure:   !endpoint.TLS.Plain,
+		}))
+	}
+	return opts, nil
+}
+
+// userCredentials passes the device user and password as request metadata, as expected by gNMI targets
+type userCredentials struct {
+	user     string
+	password string
+	secure   bool
+}
+
+func (c *userCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"username": c.user,
+		"password": c.password,
+	}, nil
+}
+
+func (c *userCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
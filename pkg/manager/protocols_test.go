@@ -0,0 +1,234 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// fakeGNMIServer is a gNMI target that only implements Capabilities
+type fakeGNMIServer struct {
+	user string
+}
+
+func (s *fakeGNMIServer) Capabilities(ctx context.Context, request *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if s.user != "" && (len(md.Get("username")) == 0 || md.Get("username")[0] != s.user) {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return &gnmi.CapabilityResponse{
+		GNMIVersion: "0.7.0",
+	}, nil
+}
+
+func (s *fakeGNMIServer) Get(ctx context.Context, request *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (s *fakeGNMIServer) Set(ctx context.Context, request *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (s *fakeGNMIServer) Subscribe(server gnmi.GNMI_SubscribeServer) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
+// startFakeDevice starts an in-process plaintext gRPC server, returning its address
+func startFakeDevice(t *testing.T, register func(*grpc.Server)) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := grpc.NewServer()
+	register(s)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	return lis.Addr().String(), s.Stop
+}
+
+// newFakeDevice returns a plaintext device with the given address and protocol
+func newFakeDevice(id deviceapi.ID, address string, protocol deviceapi.Protocol) *deviceapi.Device {
+	timeout := time.Second
+	return &deviceapi.Device{
+		ID:      id,
+		Address: address,
+		Timeout: &timeout,
+		TLS: deviceapi.TlsConfig{
+			Plain: true,
+		},
+		Protocols: []*deviceapi.ProtocolState{
+			{
+				Protocol: protocol,
+			},
+		},
+	}
+}
+
+func TestProbeProtocol(t *testing.T) {
+	prober := NewProber(nil, nil, nil, ProberConfig{})
+
+	gnmiAddress, stopGNMI := startFakeDevice(t, func(s *grpc.Server) {
+		gnmi.RegisterGNMIServer(s, &fakeGNMIServer{user: "admin"})
+	})
+	defer stopGNMI()
+
+	d := newFakeDevice("gnmi", gnmiAddress, deviceapi.Protocol_GNMI)
+	d.Credentials.User = "admin"
+	result := prober.probeProtocol(context.Background(), d, deviceapi.Protocol_GNMI)
+	assert.NoError(t, result.err)
+	assert.Equal(t, deviceapi.ChannelState_CONNECTED, result.channel)
+	assert.Equal(t, deviceapi.ServiceState_AVAILABLE, result.service)
+
+	d.Credentials.User = "foo"
+	result = prober.probeProtocol(context.Background(), d, deviceapi.Protocol_GNMI)
+	assert.Equal(t, codes.Unauthenticated, status.Code(result.err))
+	assert.Equal(t, deviceapi.ChannelState_CONNECTED, result.channel)
+	assert.Equal(t, deviceapi.ServiceState_UNAVAILABLE, result.service)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(p4RuntimeService, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	p4Address, stopP4 := startFakeDevice(t, func(s *grpc.Server) {
+		grpc_health_v1.RegisterHealthServer(s, healthServer)
+	})
+	defer stopP4()
+
+	d = newFakeDevice("p4", p4Address, deviceapi.Protocol_P4RUNTIME)
+	result = prober.probeProtocol(context.Background(), d, deviceapi.Protocol_P4RUNTIME)
+	assert.Error(t, result.err)
+	assert.Equal(t, deviceapi.ServiceState_UNAVAILABLE, result.service)
+
+	healthServer.SetServingStatus(p4RuntimeService, grpc_health_v1.HealthCheckResponse_SERVING)
+	result = prober.probeProtocol(context.Background(), d, deviceapi.Protocol_P4RUNTIME)
+	assert.NoError(t, result.err)
+	assert.Equal(t, deviceapi.ServiceState_AVAILABLE, result.service)
+
+	gnoiAddress, stopGNOI := startFakeDevice(t, func(s *grpc.Server) {
+		s.RegisterService(&grpc.ServiceDesc{
+			ServiceName: gnoiService,
+			HandlerType: (*interface{})(nil),
+		}, struct{}{})
+		reflection.Register(s)
+	})
+	defer stopGNOI()
+
+	d = newFakeDevice("gnoi", gnoiAddress, deviceapi.Protocol_GNOI)
+	result = prober.probeProtocol(context.Background(), d, deviceapi.Protocol_GNOI)
+	assert.NoError(t, result.err)
+	assert.Equal(t, deviceapi.ServiceState_AVAILABLE, result.service)
+
+	d = newFakeDevice("gnmi", gnoiAddress, deviceapi.Protocol_GNMI)
+	result = prober.probeProtocol(context.Background(), d, deviceapi.Protocol_GNMI)
+	assert.Equal(t, codes.Unimplemented, status.Code(result.err))
+	assert.Equal(t, deviceapi.ServiceState_UNAVAILABLE, result.service)
+}
+
+func TestProbeProtocolEndpoints(t *testing.T) {
+	prober := NewProber(nil, nil, nil, ProberConfig{})
+
+	gnmiAddress, stopGNMI := startFakeDevice(t, func(s *grpc.Server) {
+		gnmi.RegisterGNMIServer(s, &fakeGNMIServer{user: "admin"})
+	})
+	defer stopGNMI()
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(p4RuntimeService, grpc_health_v1.HealthCheckResponse_SERVING)
+	p4Address, stopP4 := startFakeDevice(t, func(s *grpc.Server) {
+		grpc_health_v1.RegisterHealthServer(s, healthServer)
+	})
+	defer stopP4()
+
+	// The gNMI endpoint defaults to the device address and the P4Runtime endpoint is on another port
+	d := newFakeDevice("stratum", gnmiAddress, deviceapi.Protocol_GNMI)
+	d.Credentials.User = "admin"
+	d.Endpoints = []*deviceapi.Endpoint{
+		{
+			Protocol:    deviceapi.Protocol_P4RUNTIME,
+			Address:     "grpc://" + p4Address,
+			Credentials: &deviceapi.Credentials{},
+		},
+	}
+	assert.Equal(t, []deviceapi.Protocol{deviceapi.Protocol_GNMI, deviceapi.Protocol_P4RUNTIME}, prober.protocols(d))
+
+	result := prober.probeProtocol(context.Background(), d, deviceapi.Protocol_GNMI)
+	assert.NoError(t, result.err)
+	assert.Equal(t, deviceapi.ServiceState_AVAILABLE, result.service)
+
+	result = prober.probeProtocol(context.Background(), d, deviceapi.Protocol_P4RUNTIME)
+	assert.NoError(t, result.err)
+	assert.Equal(t, deviceapi.ServiceState_AVAILABLE, result.service)
+}
+
+func TestProberProtocolState(t *testing.T) {
+	store, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+
+	address, stop := startFakeDevice(t, func(s *grpc.Server) {
+		gnmi.RegisterGNMIServer(s, &fakeGNMIServer{})
+	})
+
+	d := newFakeDevice("device-1", address, deviceapi.Protocol_GNMI)
+	d.Protocols = nil
+	assert.NoError(t, store.Store(d))
+
+	prober := NewProber(store, nil, nil, ProberConfig{
+		Interval:  50 * time.Millisecond,
+		Protocols: []deviceapi.Protocol{deviceapi.Protocol_GNMI},
+	})
+	assert.NoError(t, prober.Start())
+	defer prober.Stop()
+
+	var state *deviceapi.ProtocolState
+	assert.Eventually(t, func() bool {
+		d, err := store.Load(northbound.DefaultNamespace, "device-1")
+		assert.NoError(t, err)
+		if len(d.Protocols) != 1 {
+			return false
+		}
+		state = d.Protocols[0]
+		return state.ServiceState == deviceapi.ServiceState_AVAILABLE
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, deviceapi.Protocol_GNMI, state.Protocol)
+	assert.Equal(t, deviceapi.ConnectivityState_REACHABLE, state.ConnectivityState)
+	assert.Equal(t, deviceapi.ChannelState_CONNECTED, state.ChannelState)
+	assert.NotNil(t, state.LastStateChange)
+	assert.Equal(t, "", state.LastError)
+
+	stop()
+	assert.Eventually(t, func() bool {
+		d, err := store.Load(northbound.DefaultNamespace, "device-1")
+		assert.NoError(t, err)
+		state = d.Protocols[0]
+		return state.ServiceState == deviceapi.ServiceState_UNAVAILABLE
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, deviceapi.ConnectivityState_UNREACHABLE, state.ConnectivityState)
+	assert.Equal(t, deviceapi.ChannelState_DISCONNECTED, state.ChannelState)
+	assert.NotEqual(t, "", state.LastError)
+}
@@ -0,0 +1,243 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics exposes Prometheus metrics for the topology subsystem.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "onos_topo"
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of RPCs handled by the server, by method and status code.",
+	}, []string{"service", "method", "code"})
+
+	rpcLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of RPCs handled by the server, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	storeLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "operation_duration_seconds",
+		Help:      "Latency of store operations, by store and operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"store", "operation"})
+
+	storeErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "errors_total",
+		Help:      "Total number of failed store operations, by store and operation.",
+	}, []string{"store", "operation"})
+
+	storeConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "conflicts_total",
+		Help:      "Total number of store operations rejected by an optimistic lock, by store and operation.",
+	}, []string{"store", "operation"})
+
+	listSubscribers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "device",
+		Name:      "subscribers",
+		Help:      "Number of active device List subscribers.",
+	})
+
+	eventsSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "device",
+		Name:      "events_sent_total",
+		Help:      "Total number of device events sent to List subscribers, by event type.",
+	}, []string{"type"})
+
+	devices = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "device",
+		Name:      "devices",
+		Help:      "Number of devices in the topology, by type, role and state.",
+	}, []string{"type", "role", "state"})
+)
+
+func init() {
+	prometheus.MustRegister(rpcRequests, rpcLatency, storeLatency, storeErrors, storeConflicts, listSubscribers, eventsSent, devices)
+}
+
+// NewServer returns an HTTP server exposing the metrics on the given port.
+// The server is started with ListenAndServe and stopped with Shutdown.
+func NewServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: mux,
+	}
+}
+
+// splitMethod splits a full gRPC method name into service and method names
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// observeRPC records the outcome and latency of a single RPC
+func observeRPC(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	latency := time.Since(start)
+	rpcRequests.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	rpcLatency.WithLabelValues(service, method).Observe(latency.Seconds())
+	observeLatency(service, method, latency, err)
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor recording metrics for unary RPCs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor recording metrics for streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// ObserveStoreOperation records the latency and outcome of a store operation.
+// A conflict indicates the operation failed an optimistic lock check.
+func ObserveStoreOperation(store string, operation string, start time.Time, err error, conflict bool) {
+	storeLatency.WithLabelValues(store, operation).Observe(time.Since(start).Seconds())
+	observeStoreStatus(store, err, conflict)
+	if conflict {
+		storeConflicts.WithLabelValues(store, operation).Inc()
+	} else if err != nil {
+		storeErrors.WithLabelValues(store, operation).Inc()
+	}
+}
+
+// SubscriberAdded records the start of a device List subscription.
+func SubscriberAdded() {
+	listSubscribers.Inc()
+}
+
+// SubscriberRemoved records the end of a device List subscription.
+func SubscriberRemoved() {
+	listSubscribers.Dec()
+}
+
+// EventSent records a device event sent to a List subscriber.
+func EventSent(eventType string) {
+	eventsSent.WithLabelValues(eventType).Inc()
+}
+
+// deviceLabels are the labels under which a device is counted
+type deviceLabels struct {
+	deviceType string
+	role       string
+	state      string
+}
+
+var (
+	// deviceMu guards the labels and counts of the devices
+	deviceMu sync.Mutex
+	// deviceKeys holds the labels of each counted device by namespaced key
+	deviceKeys = make(map[string]deviceLabels)
+	// deviceCounts holds the number of devices under each set of labels
+	deviceCounts = make(map[deviceLabels]int)
+)
+
+// DeviceUpdated counts the device with the given namespaced key, replacing any previous count of it.
+// Together with DeviceRemoved, it keeps the device counts up to date from the device watch stream.
+func DeviceUpdated(key string, device *deviceapi.Device) {
+	labels := deviceLabels{string(device.Type), string(device.Role), DeviceState(device)}
+	deviceMu.Lock()
+	defer deviceMu.Unlock()
+	if old, ok := deviceKeys[key]; ok {
+		if old == labels {
+			return
+		}
+		uncountDevice(old)
+	}
+	deviceKeys[key] = labels
+	deviceCounts[labels]++
+	devices.WithLabelValues(labels.deviceType, labels.role, labels.state).Set(float64(deviceCounts[labels]))
+}
+
+// DeviceRemoved stops counting the device with the given namespaced key
+func DeviceRemoved(key string) {
+	deviceMu.Lock()
+	defer deviceMu.Unlock()
+	if old, ok := deviceKeys[key]; ok {
+		delete(deviceKeys, key)
+		uncountDevice(old)
+	}
+}
+
+// ResetDevices stops counting all devices
+func ResetDevices() {
+	deviceMu.Lock()
+	defer deviceMu.Unlock()
+	deviceKeys = make(map[string]deviceLabels)
+	deviceCounts = make(map[deviceLabels]int)
+	devices.Reset()
+}
+
+// uncountDevice decrements the count of devices under the given labels, removing the series at zero.
+// The device lock must be held.
+func uncountDevice(labels deviceLabels) {
+	deviceCounts[labels]--
+	if deviceCounts[labels] > 0 {
+		devices.WithLabelValues(labels.deviceType, labels.role, labels.state).Set(float64(deviceCounts[labels]))
+		return
+	}
+	delete(deviceCounts, labels)
+	devices.DeleteLabelValues(labels.deviceType, labels.role, labels.state)
+}
+
+// DeviceState returns a summary of the connectivity state of the given device.
+// A device is reachable if any of its protocols is reachable.
+func DeviceState(device *deviceapi.Device) string {
+	return device.GetConnectivityState().String()
+}
@@ -0,0 +1,108 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/topo.device.DeviceService/Add")
+	assert.Equal(t, "topo.device.DeviceService", service)
+	assert.Equal(t, "Add", method)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/topo.device.DeviceService/Get"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "device not found")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(rpcRequests.WithLabelValues("topo.device.DeviceService", "Get", "NotFound")))
+}
+
+func TestObserveStoreOperation(t *testing.T) {
+	ObserveStoreOperation("test", "store", time.Now(), errors.New("write condition failed"), true)
+	ObserveStoreOperation("test", "store", time.Now(), errors.New("timeout"), false)
+	ObserveStoreOperation("test", "store", time.Now(), nil, false)
+	assert.Equal(t, float64(1), testutil.ToFloat64(storeConflicts.WithLabelValues("test", "store")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(storeErrors.WithLabelValues("test", "store")))
+}
+
+func TestDeviceMetrics(t *testing.T) {
+	list := []*deviceapi.Device{
+		{
+			ID:   "leaf-1",
+			Type: "Stratum",
+			Role: "leaf",
+			Protocols: []*deviceapi.ProtocolState{
+				{Protocol: deviceapi.Protocol_GNMI, ConnectivityState: deviceapi.ConnectivityState_UNREACHABLE},
+				{Protocol: deviceapi.Protocol_P4RUNTIME, ConnectivityState: deviceapi.ConnectivityState_REACHABLE},
+			},
+		},
+		{
+			ID:   "leaf-2",
+			Type: "Stratum",
+			Role: "leaf",
+			Protocols: []*deviceapi.ProtocolState{
+				{Protocol: deviceapi.Protocol_GNMI, ConnectivityState: deviceapi.ConnectivityState_REACHABLE},
+			},
+		},
+		{
+			ID:   "spine-1",
+			Type: "Stratum",
+			Role: "spine",
+		},
+	}
+
+	ResetDevices()
+	defer ResetDevices()
+	for _, device := range list {
+		DeviceUpdated(string(device.ID), device)
+	}
+	// Replaying a device does not count it twice
+	DeviceUpdated("spine-1", list[2])
+	assert.Equal(t, float64(2), testutil.ToFloat64(devices.WithLabelValues("Stratum", "leaf", "REACHABLE")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(devices.WithLabelValues("Stratum", "spine", "UNKNOWN_CONNECTIVITY_STATE")))
+
+	// A device whose state changes moves to the series of its new state
+	unreachable := *list[1]
+	unreachable.Protocols = []*deviceapi.ProtocolState{
+		{Protocol: deviceapi.Protocol_GNMI, ConnectivityState: deviceapi.ConnectivityState_UNREACHABLE},
+	}
+	DeviceUpdated("leaf-2", &unreachable)
+	assert.Equal(t, float64(1), testutil.ToFloat64(devices.WithLabelValues("Stratum", "leaf", "REACHABLE")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(devices.WithLabelValues("Stratum", "leaf", "UNREACHABLE")))
+
+	// Series are removed when their last device is removed
+	DeviceRemoved("spine-1")
+	DeviceRemoved("spine-1")
+	ch := make(chan prometheus.Metric, 10)
+	devices.Collect(ch)
+	assert.Len(t, ch, 2)
+}
@@ -0,0 +1,188 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencySamples is the number of recent calls over which latency percentiles are computed
+const latencySamples = 1024
+
+// LatencySummary summarizes the latency of an RPC method
+type LatencySummary struct {
+	Service string
+	Method  string
+	Count   uint64
+	Errors  uint64
+	Mean    time.Duration
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+	Max     time.Duration
+}
+
+// methodLatency records the latency of calls to a single method
+type methodLatency struct {
+	count   uint64
+	errors  uint64
+	total   time.Duration
+	max     time.Duration
+	samples []time.Duration
+	next    int
+}
+
+func (l *methodLatency) observe(latency time.Duration, err error) {
+	l.count++
+	if err != nil {
+		l.errors++
+	}
+	l.total += latency
+	if latency > l.max {
+		l.max = latency
+	}
+	if len(l.samples) < latencySamples {
+		l.samples = append(l.samples, latency)
+	} else {
+		l.samples[l.next] = latency
+		l.next = (l.next + 1) % latencySamples
+	}
+}
+
+func (l *methodLatency) summary(service string, method string) LatencySummary {
+	samples := make([]time.Duration, len(l.samples))
+	copy(samples, l.samples)
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i] < samples[j]
+	})
+	percentile := func(p int) time.Duration {
+		if len(samples) == 0 {
+			return 0
+		}
+		return samples[(len(samples)-1)*p/100]
+	}
+	var mean time.Duration
+	if l.count > 0 {
+		mean = l.total / time.Duration(l.count)
+	}
+	return LatencySummary{
+		Service: service,
+		Method:  method,
+		Count:   l.count,
+		Errors:  l.errors,
+		Mean:    mean,
+		P50:     percentile(50),
+		P90:     percentile(90),
+		P99:     percentile(99),
+		Max:     l.max,
+	}
+}
+
+type methodKey struct {
+	service string
+	method  string
+}
+
+var (
+	latencyMu sync.Mutex
+	latencies = make(map[methodKey]*methodLatency)
+)
+
+// observeLatency records the latency of a call to the given method
+func observeLatency(service string, method string, latency time.Duration, err error) {
+	latencyMu.Lock()
+	defer latencyMu.Unlock()
+	key := methodKey{service, method}
+	l, ok := latencies[key]
+	if !ok {
+		l = &methodLatency{}
+		latencies[key] = l
+	}
+	l.observe(latency, err)
+}
+
+// Latencies returns latency summaries of the RPC methods called since the process started,
+// ordered by service and method
+func Latencies() []LatencySummary {
+	latencyMu.Lock()
+	defer latencyMu.Unlock()
+	summaries := make([]LatencySummary, 0, len(latencies))
+	for key, l := range latencies {
+		summaries = append(summaries, l.summary(key.service, key.method))
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Service != summaries[j].Service {
+			return summaries[i].Service < summaries[j].Service
+		}
+		return summaries[i].Method < summaries[j].Method
+	})
+	return summaries
+}
+
+// StoreStatus describes the outcome of the operations performed on a store primitive.
+// Operations rejected by an optimistic lock are counted as conflicts rather than errors
+// since the store did respond to them.
+type StoreStatus struct {
+	Store       string
+	Operations  uint64
+	Errors      uint64
+	Conflicts   uint64
+	LastSuccess time.Time
+	LastFailure time.Time
+	LastError   string
+}
+
+var (
+	storeStatusMu sync.Mutex
+	storeStatuses = make(map[string]*StoreStatus)
+)
+
+// observeStoreStatus records the outcome of an operation on the given store
+func observeStoreStatus(store string, err error, conflict bool) {
+	storeStatusMu.Lock()
+	defer storeStatusMu.Unlock()
+	status, ok := storeStatuses[store]
+	if !ok {
+		status = &StoreStatus{Store: store}
+		storeStatuses[store] = status
+	}
+	status.Operations++
+	if conflict {
+		status.Conflicts++
+		status.LastSuccess = time.Now()
+	} else if err != nil {
+		status.Errors++
+		status.LastFailure = time.Now()
+		status.LastError = err.Error()
+	} else {
+		status.LastSuccess = time.Now()
+	}
+}
+
+// StoreStatuses returns the status of the store primitives used since the process started, ordered by name
+func StoreStatuses() []StoreStatus {
+	storeStatusMu.Lock()
+	defer storeStatusMu.Unlock()
+	statuses := make([]StoreStatus, 0, len(storeStatuses))
+	for _, status := range storeStatuses {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Store < statuses[j].Store
+	})
+	return statuses
+}
@@ -0,0 +1,86 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencies(t *testing.T) {
+	for i := 1; i <= 100; i++ {
+		var err error
+		if i%10 == 0 {
+			err = errors.New("failed")
+		}
+		observeLatency("test.Latency", "Get", time.Duration(i)*time.Millisecond, err)
+	}
+	observeLatency("test.Latency", "Add", time.Millisecond, nil)
+
+	var summaries []LatencySummary
+	for _, summary := range Latencies() {
+		if summary.Service == "test.Latency" {
+			summaries = append(summaries, summary)
+		}
+	}
+	assert.Len(t, summaries, 2)
+	assert.Equal(t, "Add", summaries[0].Method)
+
+	get := summaries[1]
+	assert.Equal(t, uint64(100), get.Count)
+	assert.Equal(t, uint64(10), get.Errors)
+	assert.Equal(t, 50500*time.Microsecond, get.Mean)
+	assert.Equal(t, 50*time.Millisecond, get.P50)
+	assert.Equal(t, 90*time.Millisecond, get.P90)
+	assert.Equal(t, 99*time.Millisecond, get.P99)
+	assert.Equal(t, 100*time.Millisecond, get.Max)
+}
+
+func TestLatencySamples(t *testing.T) {
+	l := &methodLatency{}
+	for i := 0; i < latencySamples; i++ {
+		l.observe(time.Second, nil)
+	}
+	for i := 0; i < latencySamples; i++ {
+		l.observe(time.Millisecond, nil)
+	}
+	summary := l.summary("test", "Get")
+	assert.Equal(t, uint64(2*latencySamples), summary.Count)
+	assert.Equal(t, time.Millisecond, summary.P99)
+	assert.Equal(t, time.Second, summary.Max)
+}
+
+func TestStoreStatuses(t *testing.T) {
+	ObserveStoreOperation("test-status", "load", time.Now(), nil, false)
+	ObserveStoreOperation("test-status", "store", time.Now(), errors.New("write condition failed"), true)
+	ObserveStoreOperation("test-status", "store", time.Now(), errors.New("timeout"), false)
+
+	for _, status := range StoreStatuses() {
+		if status.Store != "test-status" {
+			continue
+		}
+		assert.Equal(t, uint64(3), status.Operations)
+		assert.Equal(t, uint64(1), status.Errors)
+		assert.Equal(t, uint64(1), status.Conflicts)
+		assert.Equal(t, "timeout", status.LastError)
+		assert.False(t, status.LastSuccess.IsZero())
+		assert.False(t, status.LastFailure.IsZero())
+		return
+	}
+	t.Fatal("store status not found")
+}
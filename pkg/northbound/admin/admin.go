@@ -16,22 +16,72 @@
 package admin
 
 import (
+	"context"
+
 	"github.com/onosproject/onos-topo/api/admin"
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
 	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/onosproject/onos-topo/pkg/northbound/graph"
 	"google.golang.org/grpc"
 )
 
+// Admitter admits device operations through the admission chain of the device service
+type Admitter interface {
+	// Admit runs the admission chain for the given operation on the given device, returning the admitted device
+	Admit(ctx context.Context, operation admissionapi.Operation, device *deviceapi.Device) (*deviceapi.Device, error)
+}
+
+// NewService returns a new admin Service backing up and restoring the given device, device type and link
+// stores and managing the mode persisted in the given mode store. Imported devices are admitted by the
+// given admitter and subject to the given namespace quotas.
+func NewService(deviceStore device.Store, typeStore device.TypeStore, linkStore graph.LinkStore, modeStore device.ModeStore, admitter Admitter, quotas device.Quotas) northbound.Service {
+	return Service{
+		deviceStore: deviceStore,
+		typeStore:   typeStore,
+		linkStore:   linkStore,
+		modeStore:   modeStore,
+		admitter:    admitter,
+		quotas:      quotas,
+	}
+}
+
 // Service is a Service implementation for administration.
 type Service struct {
 	northbound.Service
+	deviceStore device.Store
+	typeStore   device.TypeStore
+	linkStore   graph.LinkStore
+	modeStore   device.ModeStore
+	admitter    Admitter
+	quotas      device.Quotas
 }
 
 // Register registers the Service with the gRPC server.
 func (s Service) Register(r *grpc.Server) {
-	server := Server{}
-	admin.RegisterTopoAdminServiceServer(r, server)
+	admin.RegisterTopoAdminServiceServer(r, NewServer(s.deviceStore, s.typeStore, s.linkStore, s.modeStore, s.admitter, s.quotas))
+}
+
+// NewServer returns a new admin Server for the given device, device type, link and mode stores, the
+// given admitter of imported devices and the given namespace quotas
+func NewServer(deviceStore device.Store, typeStore device.TypeStore, linkStore graph.LinkStore, modeStore device.ModeStore, admitter Admitter, quotas device.Quotas) *Server {
+	return &Server{
+		deviceStore: deviceStore,
+		typeStore:   typeStore,
+		linkStore:   linkStore,
+		modeStore:   modeStore,
+		admitter:    admitter,
+		quotas:      quotas,
+	}
 }
 
 // Server implements the gRPC service for administrative facilities.
 type Server struct {
+	deviceStore device.Store
+	typeStore   device.TypeStore
+	linkStore   graph.LinkStore
+	modeStore   device.ModeStore
+	admitter    Admitter
+	quotas      device.Quotas
 }
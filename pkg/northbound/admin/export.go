@@ -0,0 +1,381 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+// Export renders the devices of the request namespace matching the request filters and the links
+// between them as a graph
+func (s *Server) Export(ctx context.Context, request *admin.ExportRequest) (*admin.ExportResponse, error) {
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	devices, err := s.listDevices()
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.listLinks()
+	if err != nil {
+		return nil, err
+	}
+	devices, links = filterExport(namespace, request, devices, links)
+
+	var data []byte
+	switch request.Format {
+	case admin.ExportFormat_DOT:
+		data = renderDOT(devices, links)
+	case admin.ExportFormat_GRAPHML:
+		data, err = renderGraphML(devices, links)
+	case admin.ExportFormat_JSON_GRAPH:
+		data, err = renderJSONGraph(devices, links)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "export format %d is not supported", request.Format)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	log.Infof("Exported %d devices and %d links as %s", len(devices), len(links), request.Format)
+	return &admin.ExportResponse{
+		Data:    data,
+		Devices: uint64(len(devices)),
+		Links:   uint64(len(links)),
+	}, nil
+}
+
+// filterExport returns the devices of the given namespace matching all the request filters and the links
+// between them
+func filterExport(namespace string, request *admin.ExportRequest, devices []*deviceapi.Device, links []*graphapi.Link) ([]*deviceapi.Device, []*graphapi.Link) {
+	ids := make(map[deviceapi.ID]bool)
+	for _, id := range request.Devices {
+		ids[id] = true
+	}
+	types := make(map[deviceapi.Type]bool)
+	for _, t := range request.Types {
+		types[t] = true
+	}
+	roles := make(map[deviceapi.Role]bool)
+	for _, role := range request.Roles {
+		roles[role] = true
+	}
+	states := make(map[deviceapi.LifecycleState]bool)
+	for _, state := range request.LifecycleStates {
+		states[state] = true
+	}
+
+	exported := make(map[deviceapi.ID]bool)
+	filteredDevices := make([]*deviceapi.Device, 0, len(devices))
+	for _, d := range devices {
+		if d.Namespace != namespace ||
+			(len(ids) > 0 && !ids[d.ID]) ||
+			(len(types) > 0 && !types[d.Type]) ||
+			(len(roles) > 0 && !roles[d.Role]) ||
+			(len(states) > 0 && !states[d.LifecycleState]) {
+			continue
+		}
+		exported[d.ID] = true
+		filteredDevices = append(filteredDevices, d)
+	}
+
+	filteredLinks := make([]*graphapi.Link, 0, len(links))
+	for _, link := range links {
+		if link.Namespace == namespace && exported[link.Source] && exported[link.Target] {
+			filteredLinks = append(filteredLinks, link)
+		}
+	}
+	return filteredDevices, filteredLinks
+}
+
+// connectivityState summarizes the reachability of a device over all its protocols: a device is
+// unreachable if it is unreachable over any protocol
+func connectivityState(d *deviceapi.Device) deviceapi.ConnectivityState {
+	state := deviceapi.ConnectivityState_UNKNOWN_CONNECTIVITY_STATE
+	for _, protocol := range d.Protocols {
+		switch protocol.ConnectivityState {
+		case deviceapi.ConnectivityState_UNREACHABLE:
+			return deviceapi.ConnectivityState_UNREACHABLE
+		case deviceapi.ConnectivityState_REACHABLE:
+			state = deviceapi.ConnectivityState_REACHABLE
+		}
+	}
+	return state
+}
+
+// nodeColor returns the color in which a device is drawn: unreachable devices are red and reachable
+// devices green
+func nodeColor(d *deviceapi.Device) string {
+	switch connectivityState(d) {
+	case deviceapi.ConnectivityState_UNREACHABLE:
+		return "red"
+	case deviceapi.ConnectivityState_REACHABLE:
+		return "palegreen"
+	default:
+		return "white"
+	}
+}
+
+// nodeLabel returns the lines of the label of a device: its ID, type, role and connectivity state
+func nodeLabel(d *deviceapi.Device) []string {
+	lines := []string{string(d.ID)}
+	if d.Type != "" {
+		lines = append(lines, string(d.Type))
+	}
+	if d.Role != "" {
+		lines = append(lines, string(d.Role))
+	}
+	return append(lines, connectivityState(d).String())
+}
+
+// linkWeight returns the effective weight of a link
+func linkWeight(link *graphapi.Link) uint32 {
+	if link.Weight == 0 {
+		return 1
+	}
+	return link.Weight
+}
+
+// dotQuote quotes a string as a DOT identifier
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// renderDOT renders devices and links in the Graphviz DOT language. Devices that are not active
+// are drawn dashed, and undirected links are drawn without arrows.
+func renderDOT(devices []*deviceapi.Device, links []*graphapi.Link) []byte {
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "digraph topology {")
+	fmt.Fprintln(buf, "  node [shape=box, style=filled, fillcolor=white];")
+	for _, d := range devices {
+		style := "filled"
+		if !d.LifecycleState.IsActive() {
+			style = "filled,dashed"
+		}
+		fmt.Fprintf(buf, "  %s [label=%s, style=%s, fillcolor=%s];\n", dotQuote(string(d.ID)),
+			dotQuote(strings.Join(nodeLabel(d), "\n")), dotQuote(style), dotQuote(nodeColor(d)))
+	}
+	for _, link := range links {
+		attributes := []string{
+			"id=" + dotQuote(string(link.ID)),
+			"label=" + dotQuote(string(link.ID)),
+			"weight=" + strconv.Itoa(int(linkWeight(link))),
+		}
+		if link.SourcePort != "" {
+			attributes = append(attributes, "taillabel="+dotQuote(link.SourcePort))
+		}
+		if link.TargetPort != "" {
+			attributes = append(attributes, "headlabel="+dotQuote(link.TargetPort))
+		}
+		if !link.Directed {
+			attributes = append(attributes, "dir=none")
+		}
+		fmt.Fprintf(buf, "  %s -> %s [%s];\n", dotQuote(string(link.Source)), dotQuote(string(link.Target)),
+			strings.Join(attributes, ", "))
+	}
+	fmt.Fprintln(buf, "}")
+	return buf.Bytes()
+}
+
+// graphML is a GraphML document
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+// graphMLKey declares an attribute of the nodes or edges of a GraphML graph
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+// graphMLGraph is a GraphML graph
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+// graphMLNode is a GraphML node
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+// graphMLEdge is a GraphML edge
+type graphMLEdge struct {
+	ID       string        `xml:"id,attr"`
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed bool          `xml:"directed,attr"`
+	Data     []graphMLData `xml:"data"`
+}
+
+// graphMLData is the value of a GraphML attribute
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLKeys are the attributes of exported GraphML nodes and edges
+var graphMLKeys = []graphMLKey{
+	{ID: "label", For: "node", Name: "label", Type: "string"},
+	{ID: "type", For: "node", Name: "type", Type: "string"},
+	{ID: "role", For: "node", Name: "role", Type: "string"},
+	{ID: "address", For: "node", Name: "address", Type: "string"},
+	{ID: "state", For: "node", Name: "state", Type: "string"},
+	{ID: "lifecycleState", For: "node", Name: "lifecycleState", Type: "string"},
+	{ID: "color", For: "node", Name: "color", Type: "string"},
+	{ID: "sourcePort", For: "edge", Name: "sourcePort", Type: "string"},
+	{ID: "targetPort", For: "edge", Name: "targetPort", Type: "string"},
+	{ID: "weight", For: "edge", Name: "weight", Type: "int"},
+}
+
+// renderGraphML renders devices and links as a GraphML document
+func renderGraphML(devices []*deviceapi.Device, links []*graphapi.Link) ([]byte, error) {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphMLKeys,
+		Graph: graphMLGraph{
+			ID:          "topology",
+			EdgeDefault: "undirected",
+		},
+	}
+	for _, d := range devices {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: string(d.ID),
+			Data: []graphMLData{
+				{Key: "label", Value: strings.Join(nodeLabel(d), "\n")},
+				{Key: "type", Value: string(d.Type)},
+				{Key: "role", Value: string(d.Role)},
+				{Key: "address", Value: d.Address},
+				{Key: "state", Value: connectivityState(d).String()},
+				{Key: "lifecycleState", Value: d.LifecycleState.String()},
+				{Key: "color", Value: nodeColor(d)},
+			},
+		})
+	}
+	for _, link := range links {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:       string(link.ID),
+			Source:   string(link.Source),
+			Target:   string(link.Target),
+			Directed: link.Directed,
+			Data: []graphMLData{
+				{Key: "sourcePort", Value: link.SourcePort},
+				{Key: "targetPort", Value: link.TargetPort},
+				{Key: "weight", Value: strconv.Itoa(int(linkWeight(link)))},
+			},
+		})
+	}
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// jsonGraph is a JSON Graph Format document
+type jsonGraph struct {
+	Graph jsonGraphGraph `json:"graph"`
+}
+
+// jsonGraphGraph is a JSON Graph Format graph
+type jsonGraphGraph struct {
+	ID       string                   `json:"id"`
+	Directed bool                     `json:"directed"`
+	Nodes    map[string]jsonGraphNode `json:"nodes"`
+	Edges    []jsonGraphEdge          `json:"edges"`
+}
+
+// jsonGraphNode is a JSON Graph Format node
+type jsonGraphNode struct {
+	Label    string            `json:"label"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// jsonGraphEdge is a JSON Graph Format edge
+type jsonGraphEdge struct {
+	ID       string                 `json:"id"`
+	Source   string                 `json:"source"`
+	Target   string                 `json:"target"`
+	Directed bool                   `json:"directed"`
+	Relation string                 `json:"relation"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// renderJSONGraph renders devices and links as a JSON Graph Format document
+func renderJSONGraph(devices []*deviceapi.Device, links []*graphapi.Link) ([]byte, error) {
+	doc := jsonGraph{
+		Graph: jsonGraphGraph{
+			ID:       "topology",
+			Directed: true,
+			Nodes:    make(map[string]jsonGraphNode),
+			Edges:    make([]jsonGraphEdge, 0, len(links)),
+		},
+	}
+	for _, d := range devices {
+		doc.Graph.Nodes[string(d.ID)] = jsonGraphNode{
+			Label: strings.Join(nodeLabel(d), "\n"),
+			Metadata: map[string]string{
+				"type":           string(d.Type),
+				"role":           string(d.Role),
+				"address":        d.Address,
+				"state":          connectivityState(d).String(),
+				"lifecycleState": d.LifecycleState.String(),
+				"color":          nodeColor(d),
+			},
+		}
+	}
+	for _, link := range links {
+		doc.Graph.Edges = append(doc.Graph.Edges, jsonGraphEdge{
+			ID:       string(link.ID),
+			Source:   string(link.Source),
+			Target:   string(link.Target),
+			Directed: link.Directed,
+			Relation: "link",
+			Metadata: map[string]interface{}{
+				"sourcePort": link.SourcePort,
+				"targetPort": link.TargetPort,
+				"weight":     linkWeight(link),
+				"attributes": link.Attributes,
+			},
+		})
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
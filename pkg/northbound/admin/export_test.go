@@ -0,0 +1,145 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newExportTopo(t *testing.T) *testTopo {
+	topo := newTestTopo(t)
+	leaf1 := newTestDevice("leaf-1")
+	leaf1.Role = "leaf"
+	leaf1.Protocols = []*deviceapi.ProtocolState{
+		{Protocol: deviceapi.Protocol_GNMI, ConnectivityState: deviceapi.ConnectivityState_REACHABLE},
+		{Protocol: deviceapi.Protocol_P4RUNTIME, ConnectivityState: deviceapi.ConnectivityState_UNREACHABLE},
+	}
+	leaf2 := newTestDevice("leaf-2")
+	leaf2.Role = "leaf"
+	leaf2.LifecycleState = deviceapi.LifecycleState_MAINTENANCE
+	spine1 := newTestDevice("spine-1")
+	spine1.Role = "spine"
+	spine1.Protocols = []*deviceapi.ProtocolState{
+		{Protocol: deviceapi.Protocol_GNMI, ConnectivityState: deviceapi.ConnectivityState_REACHABLE},
+	}
+	for _, d := range []*deviceapi.Device{leaf1, leaf2, spine1} {
+		assert.NoError(t, topo.deviceStore.Store(d))
+	}
+	assert.NoError(t, topo.linkStore.Store(&graphapi.Link{ID: "leaf-1-spine-1", Source: "leaf-1", SourcePort: "1", Target: "spine-1", TargetPort: "3"}))
+	assert.NoError(t, topo.linkStore.Store(&graphapi.Link{ID: "leaf-2-spine-1", Source: "leaf-2", Target: "spine-1", Directed: true, Weight: 5}))
+	return topo
+}
+
+func TestExportDOT(t *testing.T) {
+	topo := newExportTopo(t)
+	defer topo.close()
+
+	response, err := topo.client.Export(context.Background(), &admin.ExportRequest{Format: admin.ExportFormat_DOT})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), response.Devices)
+	assert.Equal(t, uint64(2), response.Links)
+
+	dot := string(response.Data)
+	assert.True(t, strings.HasPrefix(dot, "digraph topology {\n"))
+	assert.Contains(t, dot, `"leaf-1" [label="leaf-1\nStratum\nleaf\nUNREACHABLE", style="filled", fillcolor="red"];`)
+	assert.Contains(t, dot, `"leaf-2" [label="leaf-2\nStratum\nleaf\nUNKNOWN_CONNECTIVITY_STATE", style="filled,dashed", fillcolor="white"];`)
+	assert.Contains(t, dot, `"spine-1" [label="spine-1\nStratum\nspine\nREACHABLE", style="filled", fillcolor="palegreen"];`)
+	assert.Contains(t, dot, `"leaf-1" -> "spine-1" [id="leaf-1-spine-1", label="leaf-1-spine-1", weight=1, taillabel="1", headlabel="3", dir=none];`)
+	assert.Contains(t, dot, `"leaf-2" -> "spine-1" [id="leaf-2-spine-1", label="leaf-2-spine-1", weight=5];`)
+}
+
+func TestExportFilters(t *testing.T) {
+	topo := newExportTopo(t)
+	defer topo.close()
+
+	response, err := topo.client.Export(context.Background(), &admin.ExportRequest{
+		Format: admin.ExportFormat_DOT,
+		Roles:  []deviceapi.Role{"leaf"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Devices)
+	assert.Equal(t, uint64(0), response.Links)
+	assert.NotContains(t, string(response.Data), "spine-1")
+
+	response, err = topo.client.Export(context.Background(), &admin.ExportRequest{
+		Format:          admin.ExportFormat_DOT,
+		LifecycleStates: []deviceapi.LifecycleState{deviceapi.LifecycleState_IN_SERVICE},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Devices)
+	assert.Equal(t, uint64(1), response.Links)
+
+	response, err = topo.client.Export(context.Background(), &admin.ExportRequest{
+		Format:  admin.ExportFormat_DOT,
+		Devices: []deviceapi.ID{"leaf-2", "spine-1"},
+		Types:   []deviceapi.Type{"Stratum"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Devices)
+	assert.Equal(t, uint64(1), response.Links)
+	assert.Contains(t, string(response.Data), "leaf-2-spine-1")
+
+	_, err = topo.client.Export(context.Background(), &admin.ExportRequest{Format: admin.ExportFormat(10)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExportGraphML(t *testing.T) {
+	topo := newExportTopo(t)
+	defer topo.close()
+
+	response, err := topo.client.Export(context.Background(), &admin.ExportRequest{Format: admin.ExportFormat_GRAPHML})
+	assert.NoError(t, err)
+
+	doc := &graphML{}
+	assert.NoError(t, xml.Unmarshal(response.Data, doc))
+	assert.Equal(t, "undirected", doc.Graph.EdgeDefault)
+	assert.Len(t, doc.Graph.Nodes, 3)
+	assert.Equal(t, "leaf-1", doc.Graph.Nodes[0].ID)
+	assert.Contains(t, doc.Graph.Nodes[0].Data, graphMLData{Key: "color", Value: "red"})
+	assert.Contains(t, doc.Graph.Nodes[1].Data, graphMLData{Key: "lifecycleState", Value: "MAINTENANCE"})
+	assert.Len(t, doc.Graph.Edges, 2)
+	assert.False(t, doc.Graph.Edges[0].Directed)
+	assert.True(t, doc.Graph.Edges[1].Directed)
+	assert.Contains(t, doc.Graph.Edges[1].Data, graphMLData{Key: "weight", Value: "5"})
+}
+
+func TestExportJSONGraph(t *testing.T) {
+	topo := newExportTopo(t)
+	defer topo.close()
+
+	response, err := topo.client.Export(context.Background(), &admin.ExportRequest{Format: admin.ExportFormat_JSON_GRAPH})
+	assert.NoError(t, err)
+
+	doc := &jsonGraph{}
+	assert.NoError(t, json.Unmarshal(response.Data, doc))
+	assert.Len(t, doc.Graph.Nodes, 3)
+	assert.Equal(t, "spine-1\nStratum\nspine\nREACHABLE", doc.Graph.Nodes["spine-1"].Label)
+	assert.Equal(t, "red", doc.Graph.Nodes["leaf-1"].Metadata["color"])
+	assert.Len(t, doc.Graph.Edges, 2)
+	assert.Equal(t, "leaf-1-spine-1", doc.Graph.Edges[0].ID)
+	assert.Equal(t, "3", doc.Graph.Edges[0].Metadata["targetPort"])
+	assert.Equal(t, float64(5), doc.Graph.Edges[1].Metadata["weight"])
+}
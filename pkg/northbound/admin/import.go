@@ -0,0 +1,306 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/onosproject/onos-topo/api/admin"
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+// importedRecord is a record mapped to a device
+type importedRecord struct {
+	record uint64
+	device *deviceapi.Device
+}
+
+// Import maps the records of an inventory to devices of the request namespace and adds or updates them
+// through the admission chain of the device service. Records that cannot be mapped to a valid device,
+// records defining the same device differently, records that would exceed the quota of the namespace and
+// records denied admission are reported and skipped.
+func (s *Server) Import(ctx context.Context, request *admin.ImportRequest) (*admin.ImportResponse, error) {
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := importMapping(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	records, err := parseInventory(request.Format, request.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s inventory: %v", request.Format, err)
+	}
+	if !request.DryRun {
+		if err := device.CheckWritable(s.modeStore); err != nil {
+			return nil, err
+		}
+	}
+
+	devices, err := s.listDevices()
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[deviceapi.ID]*deviceapi.Device)
+	for _, d := range devices {
+		if d.Namespace == namespace {
+			existing[d.ID] = d
+		}
+	}
+	count := len(existing)
+	deviceTypes, err := s.listDeviceTypes()
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[deviceapi.Type]*deviceapi.DeviceType)
+	for _, deviceType := range deviceTypes {
+		types[deviceType.Name] = deviceType
+	}
+
+	response := &admin.ImportResponse{
+		DryRun:  request.DryRun,
+		Records: uint64(len(records)),
+	}
+	addResult := func(record uint64, id deviceapi.ID, action admin.ImportAction, message string) {
+		response.Results = append(response.Results, &admin.ImportResult{
+			Record:  record,
+			ID:      id,
+			Action:  action,
+			Message: message,
+		})
+		switch action {
+		case admin.ImportAction_CREATE:
+			response.Created++
+		case admin.ImportAction_UPDATE:
+			response.Updated++
+		case admin.ImportAction_UNCHANGED:
+			response.Unchanged++
+		case admin.ImportAction_CONFLICT:
+			response.Conflicts++
+		case admin.ImportAction_INVALID:
+			response.Invalid++
+		}
+	}
+
+	// Map the records to devices, grouping them by device ID in the order in which they appear
+	var ids []deviceapi.ID
+	imported := make(map[deviceapi.ID][]importedRecord)
+	for _, r := range records {
+		d, err := importDevice(r, namespace, mapping, request, existing, types)
+		if err != nil {
+			var id deviceapi.ID
+			if d != nil {
+				id = d.ID
+			}
+			addResult(r.number, id, admin.ImportAction_INVALID, status.Convert(err).Message())
+			continue
+		}
+		if _, ok := imported[d.ID]; !ok {
+			ids = append(ids, d.ID)
+		}
+		imported[d.ID] = append(imported[d.ID], importedRecord{record: r.number, device: d})
+	}
+
+	for _, id := range ids {
+		group := imported[id]
+		first := group[0]
+		if conflict := conflictingRecord(group); conflict != nil {
+			for _, r := range group {
+				addResult(r.record, id, admin.ImportAction_CONFLICT,
+					fmt.Sprintf("records %d and %d define device '%s' differently", first.record, conflict.record, id))
+			}
+			continue
+		}
+		response.Duplicates += uint64(len(group) - 1)
+
+		d := first.device
+		old := existing[id]
+		operation := admissionapi.Operation_UPDATE
+		if old == nil {
+			if err := s.quotas.Check(namespace, count); err != nil {
+				addResult(first.record, id, admin.ImportAction_CONFLICT, status.Convert(err).Message())
+				continue
+			}
+			d.Revision = 0
+			operation = admissionapi.Operation_CREATE
+		} else if reflect.DeepEqual(old, d) {
+			addResult(first.record, id, admin.ImportAction_UNCHANGED, "")
+			continue
+		}
+
+		d, err := s.admitter.Admit(ctx, operation, d)
+		if err != nil {
+			action := admin.ImportAction_CONFLICT
+			if status.Code(err) == codes.InvalidArgument {
+				action = admin.ImportAction_INVALID
+			}
+			addResult(first.record, id, action, status.Convert(err).Message())
+			continue
+		}
+		if old != nil && reflect.DeepEqual(old, d) {
+			addResult(first.record, id, admin.ImportAction_UNCHANGED, "")
+			continue
+		}
+
+		if !request.DryRun {
+			if err := s.storeDevice(d, old == nil); err != nil {
+				if device.IsConflict(err) {
+					addResult(first.record, id, admin.ImportAction_CONFLICT,
+						fmt.Sprintf("device '%s' was modified concurrently with the import", id))
+					continue
+				}
+				return nil, err
+			}
+		}
+		if old == nil {
+			count++
+			addResult(first.record, id, admin.ImportAction_CREATE, "")
+		} else {
+			addResult(first.record, id, admin.ImportAction_UPDATE, "")
+		}
+	}
+
+	sort.Slice(response.Results, func(i, j int) bool {
+		return response.Results[i].Record < response.Results[j].Record
+	})
+	log.Infof("Imported %d %s records: %d created, %d updated, %d unchanged, %d conflicts, %d invalid, %d duplicates (dry run: %t)",
+		response.Records, request.Format, response.Created, response.Updated, response.Unchanged, response.Conflicts,
+		response.Invalid, response.Duplicates, request.DryRun)
+	return response, nil
+}
+
+// storeDevice creates the given device if it is new, failing with a conflict if it has been created since
+// it was listed, or else updates it if it has not been modified since it was listed
+func (s *Server) storeDevice(d *deviceapi.Device, create bool) error {
+	if create {
+		return s.deviceStore.Create(d)
+	}
+	return s.deviceStore.Store(d)
+}
+
+// importMapping returns the mapping of device fields to record fields for the request: the default
+// mapping of the format overridden by the mapping of the request. Mapping a field to an empty record
+// field removes its default mapping.
+func importMapping(request *admin.ImportRequest) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for field, source := range defaultMappings[request.Format] {
+		mapping[field] = source
+	}
+	for field, source := range request.Mapping {
+		if err := validateDeviceField(field); err != nil {
+			return nil, err
+		}
+		if source == "" {
+			delete(mapping, field)
+		} else {
+			mapping[field] = source
+		}
+	}
+	for field := range request.Defaults {
+		if err := validateDeviceField(field); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := mapping["id"]; !ok {
+		return nil, fmt.Errorf("the device id field must be mapped")
+	}
+	return mapping, nil
+}
+
+// importDevice maps a record to a device. Existing devices are updated with the mapped fields of the
+// record, and new devices take the defaults of the request for the fields the record does not set.
+// The device is validated against its type if the type is in the catalog. The returned device is
+// nil if the record has no device ID.
+func importDevice(r record, namespace string, mapping map[string]string, request *admin.ImportRequest, existing map[deviceapi.ID]*deviceapi.Device, types map[deviceapi.Type]*deviceapi.DeviceType) (*deviceapi.Device, error) {
+	id := deviceapi.ID(r.fields[mapping["id"]])
+	if id == "" {
+		return nil, fmt.Errorf("record has no value for the device id field '%s'", mapping["id"])
+	}
+
+	var d *deviceapi.Device
+	if old, ok := existing[id]; ok {
+		clone, err := cloneDevice(old)
+		if err != nil {
+			return nil, err
+		}
+		d = clone
+	} else {
+		d = &deviceapi.Device{ID: id, Namespace: namespace}
+		for field, value := range request.Defaults {
+			if _, ok := r.fields[mapping[field]]; !ok {
+				if err := setDeviceField(d, field, value, request.DefaultPort); err != nil {
+					return d, err
+				}
+			}
+		}
+	}
+	for field, source := range mapping {
+		if value, ok := r.fields[source]; ok {
+			if err := setDeviceField(d, field, value, request.DefaultPort); err != nil {
+				return d, err
+			}
+		}
+	}
+
+	if err := device.ValidateDevice(d); err != nil {
+		return d, err
+	}
+	if deviceType, ok := types[d.Type]; ok {
+		device.ApplyTypeDefaults(d, deviceType)
+		if err := device.ValidateDeviceOfType(d, deviceType); err != nil {
+			return d, err
+		}
+	}
+	if d.Timeout == nil {
+		timeout := device.DefaultTimeout
+		d.Timeout = &timeout
+	}
+	return d, nil
+}
+
+// conflictingRecord returns the first record of the group defining the device differently from the
+// first record of the group, if any
+func conflictingRecord(group []importedRecord) *importedRecord {
+	for i := 1; i < len(group); i++ {
+		if !reflect.DeepEqual(group[0].device, group[i].device) {
+			return &group[i]
+		}
+	}
+	return nil
+}
+
+// cloneDevice returns a deep copy of the given device
+func cloneDevice(d *deviceapi.Device) (*deviceapi.Device, error) {
+	bytes, err := proto.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	clone := &deviceapi.Device{}
+	if err := proto.Unmarshal(bytes, clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
@@ -0,0 +1,279 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/admission"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newCSVImportRequest(t *testing.T, dryRun bool) *admin.ImportRequest {
+	data, err := ioutil.ReadFile("testdata/devices.csv")
+	assert.NoError(t, err)
+	return &admin.ImportRequest{
+		Format: admin.ImportFormat_CSV,
+		Data:   data,
+		Mapping: map[string]string{
+			"attributes.serial": "serial",
+			"attributes.rack":   "rack",
+		},
+		DefaultPort: 9339,
+		DryRun:      dryRun,
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	assert.NoError(t, topo.typeStore.Store(&deviceapi.DeviceType{
+		Name: "Stratum",
+		Attributes: []*deviceapi.AttributeSchema{
+			{Name: "serial", Required: true},
+		},
+	}))
+	assert.NoError(t, topo.deviceStore.Store(newTestDevice("leaf-1")))
+	decommissioned := newTestDevice("leaf-4")
+	decommissioned.LifecycleState = deviceapi.LifecycleState_DECOMMISSIONED
+	assert.NoError(t, topo.deviceStore.Store(decommissioned))
+
+	response, err := topo.client.Import(context.Background(), newCSVImportRequest(t, true))
+	assert.NoError(t, err)
+	assert.True(t, response.DryRun)
+	assert.Equal(t, uint64(9), response.Records)
+	assert.Equal(t, uint64(1), response.Created)
+	assert.Equal(t, uint64(1), response.Updated)
+	assert.Equal(t, uint64(0), response.Unchanged)
+	assert.Equal(t, uint64(3), response.Conflicts)
+	assert.Equal(t, uint64(3), response.Invalid)
+	assert.Equal(t, uint64(1), response.Duplicates)
+	d, err := topo.deviceStore.Load(northbound.DefaultNamespace, "leaf-2")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+
+	expected := []struct {
+		record  uint64
+		id      deviceapi.ID
+		action  admin.ImportAction
+		message string
+	}{
+		{2, "leaf-1", admin.ImportAction_UPDATE, ""},
+		{3, "leaf-2", admin.ImportAction_CREATE, ""},
+		{5, "spine-1", admin.ImportAction_CONFLICT, "records 5 and 6 define device 'spine-1' differently"},
+		{6, "spine-1", admin.ImportAction_CONFLICT, "records 5 and 6 define device 'spine-1' differently"},
+		{7, "bad", admin.ImportAction_INVALID, "device ID 'bad' is invalid"},
+		{8, "leaf-3", admin.ImportAction_INVALID, "device version 'latest' is invalid"},
+		{9, "leaf-4", admin.ImportAction_CONFLICT, "device 'leaf-4' cannot transition from DECOMMISSIONED to IN_SERVICE"},
+		{10, "leaf-5", admin.ImportAction_INVALID, "attribute 'serial' is required by type 'Stratum'"},
+	}
+	assert.Len(t, response.Results, len(expected))
+	for i, result := range response.Results {
+		assert.Equal(t, expected[i].record, result.Record)
+		assert.Equal(t, expected[i].id, result.ID)
+		assert.Equal(t, expected[i].action, result.Action)
+		assert.Equal(t, expected[i].message, result.Message)
+	}
+
+	response, err = topo.client.Import(context.Background(), newCSVImportRequest(t, false))
+	assert.NoError(t, err)
+	assert.False(t, response.DryRun)
+	assert.Equal(t, uint64(1), response.Created)
+	assert.Equal(t, uint64(1), response.Updated)
+
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "leaf-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.2:9339", d.Address)
+	assert.Equal(t, deviceapi.Role("leaf"), d.Role)
+	assert.Equal(t, deviceapi.LifecycleState_PLANNED, d.LifecycleState)
+	assert.Equal(t, map[string]string{"serial": "SN002", "rack": "r1"}, d.Attributes)
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "leaf-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:9339", d.Address)
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "spine-1")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+
+	// Importing the same inventory again changes nothing
+	response, err = topo.client.Import(context.Background(), newCSVImportRequest(t, false))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), response.Created)
+	assert.Equal(t, uint64(0), response.Updated)
+	assert.Equal(t, uint64(2), response.Unchanged)
+}
+
+func TestImportAdmission(t *testing.T) {
+	var deviceStore device.Store
+	topo := newTestTopo(t,
+		admission.NewMutator("owner", func(ctx context.Context, request *admission.Request) error {
+			request.Device.Attributes = map[string]string{"owner": "netops"}
+			return nil
+		}),
+		admission.NewValidator("no-spines", func(ctx context.Context, request *admission.Request) error {
+			if request.Device.Role == "spine" {
+				return errors.New("spines are managed by fabric-controller")
+			}
+			return nil
+		}),
+		// Creates leaf-3 concurrently with its import
+		admission.NewValidator("race", func(ctx context.Context, request *admission.Request) error {
+			if request.Device.ID == "leaf-3" {
+				return deviceStore.Store(newTestDevice("leaf-3"))
+			}
+			return nil
+		}))
+	defer topo.close()
+	deviceStore = topo.deviceStore
+
+	request := &admin.ImportRequest{
+		Format: admin.ImportFormat_CSV,
+		Data: []byte("id,address,type,version,role\n" +
+			"leaf-1,10.0.0.1:9339,Stratum,1.0.0,leaf\n" +
+			"spine-1,10.0.1.1:9339,Stratum,1.0.0,spine\n" +
+			"leaf-3,10.0.0.3:9339,Stratum,1.0.0,leaf\n"),
+	}
+	response, err := topo.client.Import(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), response.Created)
+	assert.Equal(t, uint64(1), response.Conflicts)
+	assert.Equal(t, uint64(1), response.Invalid)
+	assert.Equal(t, admin.ImportAction_CREATE, response.Results[0].Action)
+	assert.Equal(t, admin.ImportAction_INVALID, response.Results[1].Action)
+	assert.Equal(t, "denied by no-spines: spines are managed by fabric-controller", response.Results[1].Message)
+	assert.Equal(t, admin.ImportAction_CONFLICT, response.Results[2].Action)
+	assert.Equal(t, "device 'leaf-3' was modified concurrently with the import", response.Results[2].Message)
+
+	d, err := topo.deviceStore.Load(northbound.DefaultNamespace, "leaf-1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"owner": "netops"}, d.Attributes)
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "spine-1")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "leaf-3")
+	assert.NoError(t, err)
+	assert.Equal(t, "leaf-3:9339", d.Address)
+}
+
+func TestImportNetBox(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	data, err := ioutil.ReadFile("testdata/netbox-devices.json")
+	assert.NoError(t, err)
+	response, err := topo.client.Import(context.Background(), &admin.ImportRequest{
+		Format:      admin.ImportFormat_NETBOX_JSON,
+		Data:        data,
+		Mapping:     map[string]string{"attributes.rack": "rack.name"},
+		Defaults:    map[string]string{"version": "1.0.0"},
+		DefaultPort: 9559,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Records)
+	assert.Equal(t, uint64(2), response.Created)
+
+	d, err := topo.deviceStore.Load(northbound.DefaultNamespace, "nb-leaf-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1.0.1:9559", d.Address)
+	assert.Equal(t, deviceapi.Type("Stratum"), d.Type)
+	assert.Equal(t, deviceapi.Role("leaf"), d.Role)
+	assert.Equal(t, "1.0.0", d.Version)
+	assert.Equal(t, deviceapi.LifecycleState_IN_SERVICE, d.LifecycleState)
+	assert.Equal(t, map[string]string{"serial": "EC1901000001", "site": "lab-1", "rack": "R1"}, d.Attributes)
+
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "nb-spine-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "[2001:db8::1]:9559", d.Address)
+	assert.Equal(t, "1.0.0", d.Version)
+	assert.Equal(t, deviceapi.LifecycleState_PROVISIONING, d.LifecycleState)
+}
+
+func TestImportErrors(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	request := newCSVImportRequest(t, false)
+	request.Mapping = map[string]string{"serial": "serial"}
+	_, err := topo.client.Import(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	request = newCSVImportRequest(t, false)
+	request.Mapping = map[string]string{"id": ""}
+	_, err = topo.client.Import(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = topo.client.Import(context.Background(), &admin.ImportRequest{
+		Format: admin.ImportFormat_CSV,
+		Data:   []byte("id,address\nleaf-1,10.0.0.1:9339,extra\n"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = topo.client.Import(context.Background(), &admin.ImportRequest{
+		Format: admin.ImportFormat_NETBOX_JSON,
+		Data:   []byte(`{"count": 0}`),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.NoError(t, topo.modeStore.Store(&admin.Mode{ReadOnly: true, Reason: "Atomix migration"}))
+	_, err = topo.client.Import(context.Background(), newCSVImportRequest(t, false))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = topo.client.Import(context.Background(), newCSVImportRequest(t, true))
+	assert.NoError(t, err)
+}
+
+func TestImportNamespace(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+	server := NewServer(topo.deviceStore, topo.typeStore, topo.linkStore, topo.modeStore, topo.admitter, device.Quotas{
+		Namespaces: map[string]int{"lab-a": 2},
+	})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(northbound.NamespaceHeader, "lab-a"))
+
+	// Devices of other namespaces neither conflict with the import nor count against its quota
+	assert.NoError(t, topo.deviceStore.Store(newTestDevice("leaf-1")))
+	assert.NoError(t, topo.deviceStore.Store(newTestDevice("spine-2")))
+	response, err := server.Import(ctx, newCSVImportRequest(t, false))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Created)
+	assert.Equal(t, uint64(0), response.Updated)
+	assert.Equal(t, uint64(4), response.Conflicts)
+	for _, result := range response.Results[len(response.Results)-2:] {
+		assert.Equal(t, admin.ImportAction_CONFLICT, result.Action)
+		assert.Equal(t, "namespace 'lab-a' has reached its quota of 2 devices", result.Message)
+	}
+
+	d, err := topo.deviceStore.Load("lab-a", "leaf-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:9339", d.Address)
+	d, err = topo.deviceStore.Load(northbound.DefaultNamespace, "leaf-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "leaf-1:9339", d.Address)
+	d, err = topo.deviceStore.Load("lab-a", "leaf-4")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+
+	_, err = server.Import(metadata.NewIncomingContext(context.Background(), metadata.Pairs(northbound.NamespaceHeader, northbound.AllNamespaces)), newCSVImportRequest(t, false))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
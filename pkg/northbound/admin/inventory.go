@@ -0,0 +1,252 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+)
+
+// attributePrefix is the prefix of the device fields naming device attributes
+const attributePrefix = "attributes."
+
+// deviceFields are the device fields that may be imported besides attributes
+var deviceFields = map[string]bool{
+	"id":             true,
+	"address":        true,
+	"target":         true,
+	"version":        true,
+	"type":           true,
+	"role":           true,
+	"timeout":        true,
+	"lifecycleState": true,
+}
+
+// defaultMappings are the default mappings of device fields to record fields for each import format
+var defaultMappings = map[admin.ImportFormat]map[string]string{
+	admin.ImportFormat_CSV: {
+		"id":             "id",
+		"address":        "address",
+		"target":         "target",
+		"version":        "version",
+		"type":           "type",
+		"role":           "role",
+		"timeout":        "timeout",
+		"lifecycleState": "lifecycleState",
+	},
+	admin.ImportFormat_NETBOX_JSON: {
+		"id":                "name",
+		"address":           "primary_ip.address",
+		"version":           "custom_fields.version",
+		"type":              "device_type.model",
+		"role":              "device_role.slug",
+		"lifecycleState":    "status.value",
+		"attributes.serial": "serial",
+		"attributes.site":   "site.slug",
+	},
+}
+
+// netBoxStatuses are the lifecycle states of the NetBox device statuses
+var netBoxStatuses = map[string]deviceapi.LifecycleState{
+	"planned":         deviceapi.LifecycleState_PLANNED,
+	"inventory":       deviceapi.LifecycleState_PLANNED,
+	"staged":          deviceapi.LifecycleState_PROVISIONING,
+	"active":          deviceapi.LifecycleState_IN_SERVICE,
+	"offline":         deviceapi.LifecycleState_MAINTENANCE,
+	"failed":          deviceapi.LifecycleState_MAINTENANCE,
+	"decommissioning": deviceapi.LifecycleState_DECOMMISSIONED,
+}
+
+// record is an inventory record with nested fields flattened to their path
+type record struct {
+	number uint64
+	fields map[string]string
+}
+
+// parseInventory parses the records of an inventory in the given format
+func parseInventory(format admin.ImportFormat, data []byte) ([]record, error) {
+	switch format {
+	case admin.ImportFormat_CSV:
+		return parseCSV(data)
+	case admin.ImportFormat_NETBOX_JSON:
+		return parseNetBoxJSON(data)
+	default:
+		return nil, fmt.Errorf("import format %d is not supported", format)
+	}
+}
+
+// parseCSV parses CSV records, naming fields after the columns of the header row. Records are
+// numbered by row, the header being row 1, and empty values are omitted.
+func parseCSV(data []byte) ([]record, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("the header row is missing")
+	}
+	header := rows[0]
+	records := make([]record, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		fields := make(map[string]string)
+		for j, value := range row {
+			if value = strings.TrimSpace(value); value != "" {
+				fields[strings.TrimSpace(header[j])] = value
+			}
+		}
+		records = append(records, record{number: uint64(i + 2), fields: fields})
+	}
+	return records, nil
+}
+
+// parseNetBoxJSON parses the devices of a NetBox devices API response. Nested fields are named by
+// their path, e.g. 'device_type.model', and null values are omitted.
+func parseNetBoxJSON(data []byte) ([]record, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if response, ok := doc.(map[string]interface{}); ok {
+		doc = response["results"]
+	}
+	devices, ok := doc.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an array of devices or a response with results")
+	}
+	records := make([]record, 0, len(devices))
+	for i, d := range devices {
+		if _, ok := d.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("device %d is not an object", i+1)
+		}
+		fields := make(map[string]string)
+		flatten("", d, fields)
+		records = append(records, record{number: uint64(i + 1), fields: fields})
+	}
+	return records, nil
+}
+
+// flatten adds the scalar values nested in the given JSON value to fields, named by their path
+func flatten(path string, value interface{}, fields map[string]string) {
+	prefix := path
+	if prefix != "" {
+		prefix += "."
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			flatten(prefix+key, nested, fields)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			flatten(prefix+strconv.Itoa(i), nested, fields)
+		}
+	case string:
+		if v != "" {
+			fields[path] = v
+		}
+	case json.Number:
+		fields[path] = v.String()
+	case bool:
+		fields[path] = strconv.FormatBool(v)
+	}
+}
+
+// validateDeviceField returns an error if the given name is not an importable device field
+func validateDeviceField(field string) error {
+	if deviceFields[field] || (strings.HasPrefix(field, attributePrefix) && len(field) > len(attributePrefix)) {
+		return nil
+	}
+	return fmt.Errorf("unknown device field '%s'", field)
+}
+
+// setDeviceField sets a field of the device to an imported value
+func setDeviceField(device *deviceapi.Device, field string, value string, defaultPort uint32) error {
+	switch field {
+	case "id":
+		device.ID = deviceapi.ID(value)
+	case "address":
+		device.Address = importAddress(value, defaultPort)
+	case "target":
+		device.Target = value
+	case "version":
+		device.Version = value
+	case "type":
+		device.Type = deviceapi.Type(value)
+	case "role":
+		device.Role = deviceapi.Role(value)
+	case "timeout":
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("timeout '%s' is invalid", value)
+		}
+		device.Timeout = &timeout
+	case "lifecycleState":
+		state, err := importLifecycleState(value)
+		if err != nil {
+			return err
+		}
+		device.LifecycleState = state
+	default:
+		if device.Attributes == nil {
+			device.Attributes = make(map[string]string)
+		}
+		device.Attributes[strings.TrimPrefix(field, attributePrefix)] = value
+	}
+	return nil
+}
+
+// importAddress strips the prefix length of an address in CIDR notation, as NetBox formats IP
+// addresses, and appends the default port to addresses that do not include a port
+func importAddress(address string, defaultPort uint32) string {
+	if ip, _, err := net.ParseCIDR(address); err == nil {
+		address = ip.String()
+	}
+	if defaultPort == 0 {
+		return address
+	}
+	scheme, host := "", address
+	if i := strings.Index(address, "://"); i >= 0 {
+		scheme, host = address[:i+3], address[i+3:]
+	}
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return address
+	}
+	return scheme + net.JoinHostPort(host, strconv.Itoa(int(defaultPort)))
+}
+
+// importLifecycleState parses a lifecycle state name, e.g. in-service, or a NetBox device status
+func importLifecycleState(value string) (deviceapi.LifecycleState, error) {
+	if state, ok := netBoxStatuses[strings.ToLower(value)]; ok {
+		return state, nil
+	}
+	state, ok := deviceapi.LifecycleState_value[strings.ToUpper(strings.Replace(value, "-", "_", -1))]
+	if !ok {
+		return 0, fmt.Errorf("lifecycle state '%s' is unknown", value)
+	}
+	return deviceapi.LifecycleState(state), nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/onosproject/onos-topo/pkg/logging"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLogLevels returns the global log verbosity and the verbosity of the packages that override it
+func (s *Server) GetLogLevels(ctx context.Context, request *admin.GetLogLevelsRequest) (*admin.GetLogLevelsResponse, error) {
+	verbosity, packages := logging.Verbosity()
+	return &admin.GetLogLevelsResponse{
+		Verbosity: verbosity,
+		Packages:  packages,
+	}, nil
+}
+
+// SetLogLevel sets the global log verbosity or the verbosity of a package; it is restricted to administrators
+func (s *Server) SetLogLevel(ctx context.Context, request *admin.SetLogLevelRequest) (*admin.SetLogLevelResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var err error
+	if request.Package == "" {
+		err = logging.SetVerbosity(request.Verbosity)
+	} else {
+		err = logging.SetPackageVerbosity(request.Package, request.Verbosity)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	verbosity, packages := logging.Verbosity()
+	return &admin.SetLogLevelResponse{
+		Verbosity: verbosity,
+		Packages:  packages,
+	}, nil
+}
+
+// StartTrace starts logging the requests and responses concerning a device or made by a client; it is
+// restricted to administrators
+func (s *Server) StartTrace(ctx context.Context, request *admin.StartTraceRequest) (*admin.StartTraceResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	trace, err := logging.StartTrace(request.DeviceID, request.Client, request.Duration)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &admin.StartTraceResponse{
+		Trace: newTrace(trace),
+	}, nil
+}
+
+// StopTrace stops a trace before it expires; it is restricted to administrators
+func (s *Server) StopTrace(ctx context.Context, request *admin.StopTraceRequest) (*admin.StopTraceResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if !logging.StopTrace(request.ID) {
+		return nil, status.Errorf(codes.NotFound, "trace %d not found", request.ID)
+	}
+	return &admin.StopTraceResponse{}, nil
+}
+
+// ListTraces returns the active traces
+func (s *Server) ListTraces(ctx context.Context, request *admin.ListTracesRequest) (*admin.ListTracesResponse, error) {
+	traces := logging.Traces()
+	response := &admin.ListTracesResponse{
+		Traces: make([]*admin.Trace, 0, len(traces)),
+	}
+	for _, trace := range traces {
+		response.Traces = append(response.Traces, newTrace(trace))
+	}
+	return response, nil
+}
+
+func newTrace(trace logging.Trace) *admin.Trace {
+	return &admin.Trace{
+		ID:       trace.ID,
+		DeviceID: trace.DeviceID,
+		Client:   trace.Client,
+		Expires:  trace.Expires,
+	}
+}
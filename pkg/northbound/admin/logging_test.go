@@ -0,0 +1,70 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogLevels(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	response, err := topo.client.SetLogLevel(context.Background(), &admin.SetLogLevelRequest{Package: "pkg/manager", Verbosity: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), response.Packages["pkg/manager"])
+
+	levels, err := topo.client.GetLogLevels(context.Background(), &admin.GetLogLevelsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), levels.Packages["pkg/manager"])
+
+	response, err = topo.client.SetLogLevel(context.Background(), &admin.SetLogLevelRequest{Package: "pkg/manager", Verbosity: -1})
+	assert.NoError(t, err)
+	assert.Empty(t, response.Packages)
+
+	_, err = topo.client.SetLogLevel(context.Background(), &admin.SetLogLevelRequest{Verbosity: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTraces(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	_, err := topo.client.StartTrace(context.Background(), &admin.StartTraceRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	started, err := topo.client.StartTrace(context.Background(), &admin.StartTraceRequest{
+		DeviceID: "device-1",
+		Duration: time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "device-1", string(started.Trace.DeviceID))
+
+	traces, err := topo.client.ListTraces(context.Background(), &admin.ListTracesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, traces.Traces, 1)
+
+	_, err = topo.client.StopTrace(context.Background(), &admin.StopTraceRequest{ID: started.Trace.ID})
+	assert.NoError(t, err)
+	_, err = topo.client.StopTrace(context.Background(), &admin.StopTraceRequest{ID: started.Trace.ID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,59 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+// GetMode returns the operating mode of the topology service
+func (s *Server) GetMode(ctx context.Context, request *admin.GetModeRequest) (*admin.GetModeResponse, error) {
+	mode, err := s.modeStore.Load()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to load the topology mode: %v", err)
+	}
+	return &admin.GetModeResponse{
+		Mode: mode,
+	}, nil
+}
+
+// SetMode sets the operating mode of the topology service, recording the identity of the calling client.
+// Setting the mode is restricted to administrators.
+func (s *Server) SetMode(ctx context.Context, request *admin.SetModeRequest) (*admin.SetModeResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	updated := time.Now()
+	mode := &admin.Mode{
+		ReadOnly: request.ReadOnly,
+		Reason:   request.Reason,
+		SetBy:    northbound.ClientIdentity(ctx),
+		Updated:  &updated,
+	}
+	if err := s.modeStore.Store(mode); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to store the topology mode: %v", err)
+	}
+	log.Infof("Set topology mode to read-only=%t by %s: %s", mode.ReadOnly, mode.SetBy, mode.Reason)
+	return &admin.SetModeResponse{
+		Mode: mode,
+	}, nil
+}
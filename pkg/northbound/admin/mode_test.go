@@ -0,0 +1,68 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMode(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	getResponse, err := topo.client.GetMode(context.Background(), &admin.GetModeRequest{})
+	assert.NoError(t, err)
+	assert.False(t, getResponse.Mode.ReadOnly)
+	assert.Nil(t, getResponse.Mode.Updated)
+
+	setResponse, err := topo.client.SetMode(context.Background(), &admin.SetModeRequest{
+		ReadOnly: true,
+		Reason:   "Atomix migration",
+	})
+	assert.NoError(t, err)
+	assert.True(t, setResponse.Mode.ReadOnly)
+	assert.Contains(t, setResponse.Mode.SetBy, "bufconn")
+	assert.NotNil(t, setResponse.Mode.Updated)
+
+	getResponse, err = topo.client.GetMode(context.Background(), &admin.GetModeRequest{})
+	assert.NoError(t, err)
+	assert.True(t, getResponse.Mode.ReadOnly)
+	assert.Equal(t, "Atomix migration", getResponse.Mode.Reason)
+	assert.Equal(t, setResponse.Mode.SetBy, getResponse.Mode.SetBy)
+
+	// Snapshots can be taken and validated but not applied while the topology is read-only
+	assert.NoError(t, topo.deviceStore.Store(newTestDevice("device-1")))
+	entries := backup(t, topo.client)
+	_, err = restore(topo.client, admin.RestoreMode_REPLACE, true, entries)
+	assert.NoError(t, err)
+	_, err = restore(topo.client, admin.RestoreMode_REPLACE, false, entries)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = topo.client.SetMode(context.Background(), &admin.SetModeRequest{})
+	assert.NoError(t, err)
+	_, err = restore(topo.client, admin.RestoreMode_REPLACE, false, entries)
+	assert.NoError(t, err)
+	d, err := topo.deviceStore.Load(northbound.DefaultNamespace, deviceapi.ID("device-1"))
+	assert.NoError(t, err)
+	assert.NotNil(t, d)
+}
@@ -0,0 +1,365 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"io"
+	"sort"
+	"time"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/onosproject/onos-topo/pkg/northbound/graph"
+	"github.com/onosproject/onos-topo/pkg/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+// SnapshotVersion is the version of the snapshot format produced by Backup and accepted by Restore
+const SnapshotVersion = 1
+
+// Backup streams a snapshot of all device types, devices and links of all namespaces, sorted by name
+// and ID. Backups are restricted to administrators.
+func (s *Server) Backup(request *admin.BackupRequest, stream admin.TopoAdminService_BackupServer) error {
+	if err := northbound.CheckAdmin(stream.Context()); err != nil {
+		return err
+	}
+	deviceTypes, err := s.listDeviceTypes()
+	if err != nil {
+		return err
+	}
+	devices, err := s.listDevices()
+	if err != nil {
+		return err
+	}
+	links, err := s.listLinks()
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*admin.SnapshotEntry, 0, len(deviceTypes)+len(devices)+len(links)+1)
+	entries = append(entries, &admin.SnapshotEntry{
+		Entry: &admin.SnapshotEntry_Header{
+			Header: &admin.SnapshotHeader{
+				Version:       SnapshotVersion,
+				Timestamp:     time.Now(),
+				ServerVersion: version.Version,
+				DeviceTypes:   uint64(len(deviceTypes)),
+				Devices:       uint64(len(devices)),
+				Links:         uint64(len(links)),
+			},
+		},
+	})
+	for _, deviceType := range deviceTypes {
+		entries = append(entries, &admin.SnapshotEntry{
+			Entry: &admin.SnapshotEntry_DeviceType{
+				DeviceType: deviceType,
+			},
+		})
+	}
+	for _, d := range devices {
+		entries = append(entries, &admin.SnapshotEntry{
+			Entry: &admin.SnapshotEntry_Device{
+				Device: d,
+			},
+		})
+	}
+	for _, link := range links {
+		entries = append(entries, &admin.SnapshotEntry{
+			Entry: &admin.SnapshotEntry_Link{
+				Link: link,
+			},
+		})
+	}
+
+	for _, entry := range entries {
+		if err := stream.Send(&admin.BackupResponse{Entry: entry}); err != nil {
+			return err
+		}
+	}
+	log.Infof("Backed up %d device types, %d devices and %d links", len(deviceTypes), len(devices), len(links))
+	return nil
+}
+
+// listDeviceTypes returns all device types sorted by name
+func (s *Server) listDeviceTypes() ([]*deviceapi.DeviceType, error) {
+	ch := make(chan *deviceapi.DeviceType)
+	if err := s.typeStore.List(ch); err != nil {
+		return nil, err
+	}
+	var deviceTypes []*deviceapi.DeviceType
+	for deviceType := range ch {
+		deviceTypes = append(deviceTypes, deviceType)
+	}
+	sort.Slice(deviceTypes, func(i, j int) bool {
+		return deviceTypes[i].Name < deviceTypes[j].Name
+	})
+	return deviceTypes, nil
+}
+
+// listDevices returns the devices of all namespaces sorted by namespace and ID
+func (s *Server) listDevices() ([]*deviceapi.Device, error) {
+	ch := make(chan *deviceapi.Device)
+	if err := s.deviceStore.List(ch); err != nil {
+		return nil, err
+	}
+	var devices []*deviceapi.Device
+	for d := range ch {
+		devices = append(devices, d)
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		if devices[i].Namespace != devices[j].Namespace {
+			return devices[i].Namespace < devices[j].Namespace
+		}
+		return devices[i].ID < devices[j].ID
+	})
+	return devices, nil
+}
+
+// listLinks returns the links of all namespaces sorted by namespace and ID
+func (s *Server) listLinks() ([]*graphapi.Link, error) {
+	ch := make(chan *graphapi.Link)
+	if err := s.linkStore.List(ch); err != nil {
+		return nil, err
+	}
+	var links []*graphapi.Link
+	for link := range ch {
+		links = append(links, link)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].Namespace != links[j].Namespace {
+			return links[i].Namespace < links[j].Namespace
+		}
+		return links[i].ID < links[j].ID
+	})
+	return links, nil
+}
+
+// Restore reads a snapshot from the stream and applies it once the stream is closed. The snapshot
+// is validated in full before any change is made, but the changes are not applied atomically.
+// Restored resources are assigned new revisions, and resources without a namespace are restored in the
+// default namespace. Restores are restricted to administrators.
+func (s *Server) Restore(stream admin.TopoAdminService_RestoreServer) error {
+	if err := northbound.CheckAdmin(stream.Context()); err != nil {
+		return err
+	}
+	var mode admin.RestoreMode
+	var dryRun bool
+	var header *admin.SnapshotHeader
+	var deviceTypes []*deviceapi.DeviceType
+	var devices []*deviceapi.Device
+	var links []*graphapi.Link
+	for i := 0; ; i++ {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if i == 0 {
+			mode = request.Mode
+			dryRun = request.DryRun
+		}
+
+		entry := request.Entry
+		switch {
+		case entry == nil:
+			return status.Errorf(codes.InvalidArgument, "snapshot entry %d is empty", i)
+		case entry.GetHeader() != nil:
+			if header != nil {
+				return status.Error(codes.InvalidArgument, "snapshot has multiple headers")
+			}
+			header = entry.GetHeader()
+			if header.Version != SnapshotVersion {
+				return status.Errorf(codes.FailedPrecondition, "snapshot version %d is not supported", header.Version)
+			}
+		case header == nil:
+			return status.Error(codes.InvalidArgument, "snapshot does not begin with a header")
+		case entry.GetDeviceType() != nil:
+			deviceType := entry.GetDeviceType()
+			if err := device.ValidateDeviceType(deviceType); err != nil {
+				return err
+			}
+			deviceTypes = append(deviceTypes, deviceType)
+		case entry.GetDevice() != nil:
+			d := entry.GetDevice()
+			if err := device.ValidateDevice(d); err != nil {
+				return err
+			}
+			devices = append(devices, d)
+		case entry.GetLink() != nil:
+			link := entry.GetLink()
+			if err := graph.ValidateLink(link); err != nil {
+				return err
+			}
+			links = append(links, link)
+		default:
+			return status.Errorf(codes.InvalidArgument, "snapshot entry %d is of an unknown type", i)
+		}
+	}
+
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "snapshot is empty")
+	} else if uint64(len(deviceTypes)) != header.DeviceTypes || uint64(len(devices)) != header.Devices || uint64(len(links)) != header.Links {
+		return status.Errorf(codes.InvalidArgument, "snapshot is incomplete: expected %d device types, %d devices and %d links, received %d, %d and %d",
+			header.DeviceTypes, header.Devices, header.Links, len(deviceTypes), len(devices), len(links))
+	}
+
+	if !dryRun {
+		if err := device.CheckWritable(s.modeStore); err != nil {
+			return err
+		}
+	}
+
+	response, err := s.restore(mode, dryRun, deviceTypes, devices, links)
+	if err != nil {
+		if device.IsConflict(err) {
+			return status.Error(codes.Aborted, "the topology was modified concurrently with the restore")
+		}
+		return err
+	}
+	log.Infof("Restored snapshot taken at %s: %+v", header.Timestamp, response)
+	return stream.SendAndClose(response)
+}
+
+// restore applies the given device types, devices and links to the stores. Device types are restored
+// before devices and devices before links; resources are removed in the reverse order.
+func (s *Server) restore(mode admin.RestoreMode, dryRun bool, deviceTypes []*deviceapi.DeviceType, devices []*deviceapi.Device, links []*graphapi.Link) (*admin.RestoreResponse, error) {
+	response := &admin.RestoreResponse{DryRun: dryRun}
+
+	existingTypes, err := s.listDeviceTypes()
+	if err != nil {
+		return nil, err
+	}
+	typeRevisions := make(map[deviceapi.Type]deviceapi.Revision)
+	for _, deviceType := range existingTypes {
+		typeRevisions[deviceType.Name] = deviceType.Revision
+	}
+
+	existingDevices, err := s.listDevices()
+	if err != nil {
+		return nil, err
+	}
+	deviceRevisions := make(map[string]deviceapi.Revision)
+	for _, d := range existingDevices {
+		deviceRevisions[device.NamespacedKey(d.Namespace, string(d.ID))] = d.Revision
+	}
+
+	existingLinks, err := s.listLinks()
+	if err != nil {
+		return nil, err
+	}
+	linkRevisions := make(map[string]deviceapi.Revision)
+	for _, link := range existingLinks {
+		linkRevisions[device.NamespacedKey(link.Namespace, string(link.ID))] = link.Revision
+	}
+
+	restoredTypes := make(map[deviceapi.Type]bool)
+	for _, deviceType := range deviceTypes {
+		revision, ok := typeRevisions[deviceType.Name]
+		if ok {
+			response.DeviceTypesUpdated++
+		} else {
+			response.DeviceTypesAdded++
+		}
+		restoredTypes[deviceType.Name] = true
+		if !dryRun {
+			deviceType.Revision = revision
+			if err := s.typeStore.Store(deviceType); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	restoredDevices := make(map[string]bool)
+	for _, d := range devices {
+		key := device.NamespacedKey(d.Namespace, string(d.ID))
+		revision, ok := deviceRevisions[key]
+		if ok {
+			response.DevicesUpdated++
+		} else {
+			response.DevicesAdded++
+		}
+		restoredDevices[key] = true
+		if !dryRun {
+			d.Revision = revision
+			if err := s.deviceStore.Store(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	restoredLinks := make(map[string]bool)
+	for _, link := range links {
+		key := device.NamespacedKey(link.Namespace, string(link.ID))
+		revision, ok := linkRevisions[key]
+		if ok {
+			response.LinksUpdated++
+		} else {
+			response.LinksAdded++
+		}
+		restoredLinks[key] = true
+		if !dryRun {
+			link.Revision = revision
+			if err := s.linkStore.Store(link); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if mode != admin.RestoreMode_REPLACE {
+		return response, nil
+	}
+
+	for _, link := range existingLinks {
+		if restoredLinks[device.NamespacedKey(link.Namespace, string(link.ID))] {
+			continue
+		}
+		response.LinksRemoved++
+		if !dryRun {
+			if err := s.linkStore.Delete(link); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, d := range existingDevices {
+		if restoredDevices[device.NamespacedKey(d.Namespace, string(d.ID))] {
+			continue
+		}
+		response.DevicesRemoved++
+		if !dryRun {
+			if err := s.deviceStore.Delete(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, deviceType := range existingTypes {
+		if restoredTypes[deviceType.Name] {
+			continue
+		}
+		response.DeviceTypesRemoved++
+		if !dryRun {
+			if err := s.typeStore.Delete(deviceType); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/admission"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/onosproject/onos-topo/pkg/northbound/graph"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testTopo struct {
+	client      admin.TopoAdminServiceClient
+	deviceStore device.Store
+	typeStore   device.TypeStore
+	linkStore   graph.LinkStore
+	modeStore   device.ModeStore
+	admitter    Admitter
+	close       func()
+}
+
+func newTestTopo(t *testing.T, plugins ...admission.Plugin) *testTopo {
+	deviceStore, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	typeStore, err := device.NewLocalTypeStore()
+	assert.NoError(t, err)
+	linkStore, err := graph.NewLocalLinkStore()
+	assert.NoError(t, err)
+	modeStore, err := device.NewLocalModeStore()
+	assert.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	admitter := device.NewServer(deviceStore, plugins...)
+	NewService(deviceStore, typeStore, linkStore, modeStore, admitter, device.Quotas{}).Register(s)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+	return &testTopo{
+		client:      admin.CreateTopoAdminServiceClient(conn),
+		deviceStore: deviceStore,
+		typeStore:   typeStore,
+		linkStore:   linkStore,
+		modeStore:   modeStore,
+		admitter:    admitter,
+		close: func() {
+			conn.Close()
+			s.Stop()
+			deviceStore.Close()
+			typeStore.Close()
+			linkStore.Close()
+			modeStore.Close()
+		},
+	}
+}
+
+func newTestDevice(id deviceapi.ID) *deviceapi.Device {
+	return &deviceapi.Device{
+		ID:      id,
+		Type:    "Stratum",
+		Address: string(id) + ":9339",
+		Version: "1.0.0",
+	}
+}
+
+func backup(t *testing.T, client admin.TopoAdminServiceClient) []*admin.SnapshotEntry {
+	stream, err := client.Backup(context.Background(), &admin.BackupRequest{})
+	assert.NoError(t, err)
+	var entries []*admin.SnapshotEntry
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return entries
+		}
+		assert.NoError(t, err)
+		entries = append(entries, response.Entry)
+	}
+}
+
+func restore(client admin.TopoAdminServiceClient, mode admin.RestoreMode, dryRun bool, entries []*admin.SnapshotEntry) (*admin.RestoreResponse, error) {
+	stream, err := client.Restore(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if err := stream.Send(&admin.RestoreRequest{Mode: mode, DryRun: dryRun, Entry: entry}); err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func TestBackupRestore(t *testing.T) {
+	source := newTestTopo(t)
+	defer source.close()
+
+	assert.NoError(t, source.typeStore.Store(&deviceapi.DeviceType{Name: "Stratum", Versions: "1.x"}))
+	assert.NoError(t, source.deviceStore.Store(newTestDevice("device-2")))
+	assert.NoError(t, source.deviceStore.Store(newTestDevice("device-1")))
+
+	entries := backup(t, source.client)
+	assert.Len(t, entries, 4)
+	header := entries[0].GetHeader()
+	assert.NotNil(t, header)
+	assert.Equal(t, uint32(SnapshotVersion), header.Version)
+	assert.Equal(t, uint64(1), header.DeviceTypes)
+	assert.Equal(t, uint64(2), header.Devices)
+	assert.Equal(t, deviceapi.Type("Stratum"), entries[1].GetDeviceType().Name)
+	assert.Equal(t, deviceapi.ID("device-1"), entries[2].GetDevice().ID)
+	assert.Equal(t, deviceapi.ID("device-2"), entries[3].GetDevice().ID)
+
+	target := newTestTopo(t)
+	defer target.close()
+
+	assert.NoError(t, target.deviceStore.Store(newTestDevice("device-1")))
+	assert.NoError(t, target.deviceStore.Store(newTestDevice("device-3")))
+
+	response, err := restore(target.client, admin.RestoreMode_MERGE, false, entries)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), response.DeviceTypesAdded)
+	assert.Equal(t, uint64(1), response.DevicesAdded)
+	assert.Equal(t, uint64(1), response.DevicesUpdated)
+	assert.Equal(t, uint64(0), response.DevicesRemoved)
+
+	d, err := target.deviceStore.Load(northbound.DefaultNamespace, "device-2")
+	assert.NoError(t, err)
+	assert.NotNil(t, d)
+	d, err = target.deviceStore.Load(northbound.DefaultNamespace, "device-3")
+	assert.NoError(t, err)
+	assert.NotNil(t, d)
+
+	response, err = restore(target.client, admin.RestoreMode_REPLACE, true, entries)
+	assert.NoError(t, err)
+	assert.True(t, response.DryRun)
+	assert.Equal(t, uint64(2), response.DevicesUpdated)
+	assert.Equal(t, uint64(1), response.DevicesRemoved)
+	d, err = target.deviceStore.Load(northbound.DefaultNamespace, "device-3")
+	assert.NoError(t, err)
+	assert.NotNil(t, d)
+
+	response, err = restore(target.client, admin.RestoreMode_REPLACE, false, entries)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), response.DevicesRemoved)
+	d, err = target.deviceStore.Load(northbound.DefaultNamespace, "device-3")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+	assert.Len(t, backup(t, target.client), 4)
+}
+
+func TestRestoreInvalidSnapshot(t *testing.T) {
+	topo := newTestTopo(t)
+	defer topo.close()
+
+	header := &admin.SnapshotEntry{
+		Entry: &admin.SnapshotEntry_Header{
+			Header: &admin.SnapshotHeader{Version: SnapshotVersion, Devices: 1},
+		},
+	}
+	entry := &admin.SnapshotEntry{
+		Entry: &admin.SnapshotEntry_Device{
+			Device: newTestDevice("device-1"),
+		},
+	}
+
+	_, err := restore(topo.client, admin.RestoreMode_MERGE, false, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = restore(topo.client, admin.RestoreMode_MERGE, false, []*admin.SnapshotEntry{entry, header})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = restore(topo.client, admin.RestoreMode_MERGE, false, []*admin.SnapshotEntry{header})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = restore(topo.client, admin.RestoreMode_MERGE, false, []*admin.SnapshotEntry{header, {
+		Entry: &admin.SnapshotEntry_Device{
+			Device: &deviceapi.Device{ID: "device-1"},
+		},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = restore(topo.client, admin.RestoreMode_MERGE, false, []*admin.SnapshotEntry{{
+		Entry: &admin.SnapshotEntry_Header{
+			Header: &admin.SnapshotHeader{Version: SnapshotVersion + 1},
+		},
+	}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	d, err := topo.deviceStore.Load(northbound.DefaultNamespace, "device-1")
+	assert.NoError(t, err)
+	assert.Nil(t, d)
+
+	_, err = restore(topo.client, admin.RestoreMode_MERGE, false, []*admin.SnapshotEntry{header, entry})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,171 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package northbound
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultPort is the default port on which the server listens
+	DefaultPort = 5150
+)
+
+// TLSMode is the mode in which the server secures client connections
+type TLSMode string
+
+const (
+	// TLSModePlaintext disables TLS. It is intended for local development only.
+	TLSModePlaintext TLSMode = "plaintext"
+
+	// TLSModeTLS secures connections with TLS, requesting but neither requiring nor verifying client
+	// certificates
+	TLSModeTLS TLSMode = "tls"
+
+	// TLSModeMutual secures connections with mutual TLS, requiring and verifying client certificates
+	TLSModeMutual TLSMode = "mtls"
+)
+
+// ServerConfig comprises a set of server configuration options.
+type ServerConfig struct {
+	CaPath   *string
+	KeyPath  *string
+	CertPath *string
+
+	// Address is the address on which to listen; empty listens on all interfaces
+	Address string
+
+	// Port is the TCP port on which to listen
+	Port int
+
+	// SocketPath is the path of a unix domain socket on which to listen instead of TCP
+	SocketPath string
+
+	// Insecure indicates whether client certificates are requested rather than required
+	Insecure bool
+
+	// Plaintext disables TLS
+	Plaintext bool
+
+	// KeepaliveTime is the idle time after which the server pings a client; zero uses the gRPC default
+	KeepaliveTime time.Duration
+
+	// KeepaliveTimeout is the time to wait for a keepalive ping ack before closing a connection;
+	// zero uses the gRPC default
+	KeepaliveTimeout time.Duration
+
+	// KeepaliveMinTime is the minimum interval at which clients may send keepalive pings;
+	// zero uses the gRPC default
+	KeepaliveMinTime time.Duration
+
+	// MaxRecvMsgSize is the maximum message size in bytes the server can receive; zero uses the gRPC default
+	MaxRecvMsgSize int
+
+	// MaxSendMsgSize is the maximum message size in bytes the server can send; zero uses the gRPC default
+	MaxSendMsgSize int
+
+	// Reflection enables the gRPC server reflection service
+	Reflection bool
+}
+
+// NewServerConfig creates a server config created with the specified end-point security details.
+func NewServerConfig(caPath string, keyPath string, certPath string) *ServerConfig {
+	return &ServerConfig{
+		Port:     DefaultPort,
+		Insecure: true,
+		CaPath:   &caPath,
+		KeyPath:  &keyPath,
+		CertPath: &certPath,
+	}
+}
+
+// SetTLSMode configures the server security according to the given mode
+func (c *ServerConfig) SetTLSMode(mode TLSMode) error {
+	switch mode {
+	case TLSModePlaintext:
+		c.Plaintext = true
+		c.Insecure = true
+	case TLSModeTLS:
+		c.Plaintext = false
+		c.Insecure = true
+	case TLSModeMutual:
+		c.Plaintext = false
+		c.Insecure = false
+	default:
+		return fmt.Errorf("invalid TLS mode '%s'", mode)
+	}
+	return nil
+}
+
+// TLSMode returns the mode in which the server secures client connections
+func (c *ServerConfig) TLSMode() TLSMode {
+	if c.Plaintext {
+		return TLSModePlaintext
+	} else if c.Insecure {
+		return TLSModeTLS
+	}
+	return TLSModeMutual
+}
+
+// Validate checks that the configuration is complete and consistent
+func (c *ServerConfig) Validate() error {
+	if c.SocketPath == "" {
+		if c.Port <= 0 || c.Port > 65535 {
+			return fmt.Errorf("invalid port %d", c.Port)
+		}
+		if c.Address != "" {
+			if _, _, err := net.SplitHostPort(net.JoinHostPort(c.Address, strconv.Itoa(c.Port))); err != nil {
+				return fmt.Errorf("invalid address '%s'", c.Address)
+			}
+		}
+	} else if c.Address != "" {
+		return fmt.Errorf("address '%s' cannot be combined with socket path '%s'", c.Address, c.SocketPath)
+	}
+
+	if !c.Plaintext {
+		keyPath, certPath := stringValue(c.KeyPath), stringValue(c.CertPath)
+		if (keyPath == "") != (certPath == "") {
+			return fmt.Errorf("both the key path and the certificate path must be specified")
+		}
+		for _, path := range []string{keyPath, certPath, stringValue(c.CaPath)} {
+			if path != "" {
+				if _, err := os.Stat(path); err != nil {
+					return fmt.Errorf("cannot read '%s': %v", path, err)
+				}
+			}
+		}
+	} else if !c.Insecure {
+		return fmt.Errorf("client certificates cannot be required in plaintext mode")
+	}
+
+	if c.KeepaliveTime < 0 || c.KeepaliveTimeout < 0 || c.KeepaliveMinTime < 0 {
+		return fmt.Errorf("keepalive durations must not be negative")
+	}
+	if c.MaxRecvMsgSize < 0 || c.MaxSendMsgSize < 0 {
+		return fmt.Errorf("maximum message sizes must not be negative")
+	}
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
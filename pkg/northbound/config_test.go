@@ -0,0 +1,111 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package northbound
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestServerConfigValidate(t *testing.T) {
+	cfg := NewServerConfig("", "", "")
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, TLSModeTLS, cfg.TLSMode())
+
+	cfg.Port = 0
+	assert.Error(t, cfg.Validate())
+	cfg.Port = 70000
+	assert.Error(t, cfg.Validate())
+	cfg.Port = DefaultPort
+
+	cfg.Address = "::1"
+	assert.NoError(t, cfg.Validate())
+	cfg.SocketPath = "/tmp/onos-topo.sock"
+	assert.Error(t, cfg.Validate())
+	cfg.Address = ""
+	assert.NoError(t, cfg.Validate())
+	cfg.SocketPath = ""
+
+	keyPath := "/does/not/exist.key"
+	cfg.KeyPath = &keyPath
+	assert.Error(t, cfg.Validate())
+	certPath := "/does/not/exist.crt"
+	cfg.CertPath = &certPath
+	assert.Error(t, cfg.Validate())
+
+	assert.NoError(t, cfg.SetTLSMode(TLSModePlaintext))
+	assert.NoError(t, cfg.Validate())
+	assert.NoError(t, cfg.SetTLSMode(TLSModeMutual))
+	assert.False(t, cfg.Insecure)
+	assert.Error(t, cfg.SetTLSMode("foo"))
+
+	cfg = NewServerConfig("", "", "")
+	cfg.KeepaliveTime = -time.Second
+	assert.Error(t, cfg.Validate())
+	cfg.KeepaliveTime = 0
+	cfg.MaxRecvMsgSize = -1
+	assert.Error(t, cfg.Validate())
+}
+
+type healthService struct{}
+
+func (healthService) Register(s *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+}
+
+func TestServeUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "onos-topo")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := NewServerConfig("", "", "")
+	cfg.SocketPath = filepath.Join(dir, "onos-topo.sock")
+	assert.NoError(t, cfg.SetTLSMode(TLSModePlaintext))
+	assert.NoError(t, cfg.Validate())
+
+	server := NewServer(cfg)
+	server.AddService(healthService{})
+	started := make(chan struct{})
+	go func() {
+		_ = server.Serve(func(string) {
+			close(started)
+		})
+	}()
+	<-started
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, cfg.SocketPath, grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	response, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+}
@@ -0,0 +1,196 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"context"
+
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/admission"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newAdmissionChain returns the chain admitting device operations through the built-in plugins
+// and the given plugins. Device fields and lifecycle transitions are validated before any other
+// validator runs, and the default timeout is applied after all other mutators have run.
+func newAdmissionChain(plugins ...admission.Plugin) *admission.Chain {
+	chain := []admission.Plugin{newFieldsValidator(), newLifecycleValidator()}
+	chain = append(chain, plugins...)
+	chain = append(chain, newDefaultsMutator())
+	return admission.NewChain(chain...)
+}
+
+// newFieldsValidator returns the plugin validating the device ID, address, type and version
+func newFieldsValidator() admission.Validator {
+	return admission.NewValidator("device-fields", func(ctx context.Context, request *admission.Request) error {
+		if request.Operation == admissionapi.Operation_DELETE {
+			return nil
+		}
+		return ValidateDevice(request.Device)
+	})
+}
+
+// newLifecycleValidator returns the plugin validating the lifecycle state transitions of updated devices
+func newLifecycleValidator() admission.Validator {
+	return admission.NewValidator("device-lifecycle", func(ctx context.Context, request *admission.Request) error {
+		if request.Operation != admissionapi.Operation_UPDATE || request.OldDevice == nil {
+			return nil
+		}
+		from, to := request.OldDevice.LifecycleState, request.Device.LifecycleState
+		if !from.CanTransitionTo(to) {
+			return status.Errorf(codes.FailedPrecondition, "device '%s' cannot transition from %s to %s", request.Device.ID, from, to)
+		}
+		return nil
+	})
+}
+
+// newDefaultsMutator returns the plugin setting the default timeout of devices
+func newDefaultsMutator() admission.Mutator {
+	return admission.NewMutator("device-defaults", func(ctx context.Context, request *admission.Request) error {
+		if request.Device.Timeout == nil {
+			timeout := DefaultTimeout
+			request.Device.Timeout = &timeout
+		}
+		return nil
+	})
+}
+
+// NewTypePlugin returns the plugin validating devices against their type in the given catalog and
+// applying the defaults of the type. Devices of types that are not in the catalog are admitted
+// according to the given policy.
+func NewTypePlugin(typeStore TypeStore, policy TypePolicy) admission.Plugin {
+	return &typePlugin{
+		typeStore: typeStore,
+		policy:    policy,
+	}
+}
+
+// typePlugin is the admission plugin for device types
+type typePlugin struct {
+	typeStore TypeStore
+	policy    TypePolicy
+}
+
+func (p *typePlugin) Name() string {
+	return "device-type"
+}
+
+func (p *typePlugin) Mutate(ctx context.Context, request *admission.Request) error {
+	deviceType, err := p.typeStore.Load(request.Device.Type)
+	if err != nil {
+		return err
+	} else if deviceType != nil {
+		ApplyTypeDefaults(request.Device, deviceType)
+	}
+	return nil
+}
+
+func (p *typePlugin) Validate(ctx context.Context, request *admission.Request) error {
+	if request.Operation == admissionapi.Operation_DELETE {
+		return nil
+	}
+	deviceType, err := p.typeStore.Load(request.Device.Type)
+	if err != nil {
+		return err
+	} else if deviceType == nil {
+		if p.policy == TypePolicyReject {
+			return status.Errorf(codes.InvalidArgument, "device type '%s' is unknown", request.Device.Type)
+		}
+		return nil
+	}
+	return ValidateDeviceOfType(request.Device, deviceType)
+}
+
+// Admit runs the admission chain for the given operation on the given device, returning the admitted
+// device. Updated and removed devices must exist, and their revision must match the stored revision if set.
+func (s *Server) Admit(ctx context.Context, operation admissionapi.Operation, device *deviceapi.Device) (*deviceapi.Device, error) {
+	request := &admission.Request{
+		Operation: operation,
+		Device:    device,
+	}
+	if operation != admissionapi.Operation_CREATE {
+		stored, err := s.deviceStore.Load(device.Namespace, device.ID)
+		if err != nil {
+			return nil, err
+		} else if stored == nil {
+			return nil, status.Errorf(codes.NotFound, "device '%s' not found", device.ID)
+		} else if device.Revision > 0 && device.Revision != stored.Revision {
+			return nil, deviceapi.NewRevisionConflict("device", string(device.ID), device.Revision, stored.Revision)
+		}
+		if operation == admissionapi.Operation_UPDATE {
+			request.OldDevice = stored
+		} else {
+			request.Device = stored
+		}
+	}
+	if err := s.chain.Admit(ctx, request); err != nil {
+		return nil, err
+	}
+	return request.Device, nil
+}
+
+// Quotas limits the number of devices in each namespace
+type Quotas struct {
+	// Default is the maximum number of devices in namespaces without a quota; zero is unlimited
+	Default int
+
+	// Namespaces maps namespaces to their maximum number of devices; zero is unlimited
+	Namespaces map[string]int
+}
+
+// limit returns the maximum number of devices in the given namespace, or zero if it is unlimited
+func (q Quotas) limit(namespace string) int {
+	if limit, ok := q.Namespaces[namespace]; ok {
+		return limit
+	}
+	return q.Default
+}
+
+// Check returns a ResourceExhausted error if adding a device to the given namespace, holding the given
+// number of devices, would exceed the quota of the namespace
+func (q Quotas) Check(namespace string, count int) error {
+	if limit := q.limit(namespace); limit > 0 && count >= limit {
+		return status.Errorf(codes.ResourceExhausted, "namespace '%s' has reached its quota of %d devices", namespace, limit)
+	}
+	return nil
+}
+
+// NewQuotaPlugin returns the plugin rejecting devices added to namespaces that have reached their quota.
+// Quotas are checked before devices are stored, so concurrent additions may briefly exceed them.
+func NewQuotaPlugin(deviceStore Store, quotas Quotas) admission.Plugin {
+	return admission.NewValidator("device-quota", func(ctx context.Context, request *admission.Request) error {
+		if request.Operation != admissionapi.Operation_CREATE {
+			return nil
+		}
+		namespace := namespaceOrDefault(request.Device.Namespace)
+		if quotas.limit(namespace) <= 0 {
+			return nil
+		}
+
+		ch := make(chan *deviceapi.Device)
+		if err := deviceStore.List(ch); err != nil {
+			return err
+		}
+		count := 0
+		for device := range ch {
+			if device.Namespace == namespace {
+				count++
+			}
+		}
+		return quotas.Check(namespace, count)
+	})
+}
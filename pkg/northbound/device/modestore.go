@@ -0,0 +1,156 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/atomix/atomix-go-client/pkg/client/map"
+	"github.com/atomix/atomix-go-client/pkg/client/primitive"
+	"github.com/atomix/atomix-go-client/pkg/client/session"
+	"github.com/gogo/protobuf/proto"
+	adminapi "github.com/onosproject/onos-topo/api/admin"
+	"github.com/onosproject/onos-topo/pkg/metrics"
+	"github.com/onosproject/onos-topo/pkg/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// modeKey is the key under which the mode is stored in the settings map
+const modeKey = "mode"
+
+// NewAtomixModeStore returns a new persistent ModeStore
+func NewAtomixModeStore() (ModeStore, error) {
+	client, err := util.GetAtomixClient()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := client.GetGroup(context.Background(), util.GetAtomixRaftGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := group.GetMap(context.Background(), "settings", session.WithTimeout(SessionTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomixModeStore{
+		settings: settings,
+		closer:   settings,
+	}, nil
+}
+
+// NewLocalModeStore returns a new local mode store
+func NewLocalModeStore() (ModeStore, error) {
+	node, conn := util.StartLocalNode()
+	name := primitive.Name{
+		Namespace: "local",
+		Name:      "settings",
+	}
+
+	settings, err := _map.New(context.Background(), name, []*grpc.ClientConn{conn})
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomixModeStore{
+		settings: settings,
+		closer:   util.NewNodeCloser(node),
+	}, nil
+}
+
+// ModeStore stores the operating mode of the topology service
+type ModeStore interface {
+	io.Closer
+
+	// Load loads the current mode from the store
+	Load() (*adminapi.Mode, error)
+
+	// Store stores the mode in the store
+	Store(*adminapi.Mode) error
+}
+
+// atomixModeStore is the Atomix implementation of the ModeStore
+type atomixModeStore struct {
+	settings _map.Map
+	closer   io.Closer
+}
+
+// observeMode records metrics for a mode store operation started at the given time
+func observeMode(operation string, start time.Time, err error) {
+	metrics.ObserveStoreOperation("settings", operation, start, err, false)
+}
+
+func (s *atomixModeStore) Load() (_ *adminapi.Mode, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observeMode("load", start, err) }(time.Now())
+
+	entry, err := s.settings.Get(ctx, modeKey)
+	if err != nil {
+		return nil, err
+	} else if entry == nil {
+		return &adminapi.Mode{}, nil
+	}
+
+	mode := &adminapi.Mode{}
+	if err := proto.Unmarshal(entry.Value, mode); err != nil {
+		return nil, err
+	}
+	return mode, nil
+}
+
+func (s *atomixModeStore) Store(mode *adminapi.Mode) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observeMode("store", start, err) }(time.Now())
+
+	bytes, err := proto.Marshal(mode)
+	if err != nil {
+		return err
+	}
+	_, err = s.settings.Put(ctx, modeKey, bytes)
+	return err
+}
+
+func (s *atomixModeStore) Close() error {
+	_ = s.settings.Close()
+	return s.closer.Close()
+}
+
+// CheckWritable returns an Unavailable error carrying the reason if the topology is read-only.
+// The mode is loaded from the store on each call so that a change made through any replica takes
+// effect immediately. A nil store is always writable.
+func CheckWritable(modeStore ModeStore) error {
+	if modeStore == nil {
+		return nil
+	}
+	mode, err := modeStore.Load()
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to load the topology mode: %v", err)
+	}
+	if !mode.ReadOnly {
+		return nil
+	}
+	if mode.Reason == "" {
+		return status.Error(codes.Unavailable, "the topology is read-only")
+	}
+	return status.Errorf(codes.Unavailable, "the topology is read-only: %s", mode.Reason)
+}
@@ -17,51 +17,122 @@ package device
 
 import (
 	"context"
+	admissionapi "github.com/onosproject/onos-topo/api/admission"
 	deviceapi "github.com/onosproject/onos-topo/api/device"
+	diagsapi "github.com/onosproject/onos-topo/api/diags"
+	"github.com/onosproject/onos-topo/pkg/admission"
+	"github.com/onosproject/onos-topo/pkg/metrics"
 	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/semver"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	log "k8s.io/klog"
 	"regexp"
+	"sync"
 	"time"
 )
 
-const (
-	defaultTimeout       = 5 * time.Second
-	deviceNamePattern    = `^[a-zA-Z0-9\-:_]{4,40}$`
-	deviceAddressPattern = `^[a-zA-Z0-9\-_\.]+:[0-9]+$`
-	deviceVersionPattern = `^(\d+\.\d+\.\d+)$`
-)
+// DefaultTimeout is the timeout of devices that neither set a timeout nor inherit one from their type
+const DefaultTimeout = 5 * time.Second
 
-// NewService returns a new device Service
-func NewService() (northbound.Service, error) {
-	deviceStore, err := NewAtomixStore()
-	if err != nil {
-		return nil, err
-	}
+const deviceNamePattern = `^[a-zA-Z0-9\-:_]{4,40}$`
+
+// NewService returns a new device Service backed by the given device and device type stores.
+// Writes are rejected while the mode store reports the topology as read-only.
+// Device operations are admitted by the built-in plugins and then the given plugins.
+// The stores and plugins are closed when the Service is closed.
+func NewService(deviceStore Store, typeStore TypeStore, modeStore ModeStore, plugins ...admission.Plugin) (*Service, error) {
+	server := NewServer(deviceStore, plugins...)
+	server.modeStore = modeStore
+	typeServer := NewTypeServer(typeStore, deviceStore)
+	typeServer.modeStore = modeStore
 	return &Service{
-		store: deviceStore,
+		store:      deviceStore,
+		typeStore:  typeStore,
+		modeStore:  modeStore,
+		server:     server,
+		typeServer: typeServer,
 	}, nil
 }
 
 // Service is a Service implementation for administration.
 type Service struct {
 	northbound.Service
-	store Store
+	store      Store
+	typeStore  TypeStore
+	modeStore  ModeStore
+	server     *Server
+	typeServer *TypeServer
 }
 
 // Register registers the Service with the gRPC server.
 func (s Service) Register(r *grpc.Server) {
-	server := &Server{
-		deviceStore: s.store,
+	deviceapi.RegisterDeviceServiceServer(r, s.server)
+	deviceapi.RegisterDeviceTypeServiceServer(r, s.typeServer)
+}
+
+// Drain closes all open device subscriptions.
+func (s Service) Drain() {
+	s.server.Drain()
+}
+
+// Subscribers returns the active device List subscribers.
+func (s Service) Subscribers() []*diagsapi.Subscriber {
+	return s.server.Subscribers()
+}
+
+// Admit runs the admission chain of the device service for the given operation on the given device.
+func (s Service) Admit(ctx context.Context, operation admissionapi.Operation, device *deviceapi.Device) (*deviceapi.Device, error) {
+	return s.server.Admit(ctx, operation, device)
+}
+
+// Close closes the admission plugins and the device, device type and mode stores.
+func (s Service) Close() error {
+	_ = s.server.chain.Close()
+	if s.modeStore != nil {
+		_ = s.modeStore.Close()
+	}
+	typeErr := s.typeStore.Close()
+	if err := s.store.Close(); err != nil {
+		return err
+	}
+	return typeErr
+}
+
+// NewServer returns a new device Server backed by the given store. Device operations are admitted
+// by the built-in plugins validating device fields and then by the given plugins.
+func NewServer(deviceStore Store, plugins ...admission.Plugin) *Server {
+	return &Server{
+		deviceStore: deviceStore,
+		chain:       newAdmissionChain(plugins...),
+		subscribers: newSubscriberRegistry(),
+		drainCh:     make(chan struct{}),
 	}
-	deviceapi.RegisterDeviceServiceServer(r, server)
 }
 
 // Server implements the gRPC service for administrative facilities.
 type Server struct {
 	deviceStore Store
+	modeStore   ModeStore
+	chain       *admission.Chain
+	subscribers *subscriberRegistry
+	drainCh     chan struct{}
+	drainOnce   sync.Once
+}
+
+// Drain closes all open device subscriptions with an Unavailable status, prompting
+// clients to reconnect to another server. Subscriptions opened after Drain are closed immediately.
+func (s *Server) Drain() {
+	s.drainOnce.Do(func() {
+		close(s.drainCh)
+	})
+}
+
+// Subscribers returns the active device List subscribers ordered by start time
+func (s *Server) Subscribers() []*diagsapi.Subscriber {
+	return s.subscribers.list()
 }
 
 // DeviceServiceClientFactory : Default DeviceServiceClient creation.
@@ -74,8 +145,8 @@ func CreateDeviceServiceClient(cc *grpc.ClientConn) deviceapi.DeviceServiceClien
 	return DeviceServiceClientFactory(cc)
 }
 
-// validateDevice validates the given device
-func validateDevice(device *deviceapi.Device) error {
+// ValidateDevice validates the fields of the given device
+func ValidateDevice(device *deviceapi.Device) error {
 	nameRegex := regexp.MustCompile(deviceNamePattern)
 	if device.ID == "" {
 		return status.Error(codes.InvalidArgument, "device ID is required")
@@ -83,13 +154,17 @@ func validateDevice(device *deviceapi.Device) error {
 	if !nameRegex.MatchString(string(device.ID)) {
 		return status.Errorf(codes.InvalidArgument, "device ID '%s' is invalid", device.ID)
 	}
+	if device.Namespace != "" {
+		if err := northbound.ValidateNamespace(device.Namespace); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 
-	addressRegex := regexp.MustCompile(deviceAddressPattern)
 	if device.Address == "" {
 		return status.Error(codes.InvalidArgument, "device address is required")
 	}
-	if !addressRegex.MatchString(device.Address) {
-		return status.Errorf(codes.InvalidArgument, "device address '%s' is invalid", device.Address)
+	if _, err := deviceapi.ParseAddress(device.Address); err != nil {
+		return status.Errorf(codes.InvalidArgument, "device address '%s' is invalid: %v", device.Address, err)
 	}
 
 	if device.Type == "" {
@@ -99,32 +174,66 @@ func validateDevice(device *deviceapi.Device) error {
 		return status.Errorf(codes.InvalidArgument, "device type '%s' is invalid", device.ID)
 	}
 
-	versionRegex := regexp.MustCompile(deviceVersionPattern)
 	if device.Version == "" {
 		return status.Error(codes.InvalidArgument, "device version is required")
 	}
-	if !versionRegex.MatchString(device.Version) {
+	if _, err := semver.ParseVersion(device.Version); err != nil {
 		return status.Errorf(codes.InvalidArgument, "device version '%s' is invalid", device.Version)
 	}
+	if _, ok := deviceapi.LifecycleState_name[int32(device.LifecycleState)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "device lifecycle state %d is invalid", device.LifecycleState)
+	}
+	return validateEndpoints(device)
+}
 
-	if device.Timeout == nil {
-		timeout := defaultTimeout
-		device.Timeout = &timeout
+// validateEndpoints validates the per-protocol endpoints of the given device
+func validateEndpoints(device *deviceapi.Device) error {
+	protocols := make(map[deviceapi.Protocol]bool)
+	for _, endpoint := range device.Endpoints {
+		if endpoint == nil || endpoint.Protocol == deviceapi.Protocol_UNKNOWN_PROTOCOL {
+			return status.Error(codes.InvalidArgument, "device endpoint protocol is required")
+		}
+		if protocols[endpoint.Protocol] {
+			return status.Errorf(codes.InvalidArgument, "device endpoint %s is duplicated", endpoint.Protocol)
+		}
+		protocols[endpoint.Protocol] = true
+		if endpoint.Address != "" {
+			if _, err := deviceapi.ParseAddress(endpoint.Address); err != nil {
+				return status.Errorf(codes.InvalidArgument, "device endpoint %s address '%s' is invalid: %v", endpoint.Protocol, endpoint.Address, err)
+			}
+		}
+		if endpoint.Timeout != nil && *endpoint.Timeout <= 0 {
+			return status.Errorf(codes.InvalidArgument, "device endpoint %s timeout must be positive", endpoint.Protocol)
+		}
+		if endpoint.P4Runtime != nil && endpoint.Protocol != deviceapi.Protocol_P4RUNTIME {
+			return status.Errorf(codes.InvalidArgument, "device endpoint %s cannot have a P4Runtime configuration", endpoint.Protocol)
+		}
 	}
 	return nil
 }
 
 // Add :
 func (s *Server) Add(ctx context.Context, request *deviceapi.AddRequest) (*deviceapi.AddResponse, error) {
+	if err := CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
 	device := request.Device
 	if device == nil {
 		return nil, status.Error(codes.InvalidArgument, "no device specified")
 	} else if device.Revision > 0 {
 		return nil, status.Error(codes.InvalidArgument, "device revision is already set")
-	} else if err := validateDevice(device); err != nil {
+	}
+	if err := setNamespace(ctx, device); err != nil {
 		return nil, err
 	}
-	if err := s.deviceStore.Store(device); err != nil {
+	device, err := s.Admit(ctx, admissionapi.Operation_CREATE, device)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deviceStore.Create(device); err != nil {
+		if IsConflict(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "device '%s' already exists", device.ID)
+		}
 		return nil, err
 	}
 	return &deviceapi.AddResponse{
@@ -134,17 +243,26 @@ func (s *Server) Add(ctx context.Context, request *deviceapi.AddRequest) (*devic
 
 // Update :
 func (s *Server) Update(ctx context.Context, request *deviceapi.UpdateRequest) (*deviceapi.UpdateResponse, error) {
+	if err := CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
 	device := request.Device
 	if device == nil {
 		return nil, status.Error(codes.InvalidArgument, "no device specified")
 	} else if device.Revision == 0 {
 		return nil, status.Error(codes.InvalidArgument, "device revision not set")
-	} else if err := validateDevice(device); err != nil {
+	}
+	if err := setNamespace(ctx, device); err != nil {
 		return nil, err
 	}
-	if err := s.deviceStore.Store(device); err != nil {
+	device, err := s.Admit(ctx, admissionapi.Operation_UPDATE, device)
+	if err != nil {
 		return nil, err
 	}
+	revision := device.Revision
+	if err := s.deviceStore.Store(device); err != nil {
+		return nil, s.storeError(device.Namespace, device.ID, revision, err)
+	}
 	log.Info("Updated Device {}", device)
 	return &deviceapi.UpdateResponse{
 		Device: device,
@@ -153,7 +271,11 @@ func (s *Server) Update(ctx context.Context, request *deviceapi.UpdateRequest) (
 
 // Get :
 func (s *Server) Get(ctx context.Context, request *deviceapi.GetRequest) (*deviceapi.GetResponse, error) {
-	device, err := s.deviceStore.Load(request.ID)
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	device, err := s.deviceStore.Load(namespace, request.ID)
 	if err != nil {
 		return nil, err
 	} else if device == nil {
@@ -166,13 +288,49 @@ func (s *Server) Get(ctx context.Context, request *deviceapi.GetRequest) (*devic
 
 // List :
 func (s *Server) List(request *deviceapi.ListRequest, server deviceapi.DeviceService_ListServer) error {
+	namespace, err := northbound.RequestNamespace(server.Context())
+	if err != nil {
+		return err
+	}
+	filter, err := newListFilter(namespace, request)
+	if err != nil {
+		return err
+	}
+
 	if request.Subscribe {
-		ch := make(chan *Event)
-		if err := s.deviceStore.Watch(ch); err != nil {
+		ctx, cancel := context.WithCancel(server.Context())
+		defer cancel()
+
+		ch := make(chan *Event, subscriberQueueSize)
+		if err := s.deviceStore.Watch(ctx, ch); err != nil {
+			return err
+		}
+
+		metrics.SubscriberAdded()
+		defer metrics.SubscriberRemoved()
+		sub := s.subscribers.add(server.Context(), request, ch)
+		defer s.subscribers.remove(sub)
+		if err := server.SendHeader(metadata.Pairs(deviceapi.SubscribedHeader, "true")); err != nil {
 			return err
 		}
 
-		for event := range ch {
+		for {
+			var event *Event
+			select {
+			case e, ok := <-ch:
+				if !ok {
+					return nil
+				}
+				event = e
+			case <-s.drainCh:
+				return status.Error(codes.Unavailable, "server is shutting down")
+			case <-ctx.Done():
+				return nil
+			}
+			if !filter(event.Device) {
+				continue
+			}
+
 			var t deviceapi.ListResponse_Type
 			switch event.Type {
 			case EventNone:
@@ -191,6 +349,8 @@ func (s *Server) List(request *deviceapi.ListRequest, server deviceapi.DeviceSer
 			if err != nil {
 				return err
 			}
+			metrics.EventSent(t.String())
+			sub.sent()
 		}
 	} else {
 		ch := make(chan *deviceapi.Device)
@@ -199,6 +359,9 @@ func (s *Server) List(request *deviceapi.ListRequest, server deviceapi.DeviceSer
 		}
 
 		for device := range ch {
+			if !filter(device) {
+				continue
+			}
 			err := server.Send(&deviceapi.ListResponse{
 				Type:   deviceapi.ListResponse_NONE,
 				Device: device,
@@ -211,12 +374,86 @@ func (s *Server) List(request *deviceapi.ListRequest, server deviceapi.DeviceSer
 	return nil
 }
 
+// newListFilter returns a function returning whether a device of the given namespace matches the filters
+// of the given request. Devices of all namespaces match if the namespace is AllNamespaces.
+func newListFilter(namespace string, request *deviceapi.ListRequest) (func(*deviceapi.Device) bool, error) {
+	var versions *semver.Range
+	if request.Versions != "" {
+		r, err := semver.ParseRange(request.Versions)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		versions = &r
+	}
+
+	var states map[deviceapi.LifecycleState]bool
+	if len(request.LifecycleStates) > 0 {
+		states = make(map[deviceapi.LifecycleState]bool)
+		for _, state := range request.LifecycleStates {
+			states[state] = true
+		}
+	}
+
+	return func(device *deviceapi.Device) bool {
+		if namespace != northbound.AllNamespaces && device.Namespace != namespace {
+			return false
+		}
+		if states != nil && !states[device.LifecycleState] {
+			return false
+		}
+		if versions != nil {
+			version, err := semver.ParseVersion(device.Version)
+			return err == nil && versions.Contains(version)
+		}
+		return true
+	}, nil
+}
+
 // Remove :
 func (s *Server) Remove(ctx context.Context, request *deviceapi.RemoveRequest) (*deviceapi.RemoveResponse, error) {
+	if err := CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
 	device := request.Device
-	err := s.deviceStore.Delete(device)
-	if err != nil {
+	if device == nil {
+		return nil, status.Error(codes.InvalidArgument, "no device specified")
+	}
+	if err := setNamespace(ctx, device); err != nil {
 		return nil, err
 	}
+	if _, err := s.Admit(ctx, admissionapi.Operation_DELETE, device); err != nil {
+		return nil, err
+	}
+	if err := s.deviceStore.Delete(device); err != nil {
+		return nil, s.storeError(device.Namespace, device.ID, device.Revision, err)
+	}
 	return &deviceapi.RemoveResponse{}, nil
 }
+
+// setNamespace sets the namespace of the given device to the namespace of the request of the given
+// context, failing if the device names another namespace
+func setNamespace(ctx context.Context, device *deviceapi.Device) error {
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return err
+	} else if device.Namespace != "" && device.Namespace != namespace {
+		return status.Errorf(codes.InvalidArgument, "device namespace '%s' does not match request namespace '%s'", device.Namespace, namespace)
+	}
+	device.Namespace = namespace
+	return nil
+}
+
+// storeError maps an optimistic lock failure when writing the given revision of a device to a NotFound
+// error if the device has been removed, or to an Aborted error carrying the current revision of the device
+func (s *Server) storeError(namespace string, id deviceapi.ID, revision deviceapi.Revision, err error) error {
+	if !IsConflict(err) {
+		return err
+	}
+	current, loadErr := s.deviceStore.Load(namespace, id)
+	if loadErr != nil {
+		return status.Errorf(codes.Aborted, "device '%s' was modified concurrently", id)
+	} else if current == nil {
+		return status.Errorf(codes.NotFound, "device '%s' not found", id)
+	}
+	return deviceapi.NewRevisionConflict("device", string(id), revision, current.Revision)
+}
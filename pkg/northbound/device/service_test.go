@@ -16,9 +16,14 @@ package device
 
 import (
 	"context"
+	adminapi "github.com/onosproject/onos-topo/api/admin"
 	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 	"io"
 	log "k8s.io/klog"
@@ -36,9 +41,7 @@ func TestLocalServer(t *testing.T) {
 	defer store.Close()
 	defer s.Stop()
 
-	deviceapi.RegisterDeviceServiceServer(s, &Server{
-		deviceStore: store,
-	})
+	deviceapi.RegisterDeviceServiceServer(s, NewServer(store))
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -214,4 +217,386 @@ func TestLocalServer(t *testing.T) {
 		},
 	})
 	assert.NoError(t, err, "device should be good")
+
+	_, err = client.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      deviceapi.ID("good-ipv6"),
+			Type:    "test",
+			Address: "[2001:db8::1]:9339",
+			Version: "1.0.0",
+		},
+	})
+	assert.NoError(t, err, "IPv6 device should be good")
+
+	_, err = client.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      deviceapi.ID("bad-port"),
+			Type:    "test",
+			Address: "bad-port:99999",
+			Version: "1.0.0",
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDrain(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	defer s.Stop()
+
+	server := NewServer(store)
+	deviceapi.RegisterDeviceServiceServer(s, server)
+
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			panic("Server exited with error")
+		}
+	}()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		panic("Failed to dial bufnet")
+	}
+
+	client := CreateDeviceServiceClient(conn)
+	_, err = client.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      deviceapi.ID("device-foo"),
+			Type:    "test",
+			Address: "device-foo:1234",
+			Version: "1.0.0",
+		},
+	})
+	assert.NoError(t, err)
+
+	subscribe, err := client.List(context.Background(), &deviceapi.ListRequest{
+		Subscribe: true,
+	})
+	assert.NoError(t, err)
+	response, err := subscribe.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, deviceapi.ID("device-foo"), response.Device.ID)
+
+	server.Drain()
+	_, err = subscribe.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Subscriptions opened after the server is drained are closed immediately
+	subscribe, err = client.List(context.Background(), &deviceapi.ListRequest{
+		Subscribe: true,
+	})
+	assert.NoError(t, err)
+	for err == nil {
+		_, err = subscribe.Recv()
+	}
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestValidateEndpoints(t *testing.T) {
+	timeout := -time.Second
+	newDevice := func(endpoints ...*deviceapi.Endpoint) *deviceapi.Device {
+		return &deviceapi.Device{
+			ID:        "stratum-1",
+			Type:      "Stratum",
+			Address:   "stratum-1:9339",
+			Version:   "1.0.0",
+			Endpoints: endpoints,
+		}
+	}
+
+	invalid := []*deviceapi.Device{
+		newDevice(&deviceapi.Endpoint{}),
+		newDevice(&deviceapi.Endpoint{Protocol: deviceapi.Protocol_GNMI}, &deviceapi.Endpoint{Protocol: deviceapi.Protocol_GNMI}),
+		newDevice(&deviceapi.Endpoint{Protocol: deviceapi.Protocol_P4RUNTIME, Address: "stratum-1"}),
+		newDevice(&deviceapi.Endpoint{Protocol: deviceapi.Protocol_P4RUNTIME, Timeout: &timeout}),
+		newDevice(&deviceapi.Endpoint{Protocol: deviceapi.Protocol_GNMI, P4Runtime: &deviceapi.P4RuntimeConfig{DeviceID: 1}}),
+	}
+	for _, device := range invalid {
+		assert.Equal(t, codes.InvalidArgument, status.Code(ValidateDevice(device)), "%v", device)
+	}
+
+	assert.NoError(t, ValidateDevice(newDevice(
+		&deviceapi.Endpoint{Protocol: deviceapi.Protocol_GNMI},
+		&deviceapi.Endpoint{
+			Protocol:  deviceapi.Protocol_P4RUNTIME,
+			Address:   "[2001:db8::1]:9559",
+			P4Runtime: &deviceapi.P4RuntimeConfig{DeviceID: 1, ElectionID: 10},
+		},
+	)))
+}
+
+func TestListFilter(t *testing.T) {
+	_, err := newListFilter(northbound.AllNamespaces, &deviceapi.ListRequest{Versions: "<>2.0.0"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	filter, err := newListFilter(northbound.AllNamespaces, &deviceapi.ListRequest{})
+	assert.NoError(t, err)
+	assert.True(t, filter(&deviceapi.Device{Version: "2.0.0"}))
+
+	filter, err = newListFilter(northbound.AllNamespaces, &deviceapi.ListRequest{Versions: "<2.0.0"})
+	assert.NoError(t, err)
+	assert.True(t, filter(&deviceapi.Device{Version: "1.9.0"}))
+	assert.True(t, filter(&deviceapi.Device{Version: "1.22.1F"}))
+	assert.True(t, filter(&deviceapi.Device{Version: "2.0.0-rc2"}))
+	assert.False(t, filter(&deviceapi.Device{Version: "2.0.0"}))
+	assert.False(t, filter(&deviceapi.Device{Version: "foo"}))
+
+	filter, err = newListFilter("lab-a", &deviceapi.ListRequest{})
+	assert.NoError(t, err)
+	assert.True(t, filter(&deviceapi.Device{Namespace: "lab-a", Version: "2.0.0"}))
+	assert.False(t, filter(&deviceapi.Device{Namespace: northbound.DefaultNamespace, Version: "2.0.0"}))
+}
+
+func TestLifecycle(t *testing.T) {
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	server := NewServer(store)
+
+	addResponse, err := server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:             "leaf-1",
+			Type:           "Stratum",
+			Address:        "leaf-1:9339",
+			Version:        "1.0.0",
+			LifecycleState: deviceapi.LifecycleState_PLANNED,
+		},
+	})
+	assert.NoError(t, err)
+	device := addResponse.Device
+
+	device.LifecycleState = deviceapi.LifecycleState_IN_SERVICE
+	_, err = server.Update(context.Background(), &deviceapi.UpdateRequest{Device: device})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	for _, state := range []deviceapi.LifecycleState{
+		deviceapi.LifecycleState_PROVISIONING,
+		deviceapi.LifecycleState_IN_SERVICE,
+		deviceapi.LifecycleState_MAINTENANCE,
+	} {
+		device.LifecycleState = state
+		updateResponse, err := server.Update(context.Background(), &deviceapi.UpdateRequest{Device: device})
+		assert.NoError(t, err)
+		device = updateResponse.Device
+	}
+
+	device.LifecycleState = 10
+	_, err = server.Update(context.Background(), &deviceapi.UpdateRequest{Device: device})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	filter, err := newListFilter(northbound.AllNamespaces, &deviceapi.ListRequest{
+		LifecycleStates: []deviceapi.LifecycleState{deviceapi.LifecycleState_MAINTENANCE},
+	})
+	assert.NoError(t, err)
+	assert.True(t, filter(&deviceapi.Device{LifecycleState: deviceapi.LifecycleState_MAINTENANCE}))
+	assert.False(t, filter(&deviceapi.Device{LifecycleState: deviceapi.LifecycleState_IN_SERVICE}))
+}
+
+func TestConflicts(t *testing.T) {
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	server := NewServer(store)
+
+	addResponse, err := server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      "leaf-1",
+			Type:    "Stratum",
+			Address: "leaf-1:9339",
+			Version: "1.0.0",
+		},
+	})
+	assert.NoError(t, err)
+	stale := *addResponse.Device
+
+	updated := stale
+	updated.Role = "leaf"
+	updateResponse, err := server.Update(context.Background(), &deviceapi.UpdateRequest{Device: &updated})
+	assert.NoError(t, err)
+	current := updateResponse.Device.Revision
+
+	_, err = server.Update(context.Background(), &deviceapi.UpdateRequest{Device: &stale})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	revision, ok := deviceapi.GetCurrentRevision(err)
+	assert.True(t, ok)
+	assert.Equal(t, current, revision)
+
+	_, err = server.Remove(context.Background(), &deviceapi.RemoveRequest{Device: &stale})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	// Conflicts detected by the store are mapped to the same error
+	err = store.Store(&stale)
+	assert.True(t, IsConflict(err))
+	err = server.storeError(stale.Namespace, stale.ID, stale.Revision, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	revision, ok = deviceapi.GetCurrentRevision(err)
+	assert.True(t, ok)
+	assert.Equal(t, current, revision)
+
+	_, err = server.Remove(context.Background(), &deviceapi.RemoveRequest{Device: updateResponse.Device})
+	assert.NoError(t, err)
+	_, err = server.Remove(context.Background(), &deviceapi.RemoveRequest{Device: updateResponse.Device})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.Update(context.Background(), &deviceapi.UpdateRequest{Device: updateResponse.Device})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, ok = deviceapi.GetCurrentRevision(err)
+	assert.False(t, ok)
+}
+
+func TestAddExisting(t *testing.T) {
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	server := NewServer(store)
+
+	addResponse, err := server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:             "leaf-1",
+			Type:           "Stratum",
+			Address:        "leaf-1:9339",
+			Version:        "1.0.0",
+			LifecycleState: deviceapi.LifecycleState_DECOMMISSIONED,
+		},
+	})
+	assert.NoError(t, err)
+	stored := addResponse.Device
+
+	_, err = server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:             "leaf-1",
+			Type:           "Stratum",
+			Address:        "leaf-2:9339",
+			Version:        "1.0.0",
+			LifecycleState: deviceapi.LifecycleState_IN_SERVICE,
+		},
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	device, err := store.Load(stored.Namespace, "leaf-1")
+	assert.NoError(t, err)
+	assert.Equal(t, stored.Revision, device.Revision)
+	assert.Equal(t, "leaf-1:9339", device.Address)
+	assert.Equal(t, deviceapi.LifecycleState_DECOMMISSIONED, device.LifecycleState)
+}
+
+func TestReadOnly(t *testing.T) {
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	typeStore, err := NewLocalTypeStore()
+	assert.NoError(t, err)
+	defer typeStore.Close()
+	modeStore, err := NewLocalModeStore()
+	assert.NoError(t, err)
+	defer modeStore.Close()
+
+	server := NewServer(store)
+	server.modeStore = modeStore
+	typeServer := NewTypeServer(typeStore, store)
+	typeServer.modeStore = modeStore
+
+	addResponse, err := server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      "leaf-1",
+			Type:    "Stratum",
+			Address: "leaf-1:9339",
+			Version: "1.0.0",
+		},
+	})
+	assert.NoError(t, err)
+	device := addResponse.Device
+
+	assert.NoError(t, modeStore.Store(&adminapi.Mode{ReadOnly: true, Reason: "Atomix migration"}))
+
+	_, err = server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      "leaf-2",
+			Type:    "Stratum",
+			Address: "leaf-2:9339",
+			Version: "1.0.0",
+		},
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "Atomix migration")
+	_, err = server.Update(context.Background(), &deviceapi.UpdateRequest{Device: device})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = server.Remove(context.Background(), &deviceapi.RemoveRequest{Device: device})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = typeServer.Add(context.Background(), &deviceapi.AddDeviceTypeRequest{
+		DeviceType: &deviceapi.DeviceType{Name: "Stratum", Versions: "1.x"},
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	getResponse, err := server.Get(context.Background(), &deviceapi.GetRequest{ID: device.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, device.Revision, getResponse.Device.Revision)
+
+	assert.NoError(t, modeStore.Store(&adminapi.Mode{}))
+	_, err = server.Remove(context.Background(), &deviceapi.RemoveRequest{Device: device})
+	assert.NoError(t, err)
+}
+
+// namespaceContext returns the context of a request naming the given namespace
+func namespaceContext(namespace string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(northbound.NamespaceHeader, namespace))
+}
+
+func TestNamespaces(t *testing.T) {
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	server := NewServer(store, NewQuotaPlugin(store, Quotas{Namespaces: map[string]int{"lab-a": 1}}))
+
+	for _, namespace := range []string{northbound.DefaultNamespace, "lab-a"} {
+		response, err := server.Add(namespaceContext(namespace), &deviceapi.AddRequest{
+			Device: &deviceapi.Device{
+				ID:      "leaf-1",
+				Type:    "Stratum",
+				Address: namespace + ":9339",
+				Version: "1.0.0",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, namespace, response.Device.Namespace)
+	}
+
+	getResponse, err := server.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "default:9339", getResponse.Device.Address)
+	getResponse, err = server.Get(namespaceContext("lab-a"), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "lab-a:9339", getResponse.Device.Address)
+	_, err = server.Get(namespaceContext("lab-b"), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.Get(namespaceContext(northbound.AllNamespaces), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Devices cannot be moved to another namespace
+	device := getResponse.Device
+	_, err = server.Update(context.Background(), &deviceapi.UpdateRequest{Device: device})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The quota of a namespace does not apply to the others
+	_, err = server.Add(namespaceContext("lab-a"), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{ID: "leaf-2", Type: "Stratum", Address: "leaf-2:9339", Version: "1.0.0"},
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{ID: "leaf-2", Type: "Stratum", Address: "leaf-2:9339", Version: "1.0.0"},
+	})
+	assert.NoError(t, err)
+
+	_, err = server.Remove(namespaceContext("lab-a"), &deviceapi.RemoveRequest{Device: device})
+	assert.NoError(t, err)
+	_, err = server.Get(context.Background(), &deviceapi.GetRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
 }
@@ -19,19 +19,20 @@ import (
 	"github.com/atomix/atomix-go-client/pkg/client/map"
 	"github.com/atomix/atomix-go-client/pkg/client/primitive"
 	"github.com/atomix/atomix-go-client/pkg/client/session"
-	"github.com/atomix/atomix-go-local/pkg/atomix/local"
-	"github.com/atomix/atomix-go-node/pkg/atomix"
-	"github.com/atomix/atomix-go-node/pkg/atomix/registry"
 	"github.com/gogo/protobuf/proto"
 	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/metrics"
+	"github.com/onosproject/onos-topo/pkg/northbound"
 	"github.com/onosproject/onos-topo/pkg/util"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/test/bufconn"
 	"io"
-	"net"
+	"strings"
 	"time"
 )
 
+// SessionTimeout is the timeout of the Atomix sessions of the device and device type stores
+const SessionTimeout = 30 * time.Second
+
 // NewAtomixStore returns a new persistent Store
 func NewAtomixStore() (Store, error) {
 	client, err := util.GetAtomixClient()
@@ -44,7 +45,7 @@ func NewAtomixStore() (Store, error) {
 		return nil, err
 	}
 
-	devices, err := group.GetMap(context.Background(), "devices", session.WithTimeout(30*time.Second))
+	devices, err := group.GetMap(context.Background(), "devices", session.WithTimeout(SessionTimeout))
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +58,7 @@ func NewAtomixStore() (Store, error) {
 
 // NewLocalStore returns a new local device store
 func NewLocalStore() (Store, error) {
-	node, conn := startLocalNode()
+	node, conn := util.StartLocalNode()
 	name := primitive.Name{
 		Namespace: "local",
 		Name:      "devices",
@@ -70,53 +71,32 @@ func NewLocalStore() (Store, error) {
 
 	return &atomixStore{
 		devices: devices,
-		closer:  &nodeCloser{node},
+		closer:  util.NewNodeCloser(node),
 	}, nil
 }
 
-// startLocalNode starts a single local node
-func startLocalNode() (*atomix.Node, *grpc.ClientConn) {
-	lis := bufconn.Listen(1024 * 1024)
-	node := local.NewNode(lis, registry.Registry)
-	_ = node.Start()
-
-	dialer := func(ctx context.Context, address string) (net.Conn, error) {
-		return lis.Dial()
-	}
-
-	conn, err := grpc.DialContext(context.Background(), "devices", grpc.WithContextDialer(dialer), grpc.WithInsecure())
-	if err != nil {
-		panic("Failed to dial devices")
-	}
-	return node, conn
-}
-
-type nodeCloser struct {
-	node *atomix.Node
-}
-
-func (c *nodeCloser) Close() error {
-	return c.node.Stop()
-}
-
 // Store stores topology information
 type Store interface {
 	io.Closer
 
-	// Load loads a device from the store
-	Load(deviceID deviceapi.ID) (*deviceapi.Device, error)
+	// Load loads a device of the given namespace from the store
+	Load(namespace string, deviceID deviceapi.ID) (*deviceapi.Device, error)
 
-	// Store stores a device in the store
+	// Store stores a device in the store, in the namespace of the device or else the default namespace
 	Store(*deviceapi.Device) error
 
+	// Create stores a new device in the store, in the namespace of the device or else the default
+	// namespace, failing with a conflict if the device already exists
+	Create(*deviceapi.Device) error
+
 	// Delete deletes a device from the store
 	Delete(*deviceapi.Device) error
 
-	// List streams devices to the given channel
+	// List streams the devices of all namespaces to the given channel
 	List(chan<- *deviceapi.Device) error
 
-	// Watch streams device events to the given channel
-	Watch(chan<- *Event) error
+	// Watch streams device events of all namespaces to the given channel until the given context is cancelled
+	Watch(context.Context, chan<- *Event) error
 }
 
 // atomixStore is the device implementation of the Store
@@ -125,11 +105,47 @@ type atomixStore struct {
 	closer  io.Closer
 }
 
-func (s *atomixStore) Load(deviceID deviceapi.ID) (*deviceapi.Device, error) {
+// writeConditionFailed is the error returned by the map when an optimistic lock check fails
+const writeConditionFailed = "write condition failed"
+
+// IsConflict returns whether the given store error indicates an optimistic lock failure
+func IsConflict(err error) bool {
+	return err != nil && err.Error() == writeConditionFailed
+}
+
+// observe records metrics for a store operation started at the given time
+func observe(operation string, start time.Time, err error) {
+	metrics.ObserveStoreOperation("devices", operation, start, err, IsConflict(err))
+}
+
+// WatchMetrics keeps the device count metrics up to date from the devices in the given store until the
+// given context is cancelled. It should be called once per process.
+func WatchMetrics(ctx context.Context, store Store) error {
+	ch := make(chan *Event)
+	if err := store.Watch(ctx, ch); err != nil {
+		return err
+	}
+	go func() {
+		// Counts from a closed watch would go stale, so they are dropped
+		defer metrics.ResetDevices()
+		for event := range ch {
+			key := NamespacedKey(event.Device.Namespace, string(event.Device.ID))
+			if event.Type == EventRemoved {
+				metrics.DeviceRemoved(key)
+			} else {
+				metrics.DeviceUpdated(key, event.Device)
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixStore) Load(namespace string, deviceID deviceapi.ID) (_ *deviceapi.Device, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
+	defer func(start time.Time) { observe("load", start, err) }(time.Now())
 
-	entry, err := s.devices.Get(ctx, string(deviceID))
+	entry, err := s.devices.Get(ctx, NamespacedKey(namespace, string(deviceID)))
 	if err != nil {
 		return nil, err
 	} else if entry == nil {
@@ -138,10 +154,12 @@ func (s *atomixStore) Load(deviceID deviceapi.ID) (*deviceapi.Device, error) {
 	return decodeDevice(entry)
 }
 
-func (s *atomixStore) Store(device *deviceapi.Device) error {
+func (s *atomixStore) Store(device *deviceapi.Device) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
+	defer func(start time.Time) { observe("store", start, err) }(time.Now())
 
+	device.Namespace = namespaceOrDefault(device.Namespace)
 	bytes, err := proto.Marshal(device)
 	if err != nil {
 		return err
@@ -149,10 +167,11 @@ func (s *atomixStore) Store(device *deviceapi.Device) error {
 
 	// Put the device in the map using an optimistic lock if this is an update
 	var entry *_map.Entry
+	key := NamespacedKey(device.Namespace, string(device.ID))
 	if device.Revision == 0 {
-		entry, err = s.devices.Put(ctx, string(device.ID), bytes)
+		entry, err = s.devices.Put(ctx, key, bytes)
 	} else {
-		entry, err = s.devices.Put(ctx, string(device.ID), bytes, _map.IfVersion(int64(device.Revision)))
+		entry, err = s.devices.Put(ctx, key, bytes, _map.IfVersion(int64(device.Revision)))
 	}
 
 	if err != nil {
@@ -164,19 +183,42 @@ func (s *atomixStore) Store(device *deviceapi.Device) error {
 	return err
 }
 
-func (s *atomixStore) Delete(device *deviceapi.Device) error {
+func (s *atomixStore) Create(device *deviceapi.Device) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observe("create", start, err) }(time.Now())
+
+	device.Namespace = namespaceOrDefault(device.Namespace)
+	device.Revision = 0
+	bytes, err := proto.Marshal(device)
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.devices.Put(ctx, NamespacedKey(device.Namespace, string(device.ID)), bytes, _map.IfNotSet())
+	if err != nil {
+		return err
+	}
+	device.Revision = deviceapi.Revision(entry.Version)
+	return nil
+}
+
+func (s *atomixStore) Delete(device *deviceapi.Device) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
+	defer func(start time.Time) { observe("delete", start, err) }(time.Now())
 
+	key := NamespacedKey(device.Namespace, string(device.ID))
 	if device.Revision > 0 {
-		_, err := s.devices.Remove(ctx, string(device.ID), _map.IfVersion(int64(device.Revision)))
+		_, err = s.devices.Remove(ctx, key, _map.IfVersion(int64(device.Revision)))
 		return err
 	}
-	_, err := s.devices.Remove(ctx, string(device.ID))
+	_, err = s.devices.Remove(ctx, key)
 	return err
 }
 
-func (s *atomixStore) List(ch chan<- *deviceapi.Device) error {
+func (s *atomixStore) List(ch chan<- *deviceapi.Device) (err error) {
+	defer func(start time.Time) { observe("list", start, err) }(time.Now())
 	mapCh := make(chan *_map.Entry)
 	if err := s.devices.Entries(context.Background(), mapCh); err != nil {
 		return err
@@ -193,19 +235,23 @@ func (s *atomixStore) List(ch chan<- *deviceapi.Device) error {
 	return nil
 }
 
-func (s *atomixStore) Watch(ch chan<- *Event) error {
+func (s *atomixStore) Watch(ctx context.Context, ch chan<- *Event) error {
 	mapCh := make(chan *_map.Event)
-	if err := s.devices.Watch(context.Background(), mapCh, _map.WithReplay()); err != nil {
+	if err := s.devices.Watch(ctx, mapCh, _map.WithReplay()); err != nil {
 		return err
 	}
 
 	go func() {
 		defer close(ch)
+		// Keep draining the map channel after the context is cancelled until the map closes it
 		for event := range mapCh {
 			if device, err := decodeDevice(event.Entry); err == nil {
-				ch <- &Event{
+				select {
+				case ch <- &Event{
 					Type:   EventType(event.Type),
 					Device: device,
+				}:
+				case <-ctx.Done():
 				}
 			}
 		}
@@ -218,12 +264,44 @@ func (s *atomixStore) Close() error {
 	return s.closer.Close()
 }
 
+// namespaceSeparator separates the namespace from the name in store keys; it is not allowed in
+// namespaces, device IDs or link IDs
+const namespaceSeparator = "|"
+
+// NamespacedKey returns the key in a store map of the resource of the given namespace and name. Resources
+// of the default namespace are keyed by their name, so that resources stored before namespaces were
+// introduced keep their keys, and resources of other namespaces by their namespace and name.
+func NamespacedKey(namespace string, name string) string {
+	if namespace == "" || namespace == northbound.DefaultNamespace {
+		return name
+	}
+	return namespace + namespaceSeparator + name
+}
+
+// SplitNamespacedKey returns the namespace and name of the resource with the given key in a store map
+func SplitNamespacedKey(key string) (string, string) {
+	if i := strings.Index(key, namespaceSeparator); i >= 0 {
+		return key[:i], key[i+1:]
+	}
+	return northbound.DefaultNamespace, key
+}
+
+// namespaceOrDefault returns the given namespace, or the default namespace if it is empty
+func namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return northbound.DefaultNamespace
+	}
+	return namespace
+}
+
 func decodeDevice(entry *_map.Entry) (*deviceapi.Device, error) {
 	device := &deviceapi.Device{}
 	if err := proto.Unmarshal(entry.Value, device); err != nil {
 		return nil, err
 	}
-	device.ID = deviceapi.ID(entry.Key)
+	namespace, id := SplitNamespacedKey(entry.Key)
+	device.Namespace = namespace
+	device.ID = deviceapi.ID(id)
 	device.Revision = deviceapi.Revision(entry.Version)
 	return device, nil
 }
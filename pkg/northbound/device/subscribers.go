@@ -0,0 +1,119 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	diagsapi "github.com/onosproject/onos-topo/api/diags"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+)
+
+// subscriberQueueSize is the number of device events buffered for each List subscriber before
+// the store watch blocks
+const subscriberQueueSize = 64
+
+// subscriber is an active device List subscription
+type subscriber struct {
+	id         uint64
+	client     string
+	request    *deviceapi.ListRequest
+	started    time.Time
+	queue      chan *Event
+	mu         sync.RWMutex
+	eventsSent uint64
+	lastEvent  time.Time
+}
+
+// sent records an event sent to the subscriber
+func (s *subscriber) sent() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventsSent++
+	s.lastEvent = time.Now()
+}
+
+func (s *subscriber) info() *diagsapi.Subscriber {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	info := &diagsapi.Subscriber{
+		ID:            s.id,
+		Client:        s.client,
+		Filter:        s.request,
+		Started:       s.started,
+		EventsSent:    s.eventsSent,
+		QueueDepth:    uint32(len(s.queue)),
+		QueueCapacity: uint32(cap(s.queue)),
+	}
+	if !s.lastEvent.IsZero() {
+		lastEvent := s.lastEvent
+		info.LastEvent = &lastEvent
+	}
+	return info
+}
+
+// subscriberRegistry tracks the active device List subscriptions of a Server
+type subscriberRegistry struct {
+	mu          sync.RWMutex
+	nextID      uint64
+	subscribers map[uint64]*subscriber
+}
+
+func newSubscriberRegistry() *subscriberRegistry {
+	return &subscriberRegistry{
+		subscribers: make(map[uint64]*subscriber),
+	}
+}
+
+// add registers a subscription opened by the client of the given context
+func (r *subscriberRegistry) add(ctx context.Context, request *deviceapi.ListRequest, queue chan *Event) *subscriber {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	s := &subscriber{
+		id:      r.nextID,
+		client:  northbound.ClientIdentity(ctx),
+		request: request,
+		started: time.Now(),
+		queue:   queue,
+	}
+	r.subscribers[s.id] = s
+	return s
+}
+
+// remove unregisters the given subscription
+func (r *subscriberRegistry) remove(s *subscriber) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subscribers, s.id)
+}
+
+// list returns the active subscriptions ordered by start time
+func (r *subscriberRegistry) list() []*diagsapi.Subscriber {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	subscribers := make([]*diagsapi.Subscriber, 0, len(r.subscribers))
+	for _, s := range r.subscribers {
+		subscribers = append(subscribers, s.info())
+	}
+	sort.Slice(subscribers, func(i, j int) bool {
+		return subscribers[i].ID < subscribers[j].ID
+	})
+	return subscribers
+}
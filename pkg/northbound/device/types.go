@@ -0,0 +1,337 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/semver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TypePolicy determines how devices of types that are not in the catalog are admitted
+type TypePolicy string
+
+const (
+	// TypePolicyAllow admits devices of unknown types without validating them against a schema
+	TypePolicyAllow TypePolicy = "allow"
+
+	// TypePolicyReject rejects devices of unknown types
+	TypePolicyReject TypePolicy = "reject"
+)
+
+// ParseTypePolicy parses a device type policy
+func ParseTypePolicy(value string) (TypePolicy, error) {
+	switch policy := TypePolicy(value); policy {
+	case TypePolicyAllow, TypePolicyReject:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid device type policy '%s'", value)
+	}
+}
+
+// NewTypeServer returns a new device type Server backed by the given stores
+func NewTypeServer(typeStore TypeStore, deviceStore Store) *TypeServer {
+	return &TypeServer{
+		typeStore:   typeStore,
+		deviceStore: deviceStore,
+	}
+}
+
+// TypeServer implements the gRPC service for the device type catalog.
+type TypeServer struct {
+	typeStore   TypeStore
+	deviceStore Store
+	modeStore   ModeStore
+}
+
+// ValidateDeviceType validates the given device type
+func ValidateDeviceType(deviceType *deviceapi.DeviceType) error {
+	if deviceType.Name == "" {
+		return status.Error(codes.InvalidArgument, "device type name is required")
+	}
+	if !regexp.MustCompile(deviceNamePattern).MatchString(string(deviceType.Name)) {
+		return status.Errorf(codes.InvalidArgument, "device type name '%s' is invalid", deviceType.Name)
+	}
+
+	if deviceType.Versions != "" {
+		if _, err := semver.ParseRange(deviceType.Versions); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, attribute := range deviceType.Attributes {
+		if attribute.Name == "" {
+			return status.Error(codes.InvalidArgument, "attribute name is required")
+		} else if names[attribute.Name] {
+			return status.Errorf(codes.InvalidArgument, "attribute '%s' is duplicated", attribute.Name)
+		}
+		names[attribute.Name] = true
+
+		if _, ok := deviceapi.AttributeType_name[int32(attribute.Type)]; !ok {
+			return status.Errorf(codes.InvalidArgument, "attribute '%s' type %d is invalid", attribute.Name, attribute.Type)
+		}
+		if attribute.Pattern != "" {
+			if _, err := compilePattern(attribute.Pattern); err != nil {
+				return status.Errorf(codes.InvalidArgument, "attribute '%s' pattern is invalid: %v", attribute.Name, err)
+			}
+		}
+	}
+
+	for _, protocol := range deviceType.Protocols {
+		if _, ok := deviceapi.Protocol_name[int32(protocol)]; !ok || protocol == deviceapi.Protocol_UNKNOWN_PROTOCOL {
+			return status.Errorf(codes.InvalidArgument, "protocol %s is invalid", protocol)
+		}
+	}
+
+	if deviceType.Timeout != nil && *deviceType.Timeout <= 0 {
+		return status.Errorf(codes.InvalidArgument, "device type timeout %s is invalid", *deviceType.Timeout)
+	}
+	return nil
+}
+
+// compilePattern compiles an attribute pattern, which must match the whole attribute value
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// ValidateDeviceOfType validates the given device against the schema of its type
+func ValidateDeviceOfType(device *deviceapi.Device, deviceType *deviceapi.DeviceType) error {
+	if deviceType.Versions != "" {
+		versions, err := semver.ParseRange(deviceType.Versions)
+		if err != nil {
+			return status.Errorf(codes.Internal, "device type '%s' is invalid: %v", deviceType.Name, err)
+		}
+		version, err := semver.ParseVersion(device.Version)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "device version '%s' is invalid", device.Version)
+		}
+		if !versions.Contains(version) {
+			return status.Errorf(codes.InvalidArgument, "device version '%s' is not in the range '%s' of type '%s'",
+				device.Version, deviceType.Versions, deviceType.Name)
+		}
+	}
+
+	for _, attribute := range deviceType.Attributes {
+		value, ok := device.Attributes[attribute.Name]
+		if !ok {
+			if attribute.Required {
+				return status.Errorf(codes.InvalidArgument, "attribute '%s' is required by type '%s'", attribute.Name, deviceType.Name)
+			}
+			continue
+		}
+		if err := validateAttribute(attribute, value); err != nil {
+			return status.Errorf(codes.InvalidArgument, "attribute '%s' is invalid: %v", attribute.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateAttribute validates an attribute value against its schema
+func validateAttribute(attribute *deviceapi.AttributeSchema, value string) error {
+	switch attribute.Type {
+	case deviceapi.AttributeType_INTEGER:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("'%s' is not an integer", value)
+		}
+	case deviceapi.AttributeType_BOOLEAN:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("'%s' is not a boolean", value)
+		}
+	case deviceapi.AttributeType_FLOAT:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("'%s' is not a number", value)
+		}
+	}
+
+	if attribute.Pattern != "" {
+		pattern, err := compilePattern(attribute.Pattern)
+		if err != nil {
+			return err
+		}
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("'%s' does not match '%s'", value, attribute.Pattern)
+		}
+	}
+	return nil
+}
+
+// ApplyTypeDefaults sets the protocols and timeout of the device from its type if the device does not set them
+func ApplyTypeDefaults(device *deviceapi.Device, deviceType *deviceapi.DeviceType) {
+	if len(device.Protocols) == 0 {
+		for _, protocol := range deviceType.Protocols {
+			device.Protocols = append(device.Protocols, &deviceapi.ProtocolState{
+				Protocol: protocol,
+			})
+		}
+	}
+	if device.Timeout == nil && deviceType.Timeout != nil {
+		timeout := *deviceType.Timeout
+		device.Timeout = &timeout
+	}
+}
+
+// Add :
+func (s *TypeServer) Add(ctx context.Context, request *deviceapi.AddDeviceTypeRequest) (*deviceapi.AddDeviceTypeResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
+	deviceType := request.DeviceType
+	if deviceType == nil {
+		return nil, status.Error(codes.InvalidArgument, "no device type specified")
+	} else if deviceType.Revision > 0 {
+		return nil, status.Error(codes.InvalidArgument, "device type revision is already set")
+	} else if err := ValidateDeviceType(deviceType); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.typeStore.Load(deviceType.Name)
+	if err != nil {
+		return nil, err
+	} else if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "device type '%s' already exists", deviceType.Name)
+	}
+	if err := s.typeStore.Store(deviceType); err != nil {
+		return nil, err
+	}
+	return &deviceapi.AddDeviceTypeResponse{
+		DeviceType: deviceType,
+	}, nil
+}
+
+// Update :
+func (s *TypeServer) Update(ctx context.Context, request *deviceapi.UpdateDeviceTypeRequest) (*deviceapi.UpdateDeviceTypeResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
+	deviceType := request.DeviceType
+	if deviceType == nil {
+		return nil, status.Error(codes.InvalidArgument, "no device type specified")
+	} else if deviceType.Revision == 0 {
+		return nil, status.Error(codes.InvalidArgument, "device type revision not set")
+	} else if err := ValidateDeviceType(deviceType); err != nil {
+		return nil, err
+	}
+	revision := deviceType.Revision
+	if err := s.typeStore.Store(deviceType); err != nil {
+		return nil, s.storeError(deviceType.Name, revision, err)
+	}
+	return &deviceapi.UpdateDeviceTypeResponse{
+		DeviceType: deviceType,
+	}, nil
+}
+
+// Get :
+func (s *TypeServer) Get(ctx context.Context, request *deviceapi.GetDeviceTypeRequest) (*deviceapi.GetDeviceTypeResponse, error) {
+	deviceType, err := s.typeStore.Load(request.Name)
+	if err != nil {
+		return nil, err
+	} else if deviceType == nil {
+		return nil, status.Error(codes.NotFound, "device type not found")
+	}
+	return &deviceapi.GetDeviceTypeResponse{
+		DeviceType: deviceType,
+	}, nil
+}
+
+// List :
+func (s *TypeServer) List(ctx context.Context, request *deviceapi.ListDeviceTypesRequest) (*deviceapi.ListDeviceTypesResponse, error) {
+	ch := make(chan *deviceapi.DeviceType)
+	if err := s.typeStore.List(ch); err != nil {
+		return nil, err
+	}
+
+	var deviceTypes []*deviceapi.DeviceType
+	for deviceType := range ch {
+		deviceTypes = append(deviceTypes, deviceType)
+	}
+	sort.Slice(deviceTypes, func(i, j int) bool {
+		return deviceTypes[i].Name < deviceTypes[j].Name
+	})
+	return &deviceapi.ListDeviceTypesResponse{
+		DeviceTypes: deviceTypes,
+	}, nil
+}
+
+// Remove removes a device type, failing if any device of any namespace is of the type
+func (s *TypeServer) Remove(ctx context.Context, request *deviceapi.RemoveDeviceTypeRequest) (*deviceapi.RemoveDeviceTypeResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
+	deviceType := request.DeviceType
+	if deviceType == nil {
+		return nil, status.Error(codes.InvalidArgument, "no device type specified")
+	}
+
+	stored, err := s.typeStore.Load(deviceType.Name)
+	if err != nil {
+		return nil, err
+	} else if stored == nil {
+		return nil, status.Errorf(codes.NotFound, "device type '%s' not found", deviceType.Name)
+	} else if deviceType.Revision > 0 && deviceType.Revision != stored.Revision {
+		return nil, deviceapi.NewRevisionConflict("device type", string(deviceType.Name), deviceType.Revision, stored.Revision)
+	}
+
+	ch := make(chan *deviceapi.Device)
+	if err := s.deviceStore.List(ch); err != nil {
+		return nil, err
+	}
+	var inUse deviceapi.ID
+	for device := range ch {
+		if device.Type == deviceType.Name && inUse == "" {
+			inUse = device.ID
+		}
+	}
+	if inUse != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "device type '%s' is in use by device '%s'", deviceType.Name, inUse)
+	}
+
+	if err := s.typeStore.Delete(deviceType); err != nil {
+		return nil, s.storeError(deviceType.Name, deviceType.Revision, err)
+	}
+	return &deviceapi.RemoveDeviceTypeResponse{}, nil
+}
+
+// storeError maps an optimistic lock failure when writing the given revision of a device type to a
+// NotFound error if the type has been removed, or to an Aborted error carrying the current revision of the type
+func (s *TypeServer) storeError(name deviceapi.Type, revision deviceapi.Revision, err error) error {
+	if !IsConflict(err) {
+		return err
+	}
+	current, loadErr := s.typeStore.Load(name)
+	if loadErr != nil {
+		return status.Errorf(codes.Aborted, "device type '%s' was modified concurrently", name)
+	} else if current == nil {
+		return status.Errorf(codes.NotFound, "device type '%s' not found", name)
+	}
+	return deviceapi.NewRevisionConflict("device type", string(name), revision, current.Revision)
+}
@@ -0,0 +1,149 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateDeviceType(t *testing.T) {
+	timeout := -time.Second
+	invalid := []*deviceapi.DeviceType{
+		{},
+		{Name: "a b"},
+		{Name: "Stratum", Versions: "1.x.2"},
+		{Name: "Stratum", Attributes: []*deviceapi.AttributeSchema{{}}},
+		{Name: "Stratum", Attributes: []*deviceapi.AttributeSchema{{Name: "pipeconf"}, {Name: "pipeconf"}}},
+		{Name: "Stratum", Attributes: []*deviceapi.AttributeSchema{{Name: "pipeconf", Pattern: "("}}},
+		{Name: "Stratum", Attributes: []*deviceapi.AttributeSchema{{Name: "pipeconf", Type: 10}}},
+		{Name: "Stratum", Protocols: []deviceapi.Protocol{deviceapi.Protocol_UNKNOWN_PROTOCOL}},
+		{Name: "Stratum", Timeout: &timeout},
+	}
+	for _, deviceType := range invalid {
+		err := ValidateDeviceType(deviceType)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", deviceType)
+	}
+
+	assert.NoError(t, ValidateDeviceType(&deviceapi.DeviceType{
+		Name:     "Stratum",
+		Versions: ">=1.0.0 <2.0.0",
+		Attributes: []*deviceapi.AttributeSchema{
+			{Name: "pipeconf", Required: true, Pattern: "[a-z.]+"},
+		},
+		Protocols: []deviceapi.Protocol{deviceapi.Protocol_P4RUNTIME},
+	}))
+}
+
+func TestAdmitDevice(t *testing.T) {
+	store, err := NewLocalStore()
+	assert.NoError(t, err)
+	defer store.Close()
+	typeStore, err := NewLocalTypeStore()
+	assert.NoError(t, err)
+	defer typeStore.Close()
+
+	typeServer := NewTypeServer(typeStore, store)
+	server := NewServer(store, NewTypePlugin(typeStore, TypePolicyReject))
+
+	typeTimeout := 10 * time.Second
+	addTypeResponse, err := typeServer.Add(context.Background(), &deviceapi.AddDeviceTypeRequest{
+		DeviceType: &deviceapi.DeviceType{
+			Name:     "Stratum",
+			Versions: "1.x",
+			Attributes: []*deviceapi.AttributeSchema{
+				{Name: "pipeconf", Required: true, Pattern: "[a-z.]+"},
+				{Name: "deviceId", Type: deviceapi.AttributeType_INTEGER},
+			},
+			Protocols: []deviceapi.Protocol{deviceapi.Protocol_P4RUNTIME, deviceapi.Protocol_GNMI},
+			Timeout:   &typeTimeout,
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, deviceapi.Revision(0), addTypeResponse.DeviceType.Revision)
+
+	_, err = typeServer.Add(context.Background(), &deviceapi.AddDeviceTypeRequest{
+		DeviceType: &deviceapi.DeviceType{Name: "Stratum"},
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	newDevice := func(version string, attributes map[string]string) *deviceapi.Device {
+		return &deviceapi.Device{
+			ID:         "stratum-1",
+			Type:       "Stratum",
+			Address:    "stratum-1:9559",
+			Version:    version,
+			Attributes: attributes,
+		}
+	}
+
+	invalid := []*deviceapi.Device{
+		newDevice("1.0.0", nil),
+		newDevice("2.0.0", map[string]string{"pipeconf": "org.onosproject.fabric"}),
+		newDevice("1.0.0", map[string]string{"pipeconf": "Fabric"}),
+		newDevice("1.0.0", map[string]string{"pipeconf": "fabric", "deviceId": "one"}),
+	}
+	for _, device := range invalid {
+		_, err := server.Add(context.Background(), &deviceapi.AddRequest{Device: device})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", device)
+	}
+
+	addResponse, err := server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: newDevice("1.2.0", map[string]string{"pipeconf": "org.onosproject.fabric", "deviceId": "1"}),
+	})
+	assert.NoError(t, err)
+	device := addResponse.Device
+	assert.Equal(t, typeTimeout, *device.Timeout)
+	assert.Len(t, device.Protocols, 2)
+	assert.Equal(t, deviceapi.Protocol_P4RUNTIME, device.Protocols[0].Protocol)
+	assert.Equal(t, deviceapi.Protocol_GNMI, device.Protocols[1].Protocol)
+
+	// Devices of unknown types are rejected by policy
+	_, err = server.Add(context.Background(), &deviceapi.AddRequest{
+		Device: &deviceapi.Device{
+			ID:      "devicesim-1",
+			Type:    "Devicesim",
+			Address: "devicesim-1:10161",
+			Version: "1.0.0",
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Types cannot be removed while in use
+	_, err = typeServer.Remove(context.Background(), &deviceapi.RemoveDeviceTypeRequest{
+		DeviceType: &deviceapi.DeviceType{Name: "Stratum"},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.Remove(context.Background(), &deviceapi.RemoveRequest{Device: device})
+	assert.NoError(t, err)
+	_, err = typeServer.Remove(context.Background(), &deviceapi.RemoveDeviceTypeRequest{
+		DeviceType: &deviceapi.DeviceType{Name: "Stratum"},
+	})
+	assert.NoError(t, err)
+
+	listResponse, err := typeServer.List(context.Background(), &deviceapi.ListDeviceTypesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, listResponse.DeviceTypes, 0)
+
+	_, err = typeServer.Get(context.Background(), &deviceapi.GetDeviceTypeRequest{Name: "Stratum"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
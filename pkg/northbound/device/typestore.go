@@ -0,0 +1,184 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package device
+
+import (
+	"context"
+	"github.com/atomix/atomix-go-client/pkg/client/map"
+	"github.com/atomix/atomix-go-client/pkg/client/primitive"
+	"github.com/atomix/atomix-go-client/pkg/client/session"
+	"github.com/gogo/protobuf/proto"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/pkg/metrics"
+	"github.com/onosproject/onos-topo/pkg/util"
+	"google.golang.org/grpc"
+	"io"
+	"time"
+)
+
+// NewAtomixTypeStore returns a new persistent TypeStore
+func NewAtomixTypeStore() (TypeStore, error) {
+	client, err := util.GetAtomixClient()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := client.GetGroup(context.Background(), util.GetAtomixRaftGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := group.GetMap(context.Background(), "device-types", session.WithTimeout(SessionTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomixTypeStore{
+		types:  types,
+		closer: types,
+	}, nil
+}
+
+// NewLocalTypeStore returns a new local device type store
+func NewLocalTypeStore() (TypeStore, error) {
+	node, conn := util.StartLocalNode()
+	name := primitive.Name{
+		Namespace: "local",
+		Name:      "device-types",
+	}
+
+	types, err := _map.New(context.Background(), name, []*grpc.ClientConn{conn})
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomixTypeStore{
+		types:  types,
+		closer: util.NewNodeCloser(node),
+	}, nil
+}
+
+// TypeStore stores the catalog of device types
+type TypeStore interface {
+	io.Closer
+
+	// Load loads a device type from the store
+	Load(name deviceapi.Type) (*deviceapi.DeviceType, error)
+
+	// Store stores a device type in the store
+	Store(*deviceapi.DeviceType) error
+
+	// Delete deletes a device type from the store
+	Delete(*deviceapi.DeviceType) error
+
+	// List streams device types to the given channel
+	List(chan<- *deviceapi.DeviceType) error
+}
+
+// atomixTypeStore is the device type implementation of the TypeStore
+type atomixTypeStore struct {
+	types  _map.Map
+	closer io.Closer
+}
+
+// observeType records metrics for a type store operation started at the given time
+func observeType(operation string, start time.Time, err error) {
+	metrics.ObserveStoreOperation("device-types", operation, start, err, IsConflict(err))
+}
+
+func (s *atomixTypeStore) Load(name deviceapi.Type) (_ *deviceapi.DeviceType, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observeType("load", start, err) }(time.Now())
+
+	entry, err := s.types.Get(ctx, string(name))
+	if err != nil {
+		return nil, err
+	} else if entry == nil {
+		return nil, nil
+	}
+	return decodeDeviceType(entry)
+}
+
+func (s *atomixTypeStore) Store(deviceType *deviceapi.DeviceType) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observeType("store", start, err) }(time.Now())
+
+	bytes, err := proto.Marshal(deviceType)
+	if err != nil {
+		return err
+	}
+
+	// Put the type in the map using an optimistic lock if this is an update
+	var entry *_map.Entry
+	if deviceType.Revision == 0 {
+		entry, err = s.types.Put(ctx, string(deviceType.Name), bytes)
+	} else {
+		entry, err = s.types.Put(ctx, string(deviceType.Name), bytes, _map.IfVersion(int64(deviceType.Revision)))
+	}
+	if err != nil {
+		return err
+	}
+
+	deviceType.Revision = deviceapi.Revision(entry.Version)
+	return nil
+}
+
+func (s *atomixTypeStore) Delete(deviceType *deviceapi.DeviceType) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observeType("delete", start, err) }(time.Now())
+
+	if deviceType.Revision > 0 {
+		_, err = s.types.Remove(ctx, string(deviceType.Name), _map.IfVersion(int64(deviceType.Revision)))
+		return err
+	}
+	_, err = s.types.Remove(ctx, string(deviceType.Name))
+	return err
+}
+
+func (s *atomixTypeStore) List(ch chan<- *deviceapi.DeviceType) (err error) {
+	defer func(start time.Time) { observeType("list", start, err) }(time.Now())
+	mapCh := make(chan *_map.Entry)
+	if err := s.types.Entries(context.Background(), mapCh); err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(ch)
+		for entry := range mapCh {
+			if deviceType, err := decodeDeviceType(entry); err == nil {
+				ch <- deviceType
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixTypeStore) Close() error {
+	_ = s.types.Close()
+	return s.closer.Close()
+}
+
+func decodeDeviceType(entry *_map.Entry) (*deviceapi.DeviceType, error) {
+	deviceType := &deviceapi.DeviceType{}
+	if err := proto.Unmarshal(entry.Value, deviceType); err != nil {
+		return nil, err
+	}
+	deviceType.Name = deviceapi.Type(entry.Key)
+	deviceType.Revision = deviceapi.Revision(entry.Version)
+	return deviceType, nil
+}
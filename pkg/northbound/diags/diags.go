@@ -16,21 +16,282 @@
 package diags
 
 import (
+	"context"
+	"sort"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
 	"github.com/onosproject/onos-topo/api/diags"
+	"github.com/onosproject/onos-topo/pkg/manager"
+	"github.com/onosproject/onos-topo/pkg/metrics"
 	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/onosproject/onos-topo/pkg/version"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// probeTimeout is the time after which a store probe is considered failed
+const probeTimeout = 5 * time.Second
+
+// SubscriberLister lists the active device List subscribers
+type SubscriberLister interface {
+	// Subscribers returns the active device List subscribers
+	Subscribers() []*diags.Subscriber
+}
+
+// NewService returns a new diagnostics Service describing the given store backend, device store,
+// device List subscribers and leader elector. The elector may be nil if leader election is not running.
+func NewService(store diags.StoreInfo, deviceStore device.Store, subscribers SubscriberLister, elector *manager.Elector) northbound.Service {
+	return Service{
+		store:       store,
+		deviceStore: deviceStore,
+		subscribers: subscribers,
+		elector:     elector,
+	}
+}
+
 // Service is a Service implementation for administration.
 type Service struct {
 	northbound.Service
+	store       diags.StoreInfo
+	deviceStore device.Store
+	subscribers SubscriberLister
+	elector     *manager.Elector
 }
 
 // Register registers the Service with the gRPC server.
 func (s Service) Register(r *grpc.Server) {
-	diags.RegisterTopoDiagsServer(r, Server{})
+	diags.RegisterTopoDiagsServer(r, &Server{
+		server:      r,
+		store:       s.store,
+		deviceStore: s.deviceStore,
+		subscribers: s.subscribers,
+		elector:     s.elector,
+	})
 }
 
 // Server implements the gRPC service for diagnostic facilities.
 type Server struct {
+	server      *grpc.Server
+	store       diags.StoreInfo
+	deviceStore device.Store
+	subscribers SubscriberLister
+	elector     *manager.Elector
+}
+
+// GetServiceInfo returns the version, build information, enabled services and store backend of the service
+func (s *Server) GetServiceInfo(ctx context.Context, request *diags.GetServiceInfoRequest) (*diags.GetServiceInfoResponse, error) {
+	info := s.server.GetServiceInfo()
+	services := make([]string, 0, len(info))
+	for name := range info {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+
+	return &diags.GetServiceInfoResponse{
+		Version:    version.Version,
+		ApiVersion: version.APIVersion,
+		Build: diags.BuildInfo{
+			Commit:    version.Commit,
+			Date:      version.BuildDate,
+			GoVersion: version.GoVersion(),
+		},
+		Services: services,
+		Store:    s.store,
+	}, nil
+}
+
+// GetLeadership returns the leadership state of the onos-topo replicas
+func (s *Server) GetLeadership(ctx context.Context, request *diags.GetLeadershipRequest) (*diags.GetLeadershipResponse, error) {
+	if s.elector == nil {
+		return nil, status.Error(codes.Unavailable, "leader election is not running")
+	}
+	leadership := s.elector.Leadership()
+	return &diags.GetLeadershipResponse{
+		ID:          leadership.ID,
+		Term:        leadership.Term,
+		Leader:      leadership.Leader,
+		Candidates:  leadership.Candidates,
+		IsLeader:    leadership.IsLeader(),
+		Partitioned: leadership.Partitioned,
+	}, nil
+}
+
+// GetStoreStatus probes the store backend and returns its health and the status of its session and primitives
+func (s *Server) GetStoreStatus(ctx context.Context, request *diags.GetStoreStatusRequest) (*diags.GetStoreStatusResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := s.probe(ctx)
+	response := &diags.GetStoreStatusResponse{
+		Store:        s.store,
+		Healthy:      err == nil,
+		ProbeLatency: time.Since(start),
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	var lastActive time.Time
+	for _, storeStatus := range metrics.StoreStatuses() {
+		primitive := &diags.PrimitiveStatus{
+			Name:       storeStatus.Store,
+			Operations: storeStatus.Operations,
+			Errors:     storeStatus.Errors,
+			Conflicts:  storeStatus.Conflicts,
+			LastError:  storeStatus.LastError,
+		}
+		if !storeStatus.LastSuccess.IsZero() {
+			lastSuccess := storeStatus.LastSuccess
+			primitive.LastSuccess = &lastSuccess
+			if lastSuccess.After(lastActive) {
+				lastActive = lastSuccess
+			}
+		}
+		if !storeStatus.LastFailure.IsZero() {
+			lastFailure := storeStatus.LastFailure
+			primitive.LastFailure = &lastFailure
+		}
+		response.Primitives = append(response.Primitives, primitive)
+	}
+
+	if !lastActive.IsZero() {
+		response.Session.LastActive = &lastActive
+	}
+	response.Session.State = sessionState(err == nil, lastActive, s.store.SessionTimeout)
+	return response, nil
+}
+
+// sessionState returns the state of the store session given the outcome of a probe and the time
+// at which the store last responded
+func sessionState(healthy bool, lastActive time.Time, timeout time.Duration) diags.SessionState {
+	switch {
+	case healthy:
+		return diags.SessionState_ACTIVE
+	case lastActive.IsZero():
+		return diags.SessionState_UNKNOWN_SESSION_STATE
+	case time.Since(lastActive) <= timeout:
+		return diags.SessionState_SUSPENDED
+	default:
+		return diags.SessionState_EXPIRED
+	}
+}
+
+// probe lists the devices to check the store responds before the context is done
+func (s *Server) probe(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		ch := make(chan *deviceapi.Device)
+		if err := s.deviceStore.List(ch); err != nil {
+			errCh <- err
+			return
+		}
+		for range ch {
+		}
+		errCh <- nil
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// GetDeviceCounts returns the number of devices of the request namespace, or of all namespaces, by
+// namespace, type, role and state
+func (s *Server) GetDeviceCounts(ctx context.Context, request *diags.GetDeviceCountsRequest) (*diags.GetDeviceCountsResponse, error) {
+	namespace, err := northbound.RequestNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *deviceapi.Device)
+	if err := s.deviceStore.List(ch); err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		namespace      string
+		deviceType     deviceapi.Type
+		role           deviceapi.Role
+		connectivity   deviceapi.ConnectivityState
+		lifecycleState deviceapi.LifecycleState
+	}
+	counts := make(map[key]uint64)
+	response := &diags.GetDeviceCountsResponse{}
+	for d := range ch {
+		if namespace != northbound.AllNamespaces && d.Namespace != namespace {
+			continue
+		}
+		counts[key{d.Namespace, d.Type, d.Role, d.GetConnectivityState(), d.LifecycleState}]++
+		response.Total++
+	}
+
+	for k, count := range counts {
+		response.Counts = append(response.Counts, &diags.DeviceCount{
+			Namespace:         k.namespace,
+			Type:              k.deviceType,
+			Role:              k.role,
+			ConnectivityState: k.connectivity,
+			LifecycleState:    k.lifecycleState,
+			Count:             count,
+		})
+	}
+	sort.Slice(response.Counts, func(i, j int) bool {
+		ci, cj := response.Counts[i], response.Counts[j]
+		if ci.Namespace != cj.Namespace {
+			return ci.Namespace < cj.Namespace
+		}
+		if ci.Type != cj.Type {
+			return ci.Type < cj.Type
+		}
+		if ci.Role != cj.Role {
+			return ci.Role < cj.Role
+		}
+		if ci.ConnectivityState != cj.ConnectivityState {
+			return ci.ConnectivityState < cj.ConnectivityState
+		}
+		return ci.LifecycleState < cj.LifecycleState
+	})
+	return response, nil
+}
+
+// ListSubscribers returns the active device List subscribers of the serving replica. Subscribers of all
+// namespaces are listed, so ListSubscribers is restricted to administrators.
+func (s *Server) ListSubscribers(ctx context.Context, request *diags.ListSubscribersRequest) (*diags.ListSubscribersResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return &diags.ListSubscribersResponse{
+		Subscribers: s.subscribers.Subscribers(),
+	}, nil
+}
+
+// GetLatency returns RPC latency summaries of the serving replica. The summaries cover the requests of
+// all namespaces, so GetLatency is restricted to administrators.
+func (s *Server) GetLatency(ctx context.Context, request *diags.GetLatencyRequest) (*diags.GetLatencyResponse, error) {
+	if err := northbound.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	summaries := metrics.Latencies()
+	response := &diags.GetLatencyResponse{
+		Methods: make([]*diags.MethodLatency, 0, len(summaries)),
+	}
+	for _, summary := range summaries {
+		response.Methods = append(response.Methods, &diags.MethodLatency{
+			Service: summary.Service,
+			Method:  summary.Method,
+			Count:   summary.Count,
+			Errors:  summary.Errors,
+			Mean:    summary.Mean,
+			P50:     summary.P50,
+			P90:     summary.P90,
+			P99:     summary.P99,
+			Max:     summary.Max,
+		})
+	}
+	return response, nil
 }
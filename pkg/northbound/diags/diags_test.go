@@ -0,0 +1,272 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diags
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	"github.com/onosproject/onos-topo/api/diags"
+	"github.com/onosproject/onos-topo/pkg/manager"
+	"github.com/onosproject/onos-topo/pkg/metrics"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/onosproject/onos-topo/pkg/version"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testServer struct {
+	client      diags.TopoDiagsClient
+	conn        *grpc.ClientConn
+	deviceStore device.Store
+	close       func()
+}
+
+func newTestServer(t *testing.T, elector *manager.Elector) *testServer {
+	deviceStore, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	deviceServer := device.NewServer(deviceStore)
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.UnaryInterceptor(metrics.UnaryServerInterceptor()))
+	deviceapi.RegisterDeviceServiceServer(s, deviceServer)
+	NewService(diags.StoreInfo{
+		Type:           "atomix",
+		Group:          "onos-topo-raft",
+		SessionTimeout: device.SessionTimeout,
+	}, deviceStore, deviceServer, elector).Register(s)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+	return &testServer{
+		client:      diags.NewTopoDiagsClient(conn),
+		conn:        conn,
+		deviceStore: deviceStore,
+		close: func() {
+			conn.Close()
+			s.Stop()
+			deviceStore.Close()
+		},
+	}
+}
+
+func TestGetServiceInfo(t *testing.T) {
+	server := newTestServer(t, nil)
+	defer server.close()
+	client := server.client
+
+	response, err := client.GetServiceInfo(context.Background(), &diags.GetServiceInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, version.Version, response.Version)
+	assert.Equal(t, version.APIVersion, response.ApiVersion)
+	assert.NotEmpty(t, response.Build.GoVersion)
+	assert.Equal(t, []string{"topo.device.DeviceService", "topo.diags.TopoDiags"}, response.Services)
+	assert.Equal(t, "onos-topo-raft", response.Store.Group)
+}
+
+func TestGetLeadership(t *testing.T) {
+	server := newTestServer(t, nil)
+	_, err := server.client.GetLeadership(context.Background(), &diags.GetLeadershipRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	server.close()
+
+	elector, err := manager.NewLocalElector("onos-topo-1", false)
+	assert.NoError(t, err)
+	assert.NoError(t, elector.Start())
+	defer elector.Close()
+	server = newTestServer(t, elector)
+	defer server.close()
+	client := server.client
+
+	response, err := client.GetLeadership(context.Background(), &diags.GetLeadershipRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "onos-topo-1", response.ID)
+	assert.Equal(t, "onos-topo-1", response.Leader)
+	assert.Equal(t, []string{"onos-topo-1"}, response.Candidates)
+	assert.True(t, response.IsLeader)
+	assert.False(t, response.Partitioned)
+}
+
+func TestGetStoreStatus(t *testing.T) {
+	server := newTestServer(t, nil)
+	defer server.close()
+
+	assert.NoError(t, server.deviceStore.Store(&deviceapi.Device{ID: "device-1", Type: "Stratum"}))
+
+	response, err := server.client.GetStoreStatus(context.Background(), &diags.GetStoreStatusRequest{})
+	assert.NoError(t, err)
+	assert.True(t, response.Healthy)
+	assert.Equal(t, device.SessionTimeout, response.Store.SessionTimeout)
+	assert.Equal(t, diags.SessionState_ACTIVE, response.Session.State)
+	assert.NotNil(t, response.Session.LastActive)
+
+	var devices *diags.PrimitiveStatus
+	for _, primitive := range response.Primitives {
+		if primitive.Name == "devices" {
+			devices = primitive
+		}
+	}
+	assert.NotNil(t, devices)
+	assert.True(t, devices.Operations >= 2)
+	assert.NotNil(t, devices.LastSuccess)
+}
+
+func TestSessionState(t *testing.T) {
+	assert.Equal(t, diags.SessionState_ACTIVE, sessionState(true, time.Time{}, time.Minute))
+	assert.Equal(t, diags.SessionState_UNKNOWN_SESSION_STATE, sessionState(false, time.Time{}, time.Minute))
+	assert.Equal(t, diags.SessionState_SUSPENDED, sessionState(false, time.Now().Add(-time.Second), time.Minute))
+	assert.Equal(t, diags.SessionState_EXPIRED, sessionState(false, time.Now().Add(-2*time.Minute), time.Minute))
+}
+
+func TestGetDeviceCounts(t *testing.T) {
+	server := newTestServer(t, nil)
+	defer server.close()
+
+	devices := []*deviceapi.Device{
+		{ID: "leaf-1", Type: "Stratum", Role: "leaf"},
+		{ID: "leaf-2", Type: "Stratum", Role: "leaf"},
+		{
+			ID:   "leaf-3",
+			Type: "Stratum",
+			Role: "leaf",
+			Protocols: []*deviceapi.ProtocolState{
+				{Protocol: deviceapi.Protocol_GNMI, ConnectivityState: deviceapi.ConnectivityState_REACHABLE},
+			},
+		},
+		{ID: "spine-1", Type: "Stratum", Role: "spine", LifecycleState: deviceapi.LifecycleState_MAINTENANCE},
+	}
+	for _, d := range devices {
+		assert.NoError(t, server.deviceStore.Store(d))
+	}
+
+	response, err := server.client.GetDeviceCounts(context.Background(), &diags.GetDeviceCountsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), response.Total)
+	assert.Len(t, response.Counts, 3)
+	assert.Equal(t, deviceapi.Role("leaf"), response.Counts[0].Role)
+	assert.Equal(t, deviceapi.ConnectivityState_UNKNOWN_CONNECTIVITY_STATE, response.Counts[0].ConnectivityState)
+	assert.Equal(t, uint64(2), response.Counts[0].Count)
+	assert.Equal(t, deviceapi.ConnectivityState_REACHABLE, response.Counts[1].ConnectivityState)
+	assert.Equal(t, deviceapi.LifecycleState_MAINTENANCE, response.Counts[2].LifecycleState)
+}
+
+func TestListSubscribers(t *testing.T) {
+	server := newTestServer(t, nil)
+	defer server.close()
+
+	assert.NoError(t, server.deviceStore.Store(&deviceapi.Device{ID: "device-1", Type: "Stratum", Version: "1.0.0"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := deviceapi.NewDeviceServiceClient(server.conn).List(ctx, &deviceapi.ListRequest{
+		Subscribe: true,
+		Versions:  "1.x",
+	})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	var subscribers []*diags.Subscriber
+	for i := 0; i < 50 && len(subscribers) == 0; i++ {
+		response, err := server.client.ListSubscribers(context.Background(), &diags.ListSubscribersRequest{})
+		assert.NoError(t, err)
+		subscribers = response.Subscribers
+		if len(subscribers) == 0 || subscribers[0].EventsSent == 0 {
+			subscribers = nil
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	assert.Len(t, subscribers, 1)
+	subscriber := subscribers[0]
+	assert.Equal(t, "1.x", subscriber.Filter.Versions)
+	assert.Contains(t, subscriber.Client, "bufconn")
+	assert.Equal(t, uint64(1), subscriber.EventsSent)
+	assert.NotNil(t, subscriber.LastEvent)
+	assert.True(t, subscriber.QueueCapacity > 0)
+
+	cancel()
+	for i := 0; i < 50; i++ {
+		response, err := server.client.ListSubscribers(context.Background(), &diags.ListSubscribersRequest{})
+		assert.NoError(t, err)
+		if len(response.Subscribers) == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("subscriber was not removed")
+}
+
+func TestGetLatency(t *testing.T) {
+	server := newTestServer(t, nil)
+	defer server.close()
+
+	_, err := server.client.GetServiceInfo(context.Background(), &diags.GetServiceInfoRequest{})
+	assert.NoError(t, err)
+
+	response, err := server.client.GetLatency(context.Background(), &diags.GetLatencyRequest{})
+	assert.NoError(t, err)
+	for _, method := range response.Methods {
+		if method.Service == "topo.diags.TopoDiags" && method.Method == "GetServiceInfo" {
+			assert.True(t, method.Count >= 1)
+			assert.True(t, method.Max >= method.P50)
+			return
+		}
+	}
+	t.Fatal("GetServiceInfo latency not found")
+}
+
+func TestRestrictedToAdmins(t *testing.T) {
+	deviceStore, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	defer deviceStore.Close()
+
+	// Clients without a certificate are not administrators once administrators are configured
+	config := northbound.NamespaceConfig{Admins: []string{"onos"}}
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(grpc.UnaryInterceptor(northbound.NamespaceUnaryServerInterceptor(config)))
+	NewService(diags.StoreInfo{}, deviceStore, device.NewServer(deviceStore), nil).Register(s)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	defer s.Stop()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := diags.NewTopoDiagsClient(conn)
+
+	_, err = client.GetDeviceCounts(context.Background(), &diags.GetDeviceCountsRequest{})
+	assert.NoError(t, err)
+	_, err = client.ListSubscribers(context.Background(), &diags.ListSubscribersRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.GetLatency(context.Background(), &diags.GetLatencyRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,300 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+const linkIDPattern = `^[a-zA-Z0-9\-:_/.]{4,100}$`
+
+var linkIDRegex = regexp.MustCompile(linkIDPattern)
+
+// NewLinkServer returns a new link Server backed by the given link store. The devices at either end of
+// a link must exist in the given device store when the link is added or updated.
+func NewLinkServer(linkStore LinkStore, deviceStore device.Store) *LinkServer {
+	return &LinkServer{
+		linkStore:   linkStore,
+		deviceStore: deviceStore,
+		drainCh:     make(chan struct{}),
+	}
+}
+
+// LinkServer implements the gRPC service for managing links
+type LinkServer struct {
+	linkStore   LinkStore
+	deviceStore device.Store
+	modeStore   device.ModeStore
+	drainCh     chan struct{}
+	drainOnce   sync.Once
+}
+
+// Drain closes all open link subscriptions with an Unavailable status
+func (s *LinkServer) Drain() {
+	s.drainOnce.Do(func() {
+		close(s.drainCh)
+	})
+}
+
+// ValidateLink validates the fields of the given link
+func ValidateLink(link *graphapi.Link) error {
+	if link.ID == "" {
+		return status.Error(codes.InvalidArgument, "link ID is required")
+	}
+	if !linkIDRegex.MatchString(string(link.ID)) {
+		return status.Errorf(codes.InvalidArgument, "link ID '%s' is invalid", link.ID)
+	}
+	if link.Namespace != "" {
+		if err := northbound.ValidateNamespace(link.Namespace); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if link.Source == "" {
+		return status.Error(codes.InvalidArgument, "link source is required")
+	}
+	if link.Target == "" {
+		return status.Error(codes.InvalidArgument, "link target is required")
+	}
+	if link.Source == link.Target {
+		return status.Errorf(codes.InvalidArgument, "link '%s' cannot connect device '%s' to itself", link.ID, link.Source)
+	}
+	return nil
+}
+
+// setNamespace sets the namespace of the given link to the namespace of the request of the given
+// context, failing if the link names another namespace
+func setNamespace(ctx context.Context, link *graphapi.Link) error {
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return err
+	} else if link.Namespace != "" && link.Namespace != namespace {
+		return status.Errorf(codes.InvalidArgument, "link namespace '%s' does not match request namespace '%s'", link.Namespace, namespace)
+	}
+	link.Namespace = namespace
+	return nil
+}
+
+// checkDevices returns a FailedPrecondition error if a device at either end of the given link does not
+// exist in the namespace of the link
+func (s *LinkServer) checkDevices(link *graphapi.Link) error {
+	for _, id := range []deviceapi.ID{link.Source, link.Target} {
+		d, err := s.deviceStore.Load(link.Namespace, id)
+		if err != nil {
+			return err
+		} else if d == nil {
+			return status.Errorf(codes.FailedPrecondition, "device '%s' not found", id)
+		}
+	}
+	return nil
+}
+
+// Add adds a link between two devices
+func (s *LinkServer) Add(ctx context.Context, request *graphapi.AddLinkRequest) (*graphapi.AddLinkResponse, error) {
+	if err := device.CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
+	link := request.Link
+	if link == nil {
+		return nil, status.Error(codes.InvalidArgument, "no link specified")
+	} else if link.Revision > 0 {
+		return nil, status.Error(codes.InvalidArgument, "link revision is already set")
+	}
+	if err := setNamespace(ctx, link); err != nil {
+		return nil, err
+	}
+	if err := ValidateLink(link); err != nil {
+		return nil, err
+	}
+	if err := s.checkDevices(link); err != nil {
+		return nil, err
+	}
+	if err := s.linkStore.Store(link); err != nil {
+		return nil, err
+	}
+	return &graphapi.AddLinkResponse{
+		Link: link,
+	}, nil
+}
+
+// Update updates a link
+func (s *LinkServer) Update(ctx context.Context, request *graphapi.UpdateLinkRequest) (*graphapi.UpdateLinkResponse, error) {
+	if err := device.CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
+	link := request.Link
+	if link == nil {
+		return nil, status.Error(codes.InvalidArgument, "no link specified")
+	} else if link.Revision == 0 {
+		return nil, status.Error(codes.InvalidArgument, "link revision not set")
+	}
+	if err := setNamespace(ctx, link); err != nil {
+		return nil, err
+	}
+	if err := ValidateLink(link); err != nil {
+		return nil, err
+	}
+	if err := s.checkDevices(link); err != nil {
+		return nil, err
+	}
+	revision := link.Revision
+	if err := s.linkStore.Store(link); err != nil {
+		return nil, s.storeError(link.Namespace, link.ID, revision, err)
+	}
+	log.Infof("Updated Link %s", link.ID)
+	return &graphapi.UpdateLinkResponse{
+		Link: link,
+	}, nil
+}
+
+// Get gets a link by ID
+func (s *LinkServer) Get(ctx context.Context, request *graphapi.GetLinkRequest) (*graphapi.GetLinkResponse, error) {
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.linkStore.Load(namespace, request.ID)
+	if err != nil {
+		return nil, err
+	} else if link == nil {
+		return nil, status.Error(codes.NotFound, "link not found")
+	}
+	return &graphapi.GetLinkResponse{
+		Link: link,
+	}, nil
+}
+
+// List streams the links of the request namespace and, if requested, the changes made to them
+func (s *LinkServer) List(request *graphapi.ListLinksRequest, server graphapi.LinkService_ListServer) error {
+	namespace, err := northbound.RequestNamespace(server.Context())
+	if err != nil {
+		return err
+	}
+	inNamespace := func(link *graphapi.Link) bool {
+		return namespace == northbound.AllNamespaces || link.Namespace == namespace
+	}
+
+	if !request.Subscribe {
+		ch := make(chan *graphapi.Link)
+		if err := s.linkStore.List(ch); err != nil {
+			return err
+		}
+		for link := range ch {
+			if !inNamespace(link) {
+				continue
+			}
+			err := server.Send(&graphapi.ListLinksResponse{
+				Type: graphapi.ListLinksResponse_NONE,
+				Link: link,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+
+	ch := make(chan *Event)
+	if err := s.linkStore.Watch(ctx, ch); err != nil {
+		return err
+	}
+
+	for {
+		var event *Event
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			event = e
+		case <-s.drainCh:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ctx.Done():
+			return nil
+		}
+		if !inNamespace(event.Link) {
+			continue
+		}
+
+		var t graphapi.ListLinksResponse_Type
+		switch event.Type {
+		case device.EventNone:
+			t = graphapi.ListLinksResponse_NONE
+		case device.EventInserted:
+			t = graphapi.ListLinksResponse_ADDED
+		case device.EventUpdated:
+			t = graphapi.ListLinksResponse_UPDATED
+		case device.EventRemoved:
+			t = graphapi.ListLinksResponse_REMOVED
+		}
+		err := server.Send(&graphapi.ListLinksResponse{
+			Type: t,
+			Link: event.Link,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Remove removes a link
+func (s *LinkServer) Remove(ctx context.Context, request *graphapi.RemoveLinkRequest) (*graphapi.RemoveLinkResponse, error) {
+	if err := device.CheckWritable(s.modeStore); err != nil {
+		return nil, err
+	}
+	link := request.Link
+	if link == nil {
+		return nil, status.Error(codes.InvalidArgument, "no link specified")
+	}
+	if err := setNamespace(ctx, link); err != nil {
+		return nil, err
+	}
+	if stored, err := s.linkStore.Load(link.Namespace, link.ID); err != nil {
+		return nil, err
+	} else if stored == nil {
+		return nil, status.Errorf(codes.NotFound, "link '%s' not found", link.ID)
+	}
+	if err := s.linkStore.Delete(link); err != nil {
+		return nil, s.storeError(link.Namespace, link.ID, link.Revision, err)
+	}
+	return &graphapi.RemoveLinkResponse{}, nil
+}
+
+// storeError maps an optimistic lock failure when writing the given revision of a link to a NotFound
+// error if the link has been removed, or to an Aborted error carrying the current revision of the link
+func (s *LinkServer) storeError(namespace string, id graphapi.ID, revision deviceapi.Revision, err error) error {
+	if !device.IsConflict(err) {
+		return err
+	}
+	current, loadErr := s.linkStore.Load(namespace, id)
+	if loadErr != nil {
+		return status.Errorf(codes.Aborted, "link '%s' was modified concurrently", id)
+	} else if current == nil {
+		return status.Errorf(codes.NotFound, "link '%s' not found", id)
+	}
+	return deviceapi.NewRevisionConflict("link", string(id), revision, current.Revision)
+}
@@ -0,0 +1,228 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/atomix/atomix-go-client/pkg/client/map"
+	"github.com/atomix/atomix-go-client/pkg/client/primitive"
+	"github.com/atomix/atomix-go-client/pkg/client/session"
+	"github.com/gogo/protobuf/proto"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/metrics"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/onosproject/onos-topo/pkg/util"
+	"google.golang.org/grpc"
+)
+
+// NewAtomixLinkStore returns a new persistent LinkStore
+func NewAtomixLinkStore() (LinkStore, error) {
+	client, err := util.GetAtomixClient()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := client.GetGroup(context.Background(), util.GetAtomixRaftGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := group.GetMap(context.Background(), "links", session.WithTimeout(device.SessionTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomixLinkStore{
+		links:  links,
+		closer: links,
+	}, nil
+}
+
+// NewLocalLinkStore returns a new local link store
+func NewLocalLinkStore() (LinkStore, error) {
+	node, conn := util.StartLocalNode()
+	name := primitive.Name{
+		Namespace: "local",
+		Name:      "links",
+	}
+
+	links, err := _map.New(context.Background(), name, []*grpc.ClientConn{conn})
+	if err != nil {
+		return nil, err
+	}
+
+	return &atomixLinkStore{
+		links:  links,
+		closer: util.NewNodeCloser(node),
+	}, nil
+}
+
+// LinkStore stores the links between devices
+type LinkStore interface {
+	io.Closer
+
+	// Load loads a link of the given namespace from the store
+	Load(namespace string, id graphapi.ID) (*graphapi.Link, error)
+
+	// Store stores a link in the store, in the namespace of the link or else the default namespace
+	Store(*graphapi.Link) error
+
+	// Delete deletes a link from the store
+	Delete(*graphapi.Link) error
+
+	// List streams the links of all namespaces to the given channel
+	List(chan<- *graphapi.Link) error
+
+	// Watch streams link events of all namespaces to the given channel until the given context is cancelled
+	Watch(context.Context, chan<- *Event) error
+}
+
+// Event is a store event for a link
+type Event struct {
+	Type device.EventType
+	Link *graphapi.Link
+}
+
+// atomixLinkStore is the Atomix implementation of the LinkStore
+type atomixLinkStore struct {
+	links  _map.Map
+	closer io.Closer
+}
+
+// observe records metrics for a link store operation started at the given time
+func observe(operation string, start time.Time, err error) {
+	metrics.ObserveStoreOperation("links", operation, start, err, device.IsConflict(err))
+}
+
+func (s *atomixLinkStore) Load(namespace string, id graphapi.ID) (_ *graphapi.Link, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observe("load", start, err) }(time.Now())
+
+	entry, err := s.links.Get(ctx, device.NamespacedKey(namespace, string(id)))
+	if err != nil {
+		return nil, err
+	} else if entry == nil {
+		return nil, nil
+	}
+	return decodeLink(entry)
+}
+
+func (s *atomixLinkStore) Store(link *graphapi.Link) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observe("store", start, err) }(time.Now())
+
+	if link.Namespace == "" {
+		link.Namespace = northbound.DefaultNamespace
+	}
+	bytes, err := proto.Marshal(link)
+	if err != nil {
+		return err
+	}
+
+	// Put the link in the map using an optimistic lock if this is an update
+	var entry *_map.Entry
+	key := device.NamespacedKey(link.Namespace, string(link.ID))
+	if link.Revision == 0 {
+		entry, err = s.links.Put(ctx, key, bytes)
+	} else {
+		entry, err = s.links.Put(ctx, key, bytes, _map.IfVersion(int64(link.Revision)))
+	}
+	if err != nil {
+		return err
+	}
+
+	link.Revision = deviceapi.Revision(entry.Version)
+	return nil
+}
+
+func (s *atomixLinkStore) Delete(link *graphapi.Link) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	defer func(start time.Time) { observe("delete", start, err) }(time.Now())
+
+	key := device.NamespacedKey(link.Namespace, string(link.ID))
+	if link.Revision > 0 {
+		_, err = s.links.Remove(ctx, key, _map.IfVersion(int64(link.Revision)))
+		return err
+	}
+	_, err = s.links.Remove(ctx, key)
+	return err
+}
+
+func (s *atomixLinkStore) List(ch chan<- *graphapi.Link) (err error) {
+	defer func(start time.Time) { observe("list", start, err) }(time.Now())
+	mapCh := make(chan *_map.Entry)
+	if err := s.links.Entries(context.Background(), mapCh); err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(ch)
+		for entry := range mapCh {
+			if link, err := decodeLink(entry); err == nil {
+				ch <- link
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixLinkStore) Watch(ctx context.Context, ch chan<- *Event) error {
+	mapCh := make(chan *_map.Event)
+	if err := s.links.Watch(ctx, mapCh, _map.WithReplay()); err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(ch)
+		// Keep draining the map channel after the context is cancelled until the map closes it
+		for event := range mapCh {
+			if link, err := decodeLink(event.Entry); err == nil {
+				select {
+				case ch <- &Event{
+					Type: device.EventType(event.Type),
+					Link: link,
+				}:
+				case <-ctx.Done():
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixLinkStore) Close() error {
+	_ = s.links.Close()
+	return s.closer.Close()
+}
+
+func decodeLink(entry *_map.Entry) (*graphapi.Link, error) {
+	link := &graphapi.Link{}
+	if err := proto.Unmarshal(entry.Value, link); err != nil {
+		return nil, err
+	}
+	namespace, id := device.SplitNamespacedKey(entry.Key)
+	link.Namespace = namespace
+	link.ID = graphapi.ID(id)
+	link.Revision = deviceapi.Revision(entry.Version)
+	return link, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"container/heap"
+	"sort"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+)
+
+// neighbors returns the devices within the given number of hops of the root, or all devices reachable
+// from the root if depth is 0, ordered by hops and then by ID
+func (g graph) neighbors(root deviceapi.ID, depth uint32) []*graphapi.Neighbor {
+	hops := map[deviceapi.ID]uint32{root: 0}
+	frontier := []deviceapi.ID{root}
+	var neighbors []*graphapi.Neighbor
+	for hop := uint32(1); len(frontier) > 0 && (depth == 0 || hop <= depth); hop++ {
+		var next []deviceapi.ID
+		for _, id := range frontier {
+			for _, e := range g[id] {
+				if _, ok := hops[e.to]; ok {
+					continue
+				}
+				hops[e.to] = hop
+				next = append(next, e.to)
+				neighbors = append(neighbors, &graphapi.Neighbor{ID: e.to, Hops: hop})
+			}
+		}
+		frontier = next
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].Hops != neighbors[j].Hops {
+			return neighbors[i].Hops < neighbors[j].Hops
+		}
+		return neighbors[i].ID < neighbors[j].ID
+	})
+	return neighbors
+}
+
+// predecessor is the last hop of a shortest path to a device
+type predecessor struct {
+	from deviceapi.ID
+	link graphapi.ID
+}
+
+// queueItem is a device queued for a visit at a distance from the source
+type queueItem struct {
+	id       deviceapi.ID
+	distance uint64
+}
+
+// queue is a priority queue of devices ordered by distance and then by ID
+type queue []queueItem
+
+func (q queue) Len() int { return len(q) }
+func (q queue) Less(i, j int) bool {
+	if q[i].distance != q[j].distance {
+		return q[i].distance < q[j].distance
+	}
+	return q[i].id < q[j].id
+}
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(queueItem)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// shortestPaths returns up to maxPaths equal cost shortest paths from the source to the target, or no
+// paths if the target cannot be reached. Link weights must be positive.
+func (g graph) shortestPaths(source deviceapi.ID, target deviceapi.ID, maxPaths int) []*graphapi.Path {
+	distances := map[deviceapi.ID]uint64{source: 0}
+	predecessors := make(map[deviceapi.ID][]predecessor)
+	visited := make(map[deviceapi.ID]bool)
+	q := &queue{{id: source}}
+	for q.Len() > 0 {
+		item := heap.Pop(q).(queueItem)
+		if visited[item.id] {
+			continue
+		}
+		visited[item.id] = true
+		if item.id == target {
+			break
+		}
+		for _, e := range g[item.id] {
+			distance := item.distance + e.weight
+			current, ok := distances[e.to]
+			switch {
+			case !ok || distance < current:
+				distances[e.to] = distance
+				predecessors[e.to] = []predecessor{{from: item.id, link: e.link}}
+				heap.Push(q, queueItem{id: e.to, distance: distance})
+			case distance == current:
+				predecessors[e.to] = append(predecessors[e.to], predecessor{from: item.id, link: e.link})
+			}
+		}
+	}
+	if !visited[target] {
+		return nil
+	}
+
+	// Walk the predecessors back from the target to enumerate the paths
+	var paths []*graphapi.Path
+	var devices []deviceapi.ID
+	var links []graphapi.ID
+	var walk func(id deviceapi.ID)
+	walk = func(id deviceapi.ID) {
+		if len(paths) == maxPaths {
+			return
+		}
+		devices = append(devices, id)
+		if id == source {
+			path := &graphapi.Path{
+				Devices: make([]deviceapi.ID, len(devices)),
+				Links:   make([]graphapi.ID, len(links)),
+				Cost:    distances[target],
+			}
+			for i, d := range devices {
+				path.Devices[len(devices)-1-i] = d
+			}
+			for i, l := range links {
+				path.Links[len(links)-1-i] = l
+			}
+			paths = append(paths, path)
+		} else {
+			for _, p := range predecessors[id] {
+				links = append(links, p.link)
+				walk(p.from)
+				links = links[:len(links)-1]
+			}
+		}
+		devices = devices[:len(devices)-1]
+	}
+	walk(target)
+	return paths
+}
+
+// components returns the connected components of the graph ordered by decreasing size and then by
+// their first device. The graph is expected to be undirected.
+func (g graph) components() []*graphapi.Component {
+	ids := make([]deviceapi.ID, 0, len(g))
+	for id := range g {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	visited := make(map[deviceapi.ID]bool)
+	var components []*graphapi.Component
+	for _, id := range ids {
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		devices := []deviceapi.ID{id}
+		for _, neighbor := range g.neighbors(id, 0) {
+			visited[neighbor.ID] = true
+			devices = append(devices, neighbor.ID)
+		}
+		sort.Slice(devices, func(i, j int) bool {
+			return devices[i] < devices[j]
+		})
+		components = append(components, &graphapi.Component{Devices: devices})
+	}
+	sort.SliceStable(components, func(i, j int) bool {
+		return len(components[i].Devices) > len(components[j].Devices)
+	})
+	return components
+}
@@ -0,0 +1,126 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"testing"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestTopology returns a topology of two leaves connected to two spines, a slow direct link between
+// the leaves, a host connected to leaf-1 by a directed link and an isolated device
+func newTestTopology() *Topology {
+	t := newTopology(nil, nil)
+	for _, id := range []deviceapi.ID{"leaf-1", "leaf-2", "spine-1", "spine-2", "host-1", "isolated"} {
+		t.addDevice(&deviceapi.Device{ID: id, Namespace: northbound.DefaultNamespace})
+	}
+	t.addDevice(&deviceapi.Device{ID: "leaf-3", Namespace: "lab-a"})
+	for _, link := range []*graphapi.Link{
+		{ID: "l1-s1", Source: "leaf-1", Target: "spine-1", Attributes: map[string]string{"speed": "100G", "latency": "2"}},
+		{ID: "l1-s2", Source: "leaf-1", Target: "spine-2", Attributes: map[string]string{"speed": "10G", "latency": "1"}},
+		{ID: "l2-s1", Source: "leaf-2", Target: "spine-1", Attributes: map[string]string{"speed": "100G", "latency": "2"}},
+		{ID: "l2-s2", Source: "leaf-2", Target: "spine-2", Attributes: map[string]string{"speed": "10G", "latency": "1"}},
+		{ID: "l1-l2", Source: "leaf-1", Target: "leaf-2", Weight: 5},
+		{ID: "h1-l1", Source: "host-1", Target: "leaf-1", Directed: true},
+		{ID: "dangling", Source: "leaf-1", Target: "missing"},
+		{ID: "l1-l3", Source: "leaf-1", Target: "leaf-3"},
+	} {
+		link.Namespace = northbound.DefaultNamespace
+		t.addLink(link)
+	}
+	return t
+}
+
+func TestNeighbors(t *testing.T) {
+	topology := newTestTopology()
+	g := topology.graph(northbound.DefaultNamespace, nil, "", false)
+
+	neighbors := g.neighbors("leaf-1", 1)
+	assert.Equal(t, []*graphapi.Neighbor{
+		{ID: "leaf-2", Hops: 1},
+		{ID: "spine-1", Hops: 1},
+		{ID: "spine-2", Hops: 1},
+	}, neighbors)
+
+	// The directed link from host-1 cannot be traversed from leaf-1
+	assert.Len(t, g.neighbors("spine-1", 0), 3)
+	assert.Len(t, g.neighbors("host-1", 0), 4)
+	assert.Empty(t, g.neighbors("isolated", 0))
+
+	g = topology.graph(northbound.DefaultNamespace, &graphapi.Constraints{ExcludeDevices: []deviceapi.ID{"spine-1"}, ExcludeLinks: []graphapi.ID{"l1-l2"}}, "", false)
+	assert.Equal(t, []*graphapi.Neighbor{
+		{ID: "spine-2", Hops: 1},
+		{ID: "leaf-2", Hops: 2},
+	}, g.neighbors("leaf-1", 2))
+}
+
+func TestShortestPaths(t *testing.T) {
+	topology := newTestTopology()
+
+	paths := topology.graph(northbound.DefaultNamespace, nil, "", false).shortestPaths("leaf-1", "leaf-2", 10)
+	assert.Len(t, paths, 2)
+	assert.Equal(t, []deviceapi.ID{"leaf-1", "spine-1", "leaf-2"}, paths[0].Devices)
+	assert.Equal(t, []graphapi.ID{"l1-s1", "l2-s1"}, paths[0].Links)
+	assert.Equal(t, uint64(2), paths[0].Cost)
+	assert.Equal(t, []deviceapi.ID{"leaf-1", "spine-2", "leaf-2"}, paths[1].Devices)
+
+	paths = topology.graph(northbound.DefaultNamespace, nil, "", false).shortestPaths("leaf-1", "leaf-2", 1)
+	assert.Len(t, paths, 1)
+
+	// Links are weighted by an attribute, and links without it are not traversed
+	paths = topology.graph(northbound.DefaultNamespace, nil, "latency", false).shortestPaths("leaf-1", "leaf-2", 10)
+	assert.Len(t, paths, 1)
+	assert.Equal(t, []graphapi.ID{"l1-s2", "l2-s2"}, paths[0].Links)
+
+	paths = topology.graph(northbound.DefaultNamespace, &graphapi.Constraints{LinkAttributes: map[string]string{"speed": "100G"}}, "", false).shortestPaths("leaf-1", "leaf-2", 10)
+	assert.Len(t, paths, 1)
+	assert.Equal(t, []deviceapi.ID{"leaf-1", "spine-1", "leaf-2"}, paths[0].Devices)
+
+	paths = topology.graph(northbound.DefaultNamespace, &graphapi.Constraints{ExcludeDevices: []deviceapi.ID{"spine-1", "spine-2"}}, "", false).shortestPaths("leaf-1", "leaf-2", 10)
+	assert.Len(t, paths, 1)
+	assert.Equal(t, []graphapi.ID{"l1-l2"}, paths[0].Links)
+	assert.Equal(t, uint64(5), paths[0].Cost)
+
+	assert.Empty(t, topology.graph(northbound.DefaultNamespace, nil, "", false).shortestPaths("leaf-1", "host-1", 1))
+	assert.Len(t, topology.graph(northbound.DefaultNamespace, nil, "", false).shortestPaths("host-1", "leaf-2", 10), 2)
+	assert.Empty(t, topology.graph(northbound.DefaultNamespace, nil, "", false).shortestPaths("leaf-1", "isolated", 1))
+}
+
+func TestComponents(t *testing.T) {
+	topology := newTestTopology()
+
+	components := topology.graph(northbound.DefaultNamespace, nil, "", true).components()
+	assert.Equal(t, []*graphapi.Component{
+		{Devices: []deviceapi.ID{"host-1", "leaf-1", "leaf-2", "spine-1", "spine-2"}},
+		{Devices: []deviceapi.ID{"isolated"}},
+	}, components)
+
+	components = topology.graph(northbound.DefaultNamespace, &graphapi.Constraints{ExcludeDevices: []deviceapi.ID{"leaf-1"}}, "", true).components()
+	assert.Equal(t, []*graphapi.Component{
+		{Devices: []deviceapi.ID{"leaf-2", "spine-1", "spine-2"}},
+		{Devices: []deviceapi.ID{"host-1"}},
+		{Devices: []deviceapi.ID{"isolated"}},
+	}, components)
+
+	// Links do not connect devices of other namespaces
+	components = topology.graph("lab-a", nil, "", true).components()
+	assert.Equal(t, []*graphapi.Component{
+		{Devices: []deviceapi.ID{"leaf-3"}},
+	}, components)
+}
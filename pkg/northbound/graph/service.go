@@ -0,0 +1,211 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graph implements the northbound services managing the links between devices and answering
+// queries over the graph they form.
+package graph
+
+import (
+	"context"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPaths is the maximum number of equal cost paths returned by a shortest paths query
+const maxPaths = 100
+
+// NewService returns a new graph Service backed by the given link store and answering queries over
+// the devices and links in the device and link stores. Link writes, including the removal of the links
+// of removed devices, are rejected while the mode store reports the topology as read-only. The link
+// store is closed when the Service is closed.
+func NewService(linkStore LinkStore, deviceStore device.Store, modeStore device.ModeStore) (*Service, error) {
+	topology, err := NewTopology(deviceStore, linkStore, modeStore)
+	if err != nil {
+		return nil, err
+	}
+	linkServer := NewLinkServer(linkStore, deviceStore)
+	linkServer.modeStore = modeStore
+	return &Service{
+		linkStore:   linkStore,
+		topology:    topology,
+		linkServer:  linkServer,
+		graphServer: NewGraphServer(topology),
+	}, nil
+}
+
+// Service is a Service implementation for links and graph queries.
+type Service struct {
+	northbound.Service
+	linkStore   LinkStore
+	topology    *Topology
+	linkServer  *LinkServer
+	graphServer *GraphServer
+}
+
+// Register registers the Service with the gRPC server.
+func (s Service) Register(r *grpc.Server) {
+	graphapi.RegisterLinkServiceServer(r, s.linkServer)
+	graphapi.RegisterTopoGraphServiceServer(r, s.graphServer)
+}
+
+// Drain closes all open link subscriptions.
+func (s Service) Drain() {
+	s.linkServer.Drain()
+}
+
+// Close stops the in-memory topology and closes the link store.
+func (s Service) Close() error {
+	_ = s.topology.Close()
+	return s.linkStore.Close()
+}
+
+// NewGraphServer returns a new Server answering graph queries over the given topology
+func NewGraphServer(topology *Topology) *GraphServer {
+	return &GraphServer{
+		topology: topology,
+	}
+}
+
+// GraphServer implements the gRPC service for graph queries.
+type GraphServer struct {
+	topology *Topology
+}
+
+// checkSynced returns an Unavailable error if the topology has not yet been synchronized with the stores
+func (s *GraphServer) checkSynced() error {
+	if !s.topology.Synced() {
+		return status.Error(codes.Unavailable, "the topology is not yet synchronized")
+	}
+	return nil
+}
+
+// checkDevice returns a NotFound error if the given device of the given namespace is not in the topology
+func (s *GraphServer) checkDevice(namespace string, id deviceapi.ID) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "device ID is required")
+	}
+	if !s.topology.hasDevice(namespace, id) {
+		return status.Errorf(codes.NotFound, "device '%s' not found", id)
+	}
+	return nil
+}
+
+// checkExcluded returns an InvalidArgument error if the given device is excluded by the constraints
+func checkExcluded(id deviceapi.ID, constraints *graphapi.Constraints) error {
+	if constraints == nil {
+		return nil
+	}
+	for _, excluded := range constraints.ExcludeDevices {
+		if excluded == id {
+			return status.Errorf(codes.InvalidArgument, "device '%s' is excluded", id)
+		}
+	}
+	return nil
+}
+
+// GetNeighbors returns the devices within a number of hops of a device
+func (s *GraphServer) GetNeighbors(ctx context.Context, request *graphapi.GetNeighborsRequest) (*graphapi.GetNeighborsResponse, error) {
+	if err := s.checkSynced(); err != nil {
+		return nil, err
+	}
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDevice(namespace, request.ID); err != nil {
+		return nil, err
+	}
+	if err := checkExcluded(request.ID, request.Constraints); err != nil {
+		return nil, err
+	}
+	depth := request.Depth
+	if depth == 0 {
+		depth = 1
+	}
+	return &graphapi.GetNeighborsResponse{
+		Neighbors: s.topology.graph(namespace, request.Constraints, "", false).neighbors(request.ID, depth),
+	}, nil
+}
+
+// GetShortestPaths returns the lowest cost paths between two devices
+func (s *GraphServer) GetShortestPaths(ctx context.Context, request *graphapi.GetShortestPathsRequest) (*graphapi.GetShortestPathsResponse, error) {
+	if err := s.checkSynced(); err != nil {
+		return nil, err
+	}
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range []deviceapi.ID{request.Source, request.Target} {
+		if err := s.checkDevice(namespace, id); err != nil {
+			return nil, err
+		}
+		if err := checkExcluded(id, request.Constraints); err != nil {
+			return nil, err
+		}
+	}
+	if request.Source == request.Target {
+		return nil, status.Error(codes.InvalidArgument, "source and target are the same device")
+	}
+	n := int(request.MaxPaths)
+	if n == 0 {
+		n = 1
+	} else if n > maxPaths {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d paths may be requested", maxPaths)
+	}
+	g := s.topology.graph(namespace, request.Constraints, request.WeightAttribute, false)
+	return &graphapi.GetShortestPathsResponse{
+		Paths: g.shortestPaths(request.Source, request.Target, n),
+	}, nil
+}
+
+// GetComponents returns the connected components of the topology
+func (s *GraphServer) GetComponents(ctx context.Context, request *graphapi.GetComponentsRequest) (*graphapi.GetComponentsResponse, error) {
+	if err := s.checkSynced(); err != nil {
+		return nil, err
+	}
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &graphapi.GetComponentsResponse{
+		Components: s.topology.graph(namespace, request.Constraints, "", true).components(),
+	}, nil
+}
+
+// GetReachable returns all devices reachable from a device
+func (s *GraphServer) GetReachable(ctx context.Context, request *graphapi.GetReachableRequest) (*graphapi.GetReachableResponse, error) {
+	if err := s.checkSynced(); err != nil {
+		return nil, err
+	}
+	namespace, err := northbound.SingleNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDevice(namespace, request.Root); err != nil {
+		return nil, err
+	}
+	if err := checkExcluded(request.Root, request.Constraints); err != nil {
+		return nil, err
+	}
+	return &graphapi.GetReachableResponse{
+		Devices: s.topology.graph(namespace, request.Constraints, "", false).neighbors(request.Root, 0),
+	}, nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	adminapi "github.com/onosproject/onos-topo/api/admin"
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/northbound"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testService struct {
+	links       graphapi.LinkServiceClient
+	graph       graphapi.TopoGraphServiceClient
+	deviceStore device.Store
+	modeStore   device.ModeStore
+	close       func()
+}
+
+func newTestService(t *testing.T) *testService {
+	deviceStore, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	linkStore, err := NewLocalLinkStore()
+	assert.NoError(t, err)
+	modeStore, err := device.NewLocalModeStore()
+	assert.NoError(t, err)
+
+	service, err := NewService(linkStore, deviceStore, modeStore)
+	assert.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	service.Register(s)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	assert.NoError(t, err)
+	return &testService{
+		links:       graphapi.CreateLinkServiceClient(conn),
+		graph:       graphapi.CreateTopoGraphServiceClient(conn),
+		deviceStore: deviceStore,
+		modeStore:   modeStore,
+		close: func() {
+			conn.Close()
+			s.Stop()
+			service.Close()
+			deviceStore.Close()
+			modeStore.Close()
+		},
+	}
+}
+
+func (s *testService) addDevices(t *testing.T, ids ...deviceapi.ID) {
+	for _, id := range ids {
+		assert.NoError(t, s.deviceStore.Store(&deviceapi.Device{
+			ID:      id,
+			Type:    "Stratum",
+			Address: string(id) + ":9339",
+			Version: "1.0.0",
+		}))
+	}
+}
+
+func (s *testService) addLink(t *testing.T, id graphapi.ID, source deviceapi.ID, target deviceapi.ID) *graphapi.Link {
+	response, err := s.links.Add(context.Background(), &graphapi.AddLinkRequest{
+		Link: &graphapi.Link{ID: id, Source: source, Target: target},
+	})
+	assert.NoError(t, err)
+	return response.Link
+}
+
+func TestLinks(t *testing.T) {
+	service := newTestService(t)
+	defer service.close()
+	service.addDevices(t, "leaf-1", "spine-1")
+
+	link := service.addLink(t, "leaf-1-spine-1", "leaf-1", "spine-1")
+	assert.NotEqual(t, deviceapi.Revision(0), link.Revision)
+
+	_, err := service.links.Add(context.Background(), &graphapi.AddLinkRequest{
+		Link: &graphapi.Link{ID: "leaf-1-spine-2", Source: "leaf-1", Target: "spine-2"},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = service.links.Add(context.Background(), &graphapi.AddLinkRequest{
+		Link: &graphapi.Link{ID: "leaf-1-leaf-1", Source: "leaf-1", Target: "leaf-1"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	getResponse, err := service.links.Get(context.Background(), &graphapi.GetLinkRequest{ID: link.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, deviceapi.ID("spine-1"), getResponse.Link.Target)
+	_, err = service.links.Get(context.Background(), &graphapi.GetLinkRequest{ID: "leaf-2-spine-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	stale := *link
+	link.Weight = 10
+	updateResponse, err := service.links.Update(context.Background(), &graphapi.UpdateLinkRequest{Link: link})
+	assert.NoError(t, err)
+	_, err = service.links.Update(context.Background(), &graphapi.UpdateLinkRequest{Link: &stale})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	revision, ok := deviceapi.GetCurrentRevision(err)
+	assert.True(t, ok)
+	assert.Equal(t, updateResponse.Link.Revision, revision)
+
+	stream, err := service.links.List(context.Background(), &graphapi.ListLinksRequest{})
+	assert.NoError(t, err)
+	var links []*graphapi.Link
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		links = append(links, response.Link)
+	}
+	assert.Len(t, links, 1)
+	assert.Equal(t, uint32(10), links[0].Weight)
+
+	assert.NoError(t, service.modeStore.Store(&adminapi.Mode{ReadOnly: true, Reason: "Atomix migration"}))
+	_, err = service.links.Remove(context.Background(), &graphapi.RemoveLinkRequest{Link: updateResponse.Link})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.NoError(t, service.modeStore.Store(&adminapi.Mode{}))
+	_, err = service.links.Remove(context.Background(), &graphapi.RemoveLinkRequest{Link: updateResponse.Link})
+	assert.NoError(t, err)
+	_, err = service.links.Remove(context.Background(), &graphapi.RemoveLinkRequest{Link: &graphapi.Link{ID: link.ID}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRemovedDeviceLinks(t *testing.T) {
+	service := newTestService(t)
+	defer service.close()
+	service.addDevices(t, "leaf-1", "leaf-2", "spine-1")
+	service.addLink(t, "leaf-1-spine-1", "leaf-1", "spine-1")
+	service.addLink(t, "leaf-2-spine-1", "leaf-2", "spine-1")
+	service.addLink(t, "leaf-1-leaf-2", "leaf-1", "leaf-2")
+	assert.Eventually(t, func() bool {
+		response, err := service.graph.GetNeighbors(context.Background(), &graphapi.GetNeighborsRequest{ID: "spine-1"})
+		return err == nil && len(response.Neighbors) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The links of a removed device are removed, so that they do not reappear if the device is added back
+	d, err := service.deviceStore.Load(northbound.DefaultNamespace, "spine-1")
+	assert.NoError(t, err)
+	assert.NoError(t, service.deviceStore.Delete(d))
+	for _, id := range []graphapi.ID{"leaf-1-spine-1", "leaf-2-spine-1"} {
+		assert.Eventually(t, func() bool {
+			_, err := service.links.Get(context.Background(), &graphapi.GetLinkRequest{ID: id})
+			return status.Code(err) == codes.NotFound
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	_, err = service.links.Get(context.Background(), &graphapi.GetLinkRequest{ID: "leaf-1-leaf-2"})
+	assert.NoError(t, err)
+}
+
+func TestGraphQueries(t *testing.T) {
+	service := newTestService(t)
+	defer service.close()
+	service.addDevices(t, "leaf-1", "leaf-2", "spine-1", "spine-2", "isolated")
+	service.addLink(t, "leaf-1-spine-1", "leaf-1", "spine-1")
+	service.addLink(t, "leaf-2-spine-1", "leaf-2", "spine-1")
+	service.addLink(t, "leaf-1-spine-2", "leaf-1", "spine-2")
+	service.addLink(t, "leaf-2-spine-2", "leaf-2", "spine-2")
+
+	// The in-memory topology is updated asynchronously from the store
+	request := &graphapi.GetShortestPathsRequest{Source: "leaf-1", Target: "leaf-2", MaxPaths: 2}
+	assert.Eventually(t, func() bool {
+		response, err := service.graph.GetShortestPaths(context.Background(), request)
+		return err == nil && len(response.Paths) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	request.Constraints = &graphapi.Constraints{ExcludeDevices: []deviceapi.ID{"spine-1"}}
+	pathsResponse, err := service.graph.GetShortestPaths(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, pathsResponse.Paths, 1)
+	assert.Equal(t, []deviceapi.ID{"leaf-1", "spine-2", "leaf-2"}, pathsResponse.Paths[0].Devices)
+
+	_, err = service.graph.GetShortestPaths(context.Background(), &graphapi.GetShortestPathsRequest{Source: "leaf-1", Target: "leaf-3"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.graph.GetShortestPaths(context.Background(), &graphapi.GetShortestPathsRequest{Source: "leaf-1", Target: "leaf-2", MaxPaths: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	neighborsResponse, err := service.graph.GetNeighbors(context.Background(), &graphapi.GetNeighborsRequest{ID: "leaf-1"})
+	assert.NoError(t, err)
+	assert.Len(t, neighborsResponse.Neighbors, 2)
+	neighborsResponse, err = service.graph.GetNeighbors(context.Background(), &graphapi.GetNeighborsRequest{ID: "leaf-1", Depth: 2})
+	assert.NoError(t, err)
+	assert.Len(t, neighborsResponse.Neighbors, 3)
+
+	reachableResponse, err := service.graph.GetReachable(context.Background(), &graphapi.GetReachableRequest{Root: "spine-1"})
+	assert.NoError(t, err)
+	assert.Len(t, reachableResponse.Devices, 3)
+
+	componentsResponse, err := service.graph.GetComponents(context.Background(), &graphapi.GetComponentsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, componentsResponse.Components, 2)
+	assert.Equal(t, []deviceapi.ID{"isolated"}, componentsResponse.Components[1].Devices)
+
+	// Removed devices are removed from the graph along with their links
+	d, err := service.deviceStore.Load(northbound.DefaultNamespace, "spine-1")
+	assert.NoError(t, err)
+	assert.NoError(t, service.deviceStore.Delete(d))
+	assert.Eventually(t, func() bool {
+		response, err := service.graph.GetComponents(context.Background(), &graphapi.GetComponentsRequest{})
+		return err == nil && len(response.Components) == 2 && len(response.Components[0].Devices) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTopologySync(t *testing.T) {
+	topology := newTopology(nil, nil)
+	server := NewGraphServer(topology)
+	leaf1 := &deviceapi.Device{ID: "leaf-1", Namespace: northbound.DefaultNamespace}
+	spine1 := &deviceapi.Device{ID: "spine-1", Namespace: northbound.DefaultNamespace}
+	link := &graphapi.Link{ID: "leaf-1-spine-1", Namespace: northbound.DefaultNamespace, Source: "leaf-1", Target: "spine-1"}
+
+	// Queries are unavailable until every listed device and link has been applied, whether before or after listing
+	topology.addDevice(leaf1)
+	topology.expect(map[string]bool{deviceKey(leaf1): true, deviceKey(spine1): true, linkKey(link): true})
+	_, err := server.GetComponents(context.Background(), &graphapi.GetComponentsRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	topology.addDevice(spine1)
+	assert.False(t, topology.Synced())
+	topology.addLink(link)
+	assert.True(t, topology.Synced())
+
+	response, err := server.GetComponents(context.Background(), &graphapi.GetComponentsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, response.Components, 1)
+}
+
+func TestTopologySyncDanglingLinks(t *testing.T) {
+	deviceStore, err := device.NewLocalStore()
+	assert.NoError(t, err)
+	defer deviceStore.Close()
+	linkStore, err := NewLocalLinkStore()
+	assert.NoError(t, err)
+	defer linkStore.Close()
+
+	// Links of devices removed while no topology was watching are removed once the topology is synced
+	assert.NoError(t, deviceStore.Store(&deviceapi.Device{ID: "leaf-1"}))
+	assert.NoError(t, deviceStore.Store(&deviceapi.Device{ID: "leaf-2"}))
+	assert.NoError(t, linkStore.Store(&graphapi.Link{ID: "leaf-1-leaf-2", Source: "leaf-1", Target: "leaf-2"}))
+	assert.NoError(t, linkStore.Store(&graphapi.Link{ID: "leaf-1-spine-1", Source: "leaf-1", Target: "spine-1"}))
+
+	topology, err := NewTopology(deviceStore, linkStore, nil)
+	assert.NoError(t, err)
+	defer topology.Close()
+	assert.Eventually(t, topology.Synced, 5*time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		link, err := linkStore.Load(northbound.DefaultNamespace, "leaf-1-spine-1")
+		return err == nil && link == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	link, err := linkStore.Load(northbound.DefaultNamespace, "leaf-1-leaf-2")
+	assert.NoError(t, err)
+	assert.NotNil(t, link)
+}
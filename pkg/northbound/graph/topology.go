@@ -0,0 +1,365 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	deviceapi "github.com/onosproject/onos-topo/api/device"
+	graphapi "github.com/onosproject/onos-topo/api/graph"
+	"github.com/onosproject/onos-topo/pkg/logging"
+	"github.com/onosproject/onos-topo/pkg/northbound/device"
+	"google.golang.org/grpc/status"
+	log "k8s.io/klog"
+)
+
+// Topology is an in-memory copy of the devices and links in the stores, kept in sync by watching them.
+// Devices and links are indexed by namespace; links only connect devices of their own namespace.
+// When a device is removed, the links connecting it are removed from the link store.
+type Topology struct {
+	mu        sync.RWMutex
+	devices   map[string]map[deviceapi.ID]*deviceapi.Device
+	links     map[string]map[graphapi.ID]*graphapi.Link
+	linkStore LinkStore
+	modeStore device.ModeStore
+	cancel    context.CancelFunc
+	// synced indicates whether the devices and links in the stores when the topology was created have been applied
+	synced bool
+	// pending is the set of keys of the listed devices and links that have not been applied yet, or nil until listed
+	pending map[string]bool
+	// observed is the set of keys of the devices and links applied before they were listed
+	observed map[string]bool
+}
+
+// NewTopology returns a new Topology replaying and then watching the given stores until it is closed.
+// The topology is synced once the devices and links in the stores when it was created have been applied.
+// The links of removed devices are not removed while the given mode store reports the topology as read-only.
+func NewTopology(deviceStore device.Store, linkStore LinkStore, modeStore device.ModeStore) (*Topology, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	deviceCh := make(chan *device.Event)
+	if err := deviceStore.Watch(ctx, deviceCh); err != nil {
+		cancel()
+		return nil, err
+	}
+	linkCh := make(chan *Event)
+	if err := linkStore.Watch(ctx, linkCh); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	t := newTopology(linkStore, modeStore)
+	t.cancel = cancel
+	go func() {
+		for event := range deviceCh {
+			if event.Type == device.EventRemoved {
+				if links := t.removeDevice(event.Device); len(links) > 0 {
+					go t.removeLinks(links)
+				}
+			} else {
+				t.addDevice(event.Device)
+			}
+		}
+	}()
+	go func() {
+		for event := range linkCh {
+			if event.Type == device.EventRemoved {
+				t.removeLink(event.Link)
+			} else {
+				t.addLink(event.Link)
+			}
+		}
+	}()
+
+	// Every device and link listed after the watches started is eventually replayed or notified by them
+	keys, err := listKeys(deviceStore, linkStore)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	t.expect(keys)
+	return t, nil
+}
+
+func newTopology(linkStore LinkStore, modeStore device.ModeStore) *Topology {
+	return &Topology{
+		devices:   make(map[string]map[deviceapi.ID]*deviceapi.Device),
+		links:     make(map[string]map[graphapi.ID]*graphapi.Link),
+		linkStore: linkStore,
+		modeStore: modeStore,
+		cancel:    func() {},
+		observed:  make(map[string]bool),
+	}
+}
+
+// deviceKey returns the sync key of the given device
+func deviceKey(d *deviceapi.Device) string {
+	return "device/" + device.NamespacedKey(d.Namespace, string(d.ID))
+}
+
+// linkKey returns the sync key of the given link
+func linkKey(link *graphapi.Link) string {
+	return "link/" + device.NamespacedKey(link.Namespace, string(link.ID))
+}
+
+// listKeys returns the sync keys of the devices and links in the given stores
+func listKeys(deviceStore device.Store, linkStore LinkStore) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	deviceCh := make(chan *deviceapi.Device)
+	if err := deviceStore.List(deviceCh); err != nil {
+		return nil, err
+	}
+	for d := range deviceCh {
+		keys[deviceKey(d)] = true
+	}
+	linkCh := make(chan *graphapi.Link)
+	if err := linkStore.List(linkCh); err != nil {
+		return nil, err
+	}
+	for link := range linkCh {
+		keys[linkKey(link)] = true
+	}
+	return keys, nil
+}
+
+// expect sets the keys of the devices and links that must be applied before the topology is synced
+func (t *Topology) expect(keys map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = make(map[string]bool)
+	for key := range keys {
+		if !t.observed[key] {
+			t.pending[key] = true
+		}
+	}
+	t.observed = nil
+	t.checkSynced()
+}
+
+// observe records that the device or link with the given key has been applied. The lock must be held.
+func (t *Topology) observe(key string) {
+	if t.synced {
+		return
+	}
+	if t.pending == nil {
+		t.observed[key] = true
+		return
+	}
+	delete(t.pending, key)
+	t.checkSynced()
+}
+
+// checkSynced marks the topology as synced once no listed device or link is pending, and then removes
+// the links of devices removed while the topology was not watching. The lock must be held.
+func (t *Topology) checkSynced() {
+	if len(t.pending) > 0 {
+		return
+	}
+	t.synced = true
+	t.pending = nil
+	log.Info("Synchronized the topology")
+
+	var dangling []*graphapi.Link
+	for namespace, links := range t.links {
+		for _, link := range links {
+			if _, ok := t.devices[namespace][link.Source]; !ok {
+				dangling = append(dangling, link)
+			} else if _, ok := t.devices[namespace][link.Target]; !ok {
+				dangling = append(dangling, link)
+			}
+		}
+	}
+	if len(dangling) > 0 {
+		go t.removeLinks(dangling)
+	}
+}
+
+// Synced returns whether the devices and links in the stores when the topology was created have been applied
+func (t *Topology) Synced() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.synced
+}
+
+// addDevice adds or replaces the given device in the topology
+func (t *Topology) addDevice(d *deviceapi.Device) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	devices, ok := t.devices[d.Namespace]
+	if !ok {
+		devices = make(map[deviceapi.ID]*deviceapi.Device)
+		t.devices[d.Namespace] = devices
+	}
+	devices[d.ID] = d
+	t.observe(deviceKey(d))
+}
+
+// removeDevice removes the given device from the topology and returns the links connecting it
+func (t *Topology) removeDevice(d *deviceapi.Device) []*graphapi.Link {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.devices[d.Namespace], d.ID)
+	if len(t.devices[d.Namespace]) == 0 {
+		delete(t.devices, d.Namespace)
+	}
+	t.observe(deviceKey(d))
+	var links []*graphapi.Link
+	for _, link := range t.links[d.Namespace] {
+		if link.Source == d.ID || link.Target == d.ID {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// removeLinks removes the given links of a removed device from the link store. The topology of every
+// replica removes them, so links modified or already removed by another replica are skipped.
+func (t *Topology) removeLinks(links []*graphapi.Link) {
+	if err := device.CheckWritable(t.modeStore); err != nil {
+		log.Warningf("Not removing %d links of a removed device: %s", len(links), status.Convert(err).Message())
+		return
+	}
+	for _, link := range links {
+		if err := t.linkStore.Delete(link); err != nil && !device.IsConflict(err) {
+			log.Warningf("Failed to remove link %s of a removed device: %v", link.ID, err)
+		} else if err == nil {
+			logging.V(1).Infof("Removed link %s of a removed device", link.ID)
+		}
+	}
+}
+
+// addLink adds or replaces the given link in the topology
+func (t *Topology) addLink(link *graphapi.Link) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	links, ok := t.links[link.Namespace]
+	if !ok {
+		links = make(map[graphapi.ID]*graphapi.Link)
+		t.links[link.Namespace] = links
+	}
+	links[link.ID] = link
+	t.observe(linkKey(link))
+}
+
+// removeLink removes the given link from the topology
+func (t *Topology) removeLink(link *graphapi.Link) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.links[link.Namespace], link.ID)
+	if len(t.links[link.Namespace]) == 0 {
+		delete(t.links, link.Namespace)
+	}
+	t.observe(linkKey(link))
+}
+
+// Close stops watching the stores
+func (t *Topology) Close() error {
+	t.cancel()
+	return nil
+}
+
+// hasDevice returns whether the given device of the given namespace is in the topology
+func (t *Topology) hasDevice(namespace string, id deviceapi.ID) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.devices[namespace][id]
+	return ok
+}
+
+// edge is a traversable direction of a link
+type edge struct {
+	link   graphapi.ID
+	to     deviceapi.ID
+	weight uint64
+}
+
+// graph is the adjacency list of the devices and links traversable under a set of constraints
+type graph map[deviceapi.ID][]edge
+
+// graph returns the adjacency list of the devices and links of the given namespace satisfying the given
+// constraints. If weightAttribute is set, links are weighted by that attribute and links lacking a
+// positive value are omitted. If undirected is set, directed links are traversable in both directions.
+func (t *Topology) graph(namespace string, constraints *graphapi.Constraints, weightAttribute string, undirected bool) graph {
+	if constraints == nil {
+		constraints = &graphapi.Constraints{}
+	}
+	excludedDevices := make(map[deviceapi.ID]bool)
+	for _, id := range constraints.ExcludeDevices {
+		excludedDevices[id] = true
+	}
+	excludedLinks := make(map[graphapi.ID]bool)
+	for _, id := range constraints.ExcludeLinks {
+		excludedLinks[id] = true
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	g := make(graph)
+	for id := range t.devices[namespace] {
+		if !excludedDevices[id] {
+			g[id] = nil
+		}
+	}
+
+	links := t.links[namespace]
+	ids := make([]graphapi.ID, 0, len(links))
+	for id := range links {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		link := links[id]
+		if excludedLinks[id] || !hasAttributes(link, constraints.LinkAttributes) {
+			continue
+		}
+		if _, ok := g[link.Source]; !ok {
+			continue
+		}
+		if _, ok := g[link.Target]; !ok {
+			continue
+		}
+		weight := uint64(link.Weight)
+		if weightAttribute != "" {
+			value, err := strconv.ParseUint(link.Attributes[weightAttribute], 10, 32)
+			if err != nil || value == 0 {
+				continue
+			}
+			weight = value
+		} else if weight == 0 {
+			weight = 1
+		}
+		g[link.Source] = append(g[link.Source], edge{link: id, to: link.Target, weight: weight})
+		if !link.Directed || undirected {
+			g[link.Target] = append(g[link.Target], edge{link: id, to: link.Source, weight: weight})
+		}
+	}
+	return g
+}
+
+// hasAttributes returns whether the given link has all the given attributes
+func hasAttributes(link *graphapi.Link, attributes map[string]string) bool {
+	for key, value := range attributes {
+		if v, ok := link.Attributes[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
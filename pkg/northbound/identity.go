@@ -0,0 +1,78 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package northbound
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIdentity describes the client of the given context by its address, the common name of
+// its TLS certificate and its user agent, where known. The certificate is not verified in the tls
+// mode, so the identity describes the client but must not be used to authorize it.
+func ClientIdentity(ctx context.Context) string {
+	var parts []string
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			parts = append(parts, p.Addr.String())
+		}
+	}
+	if name := commonName(ctx); name != "" {
+		parts = append(parts, "cn="+name)
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if agents := md.Get("user-agent"); len(agents) > 0 {
+			parts = append(parts, agents[0])
+		}
+	}
+	if len(parts) == 0 {
+		return "unknown"
+	}
+	return strings.Join(parts, " ")
+}
+
+// verifiedCommonName returns the common name of the client certificate of the given context if the
+// certificate was verified against the CA, which it is only in the mtls mode
+func verifiedCommonName(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			for _, chain := range tlsInfo.State.VerifiedChains {
+				if len(chain) > 0 && chain[0].Subject.CommonName != "" {
+					return chain[0].Subject.CommonName
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// commonName returns the common name of the TLS certificate presented by the client of the given
+// context, if any, whether or not the certificate was verified
+func commonName(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			for _, cert := range tlsInfo.State.PeerCertificates {
+				if cert.Subject.CommonName != "" {
+					return cert.Subject.CommonName
+				}
+			}
+		}
+	}
+	return ""
+}
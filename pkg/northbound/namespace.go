@@ -0,0 +1,252 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultNamespace is the namespace of requests that do not name a namespace
+	DefaultNamespace = "default"
+
+	// AllNamespaces is the namespace with which administrators list resources across all namespaces
+	AllNamespaces = "*"
+
+	// NamespaceHeader is the gRPC metadata key carrying the namespace of a request
+	NamespaceHeader = "onos-topo-namespace"
+)
+
+// namespacePattern is the pattern of namespace names, which are DNS labels
+var namespacePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9\-]{0,61}[a-z0-9])?$`)
+
+// ValidateNamespace returns an error if the given name is not a valid namespace name
+func ValidateNamespace(namespace string) error {
+	if !namespacePattern.MatchString(namespace) {
+		return fmt.Errorf("namespace '%s' is invalid", namespace)
+	}
+	return nil
+}
+
+// NamespaceConfig configures how the namespaces of requests are derived from the identity of clients.
+// Clients are identified by the common name of their TLS certificate, which must be verified against
+// the CA; clients whose certificates were not verified are neither tenants nor listed administrators.
+type NamespaceConfig struct {
+	// Tenants maps namespaces to the identities of the tenants whose requests are confined to them
+	Tenants map[string][]string
+
+	// Admins are the identities of the administrators, who may use any namespace and list resources
+	// across namespaces. If no administrator is configured, all clients that are not tenants are
+	// administrators. Otherwise, other clients may use any namespace but not list across namespaces.
+	Admins []string
+}
+
+// Validate checks that the namespaces are valid and that each client has a single role
+func (c NamespaceConfig) Validate() error {
+	tenants := make(map[string]string)
+	for namespace, names := range c.Tenants {
+		if err := ValidateNamespace(namespace); err != nil {
+			return err
+		}
+		for _, name := range names {
+			if other, ok := tenants[name]; ok {
+				return fmt.Errorf("tenant '%s' cannot be confined to both namespace '%s' and namespace '%s'", name, other, namespace)
+			}
+			tenants[name] = namespace
+		}
+	}
+	for _, admin := range c.Admins {
+		if namespace, ok := tenants[admin]; ok {
+			return fmt.Errorf("administrator '%s' cannot be a tenant of namespace '%s'", admin, namespace)
+		}
+	}
+	return nil
+}
+
+// tenantNamespace returns the namespace to which the tenant with the given identity is confined, if any
+func (c NamespaceConfig) tenantNamespace(name string) (string, bool) {
+	for namespace, names := range c.Tenants {
+		for _, n := range names {
+			if n == name {
+				return namespace, true
+			}
+		}
+	}
+	return "", false
+}
+
+// scope is the namespace in which a request operates and whether its client is an administrator
+type scope struct {
+	namespace string
+	admin     bool
+}
+
+// scopeKey is the context key of the scope of a request
+type scopeKey struct{}
+
+// resolve returns the scope of the request of the given context
+func (c NamespaceConfig) resolve(ctx context.Context) (scope, error) {
+	requested, err := requestedNamespace(ctx)
+	if err != nil {
+		return scope{}, err
+	}
+
+	name := verifiedCommonName(ctx)
+	if namespace, ok := c.tenantNamespace(name); ok && name != "" {
+		if requested != "" && requested != namespace {
+			return scope{}, status.Errorf(codes.PermissionDenied, "client '%s' is confined to namespace '%s'", name, namespace)
+		}
+		return scope{namespace: namespace}, nil
+	}
+
+	admin := len(c.Admins) == 0
+	for _, a := range c.Admins {
+		if a == name && name != "" {
+			admin = true
+		}
+	}
+	if requested == "" {
+		requested = DefaultNamespace
+	} else if requested == AllNamespaces && !admin {
+		return scope{}, status.Error(codes.PermissionDenied, "only administrators may list across namespaces")
+	}
+	return scope{namespace: requested, admin: admin}, nil
+}
+
+// requestedNamespace returns the namespace named by the metadata of the request of the given context, if any
+func requestedNamespace(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(NamespaceHeader)) == 0 {
+		return "", nil
+	}
+	namespace := md.Get(NamespaceHeader)[0]
+	if namespace == "" || namespace == AllNamespaces {
+		return namespace, nil
+	}
+	if err := ValidateNamespace(namespace); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return namespace, nil
+}
+
+// NamespaceUnaryServerInterceptor returns an interceptor resolving the namespace of each unary RPC
+// according to the given configuration
+func NamespaceUnaryServerInterceptor(config NamespaceConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		s, err := config.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, scopeKey{}, s), req)
+	}
+}
+
+// NamespaceStreamServerInterceptor returns an interceptor resolving the namespace of each streaming RPC
+// according to the given configuration
+func NamespaceStreamServerInterceptor(config NamespaceConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		s, err := config.resolve(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &scopedStream{
+			ServerStream: stream,
+			ctx:          context.WithValue(stream.Context(), scopeKey{}, s),
+		})
+	}
+}
+
+// scopedStream is a ServerStream carrying the scope of its request in its context
+type scopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestScope returns the scope of the request of the given context. Requests that were not
+// intercepted, e.g. in tests, operate in the namespace named by their metadata as administrators.
+func requestScope(ctx context.Context) (scope, error) {
+	if s, ok := ctx.Value(scopeKey{}).(scope); ok {
+		return s, nil
+	}
+	return NamespaceConfig{}.resolve(ctx)
+}
+
+// RequestNamespace returns the namespace of the request of the given context, which is AllNamespaces
+// if an administrator requested resources across all namespaces
+func RequestNamespace(ctx context.Context) (string, error) {
+	s, err := requestScope(ctx)
+	if err != nil {
+		return "", err
+	}
+	return s.namespace, nil
+}
+
+// SingleNamespace returns the namespace of the request of the given context, failing with an
+// InvalidArgument error if the request is not confined to a single namespace
+func SingleNamespace(ctx context.Context) (string, error) {
+	namespace, err := RequestNamespace(ctx)
+	if err != nil {
+		return "", err
+	} else if namespace == AllNamespaces {
+		return "", status.Error(codes.InvalidArgument, "the request must name a single namespace")
+	}
+	return namespace, nil
+}
+
+// CheckAdmin returns a PermissionDenied error if the client of the given context is not an administrator
+func CheckAdmin(ctx context.Context) error {
+	s, err := requestScope(ctx)
+	if err != nil {
+		return err
+	} else if !s.admin {
+		return status.Error(codes.PermissionDenied, "the request is restricted to administrators")
+	}
+	return nil
+}
+
+// WithNamespace returns a copy of the given client context naming the given namespace in its metadata
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, NamespaceHeader, namespace)
+}
+
+// NamespaceDialOptions returns the options with which client connections name the given namespace in
+// requests whose context does not already name a namespace
+func NamespaceDialOptions(namespace string) []grpc.DialOption {
+	withNamespace := func(ctx context.Context) context.Context {
+		if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(NamespaceHeader)) > 0 {
+			return ctx
+		}
+		return WithNamespace(ctx, namespace)
+	}
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(withNamespace(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withNamespace(ctx), desc, cc, method, opts...)
+		}),
+	}
+}
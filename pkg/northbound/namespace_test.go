@@ -0,0 +1,173 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package northbound
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// clientContext returns the context of a request made by the client with the given verified certificate
+// common name naming the given namespace, if any
+func clientContext(name string, namespace string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: name}}
+	return requestContext(tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}, namespace)
+}
+
+// unverifiedClientContext returns the context of a request made by a client presenting a certificate
+// with the given common name that was not verified, as in the tls mode
+func unverifiedClientContext(name string, namespace string) context.Context {
+	return requestContext(tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: name}}},
+	}, namespace)
+}
+
+// requestContext returns the context of a request made over the given connection naming the given
+// namespace, if any
+func requestContext(state tls.ConnectionState, namespace string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+	if namespace != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(NamespaceHeader, namespace))
+	}
+	return ctx
+}
+
+func TestValidateNamespace(t *testing.T) {
+	assert.NoError(t, ValidateNamespace("default"))
+	assert.NoError(t, ValidateNamespace("lab-a"))
+	assert.Error(t, ValidateNamespace(""))
+	assert.Error(t, ValidateNamespace(AllNamespaces))
+	assert.Error(t, ValidateNamespace("Lab-A"))
+	assert.Error(t, ValidateNamespace("lab-"))
+	assert.Error(t, ValidateNamespace("lab|a"))
+}
+
+func TestNamespaceConfigValidate(t *testing.T) {
+	assert.NoError(t, NamespaceConfig{}.Validate())
+	assert.NoError(t, NamespaceConfig{
+		Tenants: map[string][]string{"lab-a": {"alice"}, "lab-b": {"bob"}},
+		Admins:  []string{"onos"},
+	}.Validate())
+	assert.Error(t, NamespaceConfig{
+		Tenants: map[string][]string{"Lab-A": {"alice"}},
+	}.Validate())
+	assert.Error(t, NamespaceConfig{
+		Tenants: map[string][]string{"lab-a": {"alice"}, "lab-b": {"alice"}},
+	}.Validate())
+	assert.Error(t, NamespaceConfig{
+		Tenants: map[string][]string{"lab-a": {"alice"}},
+		Admins:  []string{"alice"},
+	}.Validate())
+}
+
+func TestResolveNamespace(t *testing.T) {
+	config := NamespaceConfig{
+		Tenants: map[string][]string{"lab-a": {"alice"}},
+		Admins:  []string{"onos"},
+	}
+
+	s, err := config.resolve(clientContext("alice", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: "lab-a"}, s)
+	s, err = config.resolve(clientContext("alice", "lab-a"))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: "lab-a"}, s)
+	_, err = config.resolve(clientContext("alice", "lab-b"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = config.resolve(clientContext("alice", AllNamespaces))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	s, err = config.resolve(clientContext("onos", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: DefaultNamespace, admin: true}, s)
+	s, err = config.resolve(clientContext("onos", AllNamespaces))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: AllNamespaces, admin: true}, s)
+
+	s, err = config.resolve(clientContext("carol", "lab-b"))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: "lab-b"}, s)
+	_, err = config.resolve(clientContext("carol", AllNamespaces))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = config.resolve(clientContext("carol", "Lab-B"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Unverified certificates identify neither tenants nor administrators
+	s, err = config.resolve(unverifiedClientContext("alice", "lab-b"))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: "lab-b"}, s)
+	_, err = config.resolve(unverifiedClientContext("onos", AllNamespaces))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Without administrators, all clients that are not tenants are administrators
+	s, err = NamespaceConfig{}.resolve(clientContext("carol", AllNamespaces))
+	assert.NoError(t, err)
+	assert.Equal(t, scope{namespace: AllNamespaces, admin: true}, s)
+}
+
+func TestRequestNamespace(t *testing.T) {
+	config := NamespaceConfig{
+		Tenants: map[string][]string{"lab-a": {"alice"}},
+		Admins:  []string{"onos"},
+	}
+	interceptor := NamespaceUnaryServerInterceptor(config)
+	call := func(ctx context.Context, handler grpc.UnaryHandler) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		return err
+	}
+
+	assert.NoError(t, call(clientContext("alice", ""), func(ctx context.Context, req interface{}) (interface{}, error) {
+		namespace, err := SingleNamespace(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "lab-a", namespace)
+		assert.Equal(t, codes.PermissionDenied, status.Code(CheckAdmin(ctx)))
+		return nil, nil
+	}))
+	assert.NoError(t, call(clientContext("onos", AllNamespaces), func(ctx context.Context, req interface{}) (interface{}, error) {
+		namespace, err := RequestNamespace(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, AllNamespaces, namespace)
+		_, err = SingleNamespace(ctx)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.NoError(t, CheckAdmin(ctx))
+		return nil, nil
+	}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(clientContext("alice", "lab-b"), func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("the handler of a denied request was called")
+		return nil, nil
+	})))
+
+	// Requests that were not intercepted are made by administrators in the namespace of their metadata
+	namespace, err := SingleNamespace(metadata.NewIncomingContext(context.Background(), metadata.Pairs(NamespaceHeader, "lab-b")))
+	assert.NoError(t, err)
+	assert.Equal(t, "lab-b", namespace)
+	assert.NoError(t, CheckAdmin(context.Background()))
+}
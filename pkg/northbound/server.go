@@ -17,14 +17,20 @@
 package northbound
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
-	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
+	"os"
+	"strconv"
+	"sync"
 
 	"github.com/onosproject/onos-topo/pkg/certs"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	log "k8s.io/klog"
 
 	"google.golang.org/grpc"
@@ -35,19 +41,22 @@ type Service interface {
 	Register(s *grpc.Server)
 }
 
-// Server provides NB gNMI server for onos-topo.
-type Server struct {
-	cfg      *ServerConfig
-	services []Service
+// Drainer is implemented by services holding long-lived streams which must be closed
+// before the server can be stopped gracefully.
+type Drainer interface {
+	// Drain closes the service's open streams
+	Drain()
 }
 
-// ServerConfig comprises a set of server configuration options.
-type ServerConfig struct {
-	CaPath   *string
-	KeyPath  *string
-	CertPath *string
-	Port     int16
-	Insecure bool
+// Server provides NB gNMI server for onos-topo.
+type Server struct {
+	cfg                *ServerConfig
+	services           []Service
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	server             *grpc.Server
+	mu                 sync.Mutex
+	closeOnce          sync.Once
 }
 
 // NewServer initializes gNMI server using the supplied configuration.
@@ -58,73 +67,197 @@ func NewServer(cfg *ServerConfig) *Server {
 	}
 }
 
-// NewServerConfig creates a server config created with the specified end-point security details.
-func NewServerConfig(caPath string, keyPath string, certPath string) *ServerConfig {
-	return &ServerConfig{
-		Port:     5150,
-		Insecure: true,
-		CaPath:   &caPath,
-		KeyPath:  &keyPath,
-		CertPath: &certPath,
-	}
-}
-
 // AddService adds a Service to the server to be registered on Serve.
 func (s *Server) AddService(r Service) {
 	s.services = append(s.services, r)
 }
 
+// AddUnaryInterceptor adds an interceptor to be invoked on each unary RPC.
+// Interceptors are invoked in the order in which they were added.
+func (s *Server) AddUnaryInterceptor(interceptor grpc.UnaryServerInterceptor) {
+	s.unaryInterceptors = append(s.unaryInterceptors, interceptor)
+}
+
+// AddStreamInterceptor adds an interceptor to be invoked on each streaming RPC.
+// Interceptors are invoked in the order in which they were added.
+func (s *Server) AddStreamInterceptor(interceptor grpc.StreamServerInterceptor) {
+	s.streamInterceptors = append(s.streamInterceptors, interceptor)
+}
+
 // Serve starts the NB gNMI server.
 func (s *Server) Serve(started func(string)) error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.Port))
+	lis, err := s.listen()
 	if err != nil {
 		return err
 	}
 
+	opts, err := s.serverOptions()
+	if err != nil {
+		lis.Close()
+		return err
+	}
+	server := grpc.NewServer(opts...)
+	for i := range s.services {
+		s.services[i].Register(server)
+	}
+	if s.cfg.Reflection {
+		reflection.Register(server)
+	}
+	s.mu.Lock()
+	s.server = server
+	s.mu.Unlock()
+	started(lis.Addr().String())
+
+	log.Infof("Starting RPC server on address: %s (%s)", lis.Addr().String(), s.cfg.TLSMode())
+	return server.Serve(lis)
+}
+
+// listen opens the unix socket or TCP listener on which the server is to be served
+func (s *Server) listen() (net.Listener, error) {
+	if s.cfg.SocketPath != "" {
+		// Remove a socket left behind by a previous process before listening
+		if err := os.Remove(s.cfg.SocketPath); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return net.Listen("unix", s.cfg.SocketPath)
+	}
+	return net.Listen("tcp", net.JoinHostPort(s.cfg.Address, strconv.Itoa(s.cfg.Port)))
+}
+
+// serverOptions returns the gRPC server options for the server configuration
+func (s *Server) serverOptions() ([]grpc.ServerOption, error) {
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(chainUnaryInterceptors(s.unaryInterceptors)),
+		grpc.StreamInterceptor(chainStreamInterceptors(s.streamInterceptors)),
+	}
+
+	if !s.cfg.Plaintext {
+		tlsCfg, err := s.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	if s.cfg.KeepaliveTime > 0 || s.cfg.KeepaliveTimeout > 0 {
+		opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    s.cfg.KeepaliveTime,
+			Timeout: s.cfg.KeepaliveTimeout,
+		}))
+	}
+	if s.cfg.KeepaliveMinTime > 0 {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             s.cfg.KeepaliveMinTime,
+			PermitWithoutStream: true,
+		}))
+	}
+	if s.cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(s.cfg.MaxRecvMsgSize))
+	}
+	if s.cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(s.cfg.MaxSendMsgSize))
+	}
+	return opts, nil
+}
+
+// tlsConfig returns the TLS configuration for the server's certificates and TLS mode
+func (s *Server) tlsConfig() (*tls.Config, error) {
 	tlsCfg := &tls.Config{}
 
-	if *s.cfg.CertPath == "" && *s.cfg.KeyPath == "" {
+	certPath, keyPath := stringValue(s.cfg.CertPath), stringValue(s.cfg.KeyPath)
+	if certPath == "" && keyPath == "" {
 		// Load default Certificates
 		clientCerts, err := tls.X509KeyPair([]byte(certs.DefaultLocalhostCrt), []byte(certs.DefaultLocalhostKey))
 		if err != nil {
 			log.Error("Error loading default certs")
-			return err
+			return nil, err
 		}
 		tlsCfg.Certificates = []tls.Certificate{clientCerts}
 	} else {
-		log.Infof("Loading certs: %s %s", *s.cfg.CertPath, *s.cfg.KeyPath)
-		clientCerts, err := tls.LoadX509KeyPair(*s.cfg.CertPath, *s.cfg.KeyPath)
+		log.Infof("Loading certs: %s %s", certPath, keyPath)
+		clientCerts, err := tls.LoadX509KeyPair(certPath, keyPath)
 		if err != nil {
-			log.Info("Error loading default certs")
+			log.Error("Error loading certs")
+			return nil, err
 		}
 		tlsCfg.Certificates = []tls.Certificate{clientCerts}
 	}
 
 	if s.cfg.Insecure {
 		// RequestClientCert will ask client for a certificate but won't
-		// require it to proceed. If certificate is provided, it will be
-		// verified.
+		// require it to proceed. A provided certificate is not verified,
+		// so it does not identify the client; use mTLS to authenticate
+		// clients.
 		tlsCfg.ClientAuth = tls.RequestClientCert
 	} else {
 		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
 	}
 
-	if *s.cfg.CaPath == "" {
+	if caPath := stringValue(s.cfg.CaPath); caPath == "" {
 		log.Info("Loading default CA onfca")
 		tlsCfg.ClientCAs = getCertPoolDefault()
 	} else {
-		tlsCfg.ClientCAs = getCertPool(*s.cfg.CaPath)
+		tlsCfg.ClientCAs = getCertPool(caPath)
 	}
+	return tlsCfg, nil
+}
 
-	opts := []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsCfg))}
-	server := grpc.NewServer(opts...)
-	for i := range s.services {
-		s.services[i].Register(server)
+// Stop stops the server immediately, closing all open connections and RPCs, and then closes the services.
+func (s *Server) Stop() {
+	s.mu.Lock()
+	server := s.server
+	s.mu.Unlock()
+	if server != nil {
+		server.Stop()
 	}
-	started(lis.Addr().String())
+	s.closeServices()
+}
 
-	log.Infof("Starting RPC server on address: %s", lis.Addr().String())
-	return server.Serve(lis)
+// GracefulStop stops the server from accepting new connections and RPCs, closes the services'
+// long-lived streams and waits for pending RPCs to complete. If pending RPCs do not complete before
+// the given context is done, the server is stopped immediately. Once the server is stopped, the services are closed.
+func (s *Server) GracefulStop(ctx context.Context) {
+	s.mu.Lock()
+	server := s.server
+	s.mu.Unlock()
+	if server == nil {
+		s.closeServices()
+		return
+	}
+
+	log.Info("Draining RPC server")
+	for _, service := range s.services {
+		if drainer, ok := service.(Drainer); ok {
+			drainer.Drain()
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warning("RPCs did not complete before the shutdown deadline; stopping RPC server")
+		server.Stop()
+	}
+	s.closeServices()
+}
+
+// closeServices closes the services which hold resources
+func (s *Server) closeServices() {
+	s.closeOnce.Do(func() {
+		for _, service := range s.services {
+			if closer, ok := service.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					log.Warning("Failed to close service ", err)
+				}
+			}
+		}
+	})
 }
 
 func getCertPoolDefault() *x509.CertPool {
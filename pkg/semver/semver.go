@@ -0,0 +1,383 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semver parses device software versions and version ranges.
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+
+	// Prerelease is the dot separated pre-release identifiers of the version, e.g. rc.1 in 1.0.0-rc.1
+	Prerelease string
+
+	// Build is the build metadata of the version, e.g. 20191001 in 1.0.0+20191001. Build metadata
+	// does not affect the precedence of versions.
+	Build string
+
+	// Vendor is the original text of a version in a vendor specific format, e.g. 4.22.1F. The major,
+	// minor and patch numbers of vendor versions are their leading numbers.
+	Vendor string
+}
+
+// vendorPattern matches vendor specific versions, which are one to three dot separated numbers followed
+// by an arbitrary suffix, e.g. 4.22.1F, 16.9 or 7.0(3)I7(5)
+var vendorPattern = regexp.MustCompile(`^(\d+)(?:\.(\d+))?(?:\.(\d+))?([^.\d].*)?$`)
+
+// Parse parses a semantic version of the form major.minor.patch[-prerelease][+build]
+func Parse(s string) (Version, error) {
+	core := s
+	var build, prerelease string
+	if i := strings.Index(core, "+"); i >= 0 {
+		core, build = core[:i], core[i+1:]
+		if !validIdentifiers(build, false) {
+			return Version{}, fmt.Errorf("invalid version '%s'", s)
+		}
+	}
+	if i := strings.Index(core, "-"); i >= 0 {
+		core, prerelease = core[:i], core[i+1:]
+		if !validIdentifiers(prerelease, true) {
+			return Version{}, fmt.Errorf("invalid version '%s'", s)
+		}
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("invalid version '%s'", s)
+	}
+	var numbers [3]uint64
+	for i, part := range parts {
+		n, err := parseNumber(part)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version '%s'", s)
+		}
+		numbers[i] = n
+	}
+	return Version{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		Prerelease: prerelease,
+		Build:      build,
+	}, nil
+}
+
+// ParseVersion parses a semantic version, or a version in a vendor specific format if the version is
+// not a semantic version
+func ParseVersion(s string) (Version, error) {
+	if v, err := Parse(s); err == nil {
+		return v, nil
+	}
+	match := vendorPattern.FindStringSubmatch(s)
+	if match == nil {
+		return Version{}, fmt.Errorf("invalid version '%s'", s)
+	}
+	v := Version{Vendor: s}
+	for i, n := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		if match[i+1] == "" {
+			break
+		}
+		number, err := strconv.ParseUint(match[i+1], 10, 64)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version '%s'", s)
+		}
+		*n = number
+	}
+	return v, nil
+}
+
+// validIdentifiers returns whether the given pre-release or build metadata identifiers are valid.
+// Numeric pre-release identifiers may not have leading zeros.
+func validIdentifiers(s string, prerelease bool) bool {
+	for _, identifier := range strings.Split(s, ".") {
+		if identifier == "" {
+			return false
+		}
+		numeric := true
+		for _, c := range identifier {
+			switch {
+			case c >= '0' && c <= '9':
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if prerelease && numeric && len(identifier) > 1 && identifier[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseNumber parses a version number, rejecting signs and leading zeros
+func parseNumber(s string) (uint64, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') || s[0] == '+' {
+		return 0, fmt.Errorf("invalid version number '%s'", s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// Compare returns -1, 0 or 1 if the version is lower than, equal to or greater than the given version.
+// Versions are ordered by their major, minor and patch numbers, then pre-release versions are ordered
+// before the release, and finally vendor versions are ordered by their text after versions that are
+// not vendor specific. Build metadata is ignored.
+func (v Version) Compare(other Version) int {
+	switch {
+	case v.Major != other.Major:
+		return compareNumbers(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return compareNumbers(v.Minor, other.Minor)
+	case v.Patch != other.Patch:
+		return compareNumbers(v.Patch, other.Patch)
+	case v.Prerelease != other.Prerelease:
+		return comparePrerelease(v.Prerelease, other.Prerelease)
+	default:
+		return strings.Compare(v.Vendor, other.Vendor)
+	}
+}
+
+func compareNumbers(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePrerelease compares pre-release identifiers. A version without pre-release identifiers has a
+// higher precedence than a pre-release version; otherwise identifiers are compared from left to right,
+// numerically if both are numeric and lexically otherwise, with numeric identifiers ordered first.
+func comparePrerelease(a, b string) int {
+	if a == "" {
+		return 1
+	} else if b == "" {
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+		var cmp int
+		switch {
+		case aErr == nil && bErr == nil:
+			cmp = compareNumbers(an, bn)
+		case aErr == nil:
+			cmp = -1
+		case bErr == nil:
+			cmp = 1
+		default:
+			cmp = strings.Compare(as[i], bs[i])
+		}
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	return compareNumbers(uint64(len(as)), uint64(len(bs)))
+}
+
+func (v Version) String() string {
+	if v.Vendor != "" {
+		return v.Vendor
+	}
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// operator is a comparison operator in a version range
+type operator string
+
+const (
+	opEQ operator = "="
+	opLT operator = "<"
+	opLE operator = "<="
+	opGT operator = ">"
+	opGE operator = ">="
+)
+
+// lowestPrerelease is the pre-release identifier with the lowest precedence. The exclusive upper bounds
+// of caret, tilde and wildcard ranges are the lowest pre-release of the bound, so that pre-releases of
+// the bound are not in the range, e.g. 2.0.0-rc.1 is not in 1.x.
+const lowestPrerelease = "0"
+
+// comparator compares versions to a fixed version
+type comparator struct {
+	op      operator
+	version Version
+}
+
+func (c comparator) matches(v Version) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case opLT:
+		return cmp < 0
+	case opLE:
+		return cmp <= 0
+	case opGT:
+		return cmp > 0
+	case opGE:
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// Range is a set of versions, e.g. ">=1.0.0 <2.0.0", "1.x" or "^1.2.0 || 2.0.x"
+type Range struct {
+	// sets are alternatives, each of which matches a version if all its comparators do
+	sets [][]comparator
+	text string
+}
+
+// ParseRange parses a version range.
+// A range is a list of alternatives separated by "||", each of which is a space separated list of
+// constraints that must all hold. A constraint is a version preceded by one of the operators =, <, <=,
+// > or >=; a caret (^1.2.0) or tilde (~1.2.0) range; or a partial version with optional wildcards
+// (1, 1.x, 1.2.*) matching all versions with the given prefix. "*" matches all versions.
+// Versions in constraints may have pre-release identifiers, e.g. >=1.0.0-rc.1, which are ordered
+// before the release.
+func ParseRange(s string) (Range, error) {
+	r := Range{text: strings.TrimSpace(s)}
+	for _, alternative := range strings.Split(s, "||") {
+		fields := strings.Fields(alternative)
+		if len(fields) == 0 {
+			return Range{}, fmt.Errorf("invalid version range '%s'", s)
+		}
+		var set []comparator
+		for _, field := range fields {
+			comparators, err := parseConstraint(field)
+			if err != nil {
+				return Range{}, fmt.Errorf("invalid version range '%s': %v", s, err)
+			}
+			set = append(set, comparators...)
+		}
+		r.sets = append(r.sets, set)
+	}
+	return r, nil
+}
+
+// parseConstraint parses a single constraint into the comparators implementing it
+func parseConstraint(s string) ([]comparator, error) {
+	for _, op := range []operator{opGE, opLE, opGT, opLT, opEQ} {
+		if strings.HasPrefix(s, string(op)) {
+			v, err := Parse(strings.TrimPrefix(s, string(op)))
+			if err != nil {
+				return nil, err
+			}
+			return []comparator{{op: op, version: v}}, nil
+		}
+	}
+
+	if strings.HasPrefix(s, "^") || strings.HasPrefix(s, "~") {
+		v, err := Parse(s[1:])
+		if err != nil {
+			return nil, err
+		}
+		var upper Version
+		switch {
+		case s[0] == '~':
+			upper = Version{Major: v.Major, Minor: v.Minor + 1, Prerelease: lowestPrerelease}
+		case v.Major > 0:
+			upper = Version{Major: v.Major + 1, Prerelease: lowestPrerelease}
+		case v.Minor > 0:
+			upper = Version{Minor: v.Minor + 1, Prerelease: lowestPrerelease}
+		default:
+			upper = Version{Patch: v.Patch + 1, Prerelease: lowestPrerelease}
+		}
+		return []comparator{{op: opGE, version: v}, {op: opLT, version: upper}}, nil
+	}
+	return parseWildcard(s)
+}
+
+// parseWildcard parses a partial version such as 1, 1.x or 1.2.*
+func parseWildcard(s string) ([]comparator, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid version '%s'", s)
+	}
+
+	var numbers []uint64
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			// Nothing may follow a wildcard but more wildcards
+			for _, rest := range parts[i+1:] {
+				if rest != "x" && rest != "X" && rest != "*" {
+					return nil, fmt.Errorf("invalid version '%s'", s)
+				}
+			}
+			break
+		}
+		n, err := parseNumber(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version '%s'", s)
+		}
+		numbers = append(numbers, n)
+	}
+
+	switch len(numbers) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []comparator{
+			{op: opGE, version: Version{Major: numbers[0]}},
+			{op: opLT, version: Version{Major: numbers[0] + 1, Prerelease: lowestPrerelease}},
+		}, nil
+	case 2:
+		return []comparator{
+			{op: opGE, version: Version{Major: numbers[0], Minor: numbers[1]}},
+			{op: opLT, version: Version{Major: numbers[0], Minor: numbers[1] + 1, Prerelease: lowestPrerelease}},
+		}, nil
+	default:
+		return []comparator{{op: opEQ, version: Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}}}, nil
+	}
+}
+
+// Contains returns whether the given version is in the range
+func (r Range) Contains(v Version) bool {
+	for _, set := range r.sets {
+		matches := true
+		for _, c := range set {
+			if !c.matches(v) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Range) String() string {
+	return r.text
+}
@@ -0,0 +1,125 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	v, err := Parse("1.22.3")
+	assert.NoError(t, err)
+	assert.Equal(t, Version{Major: 1, Minor: 22, Patch: 3}, v)
+	assert.Equal(t, "1.22.3", v.String())
+
+	v, err = Parse("1.0.0-rc.1+build.20191001")
+	assert.NoError(t, err)
+	assert.Equal(t, Version{Major: 1, Prerelease: "rc.1", Build: "build.20191001"}, v)
+	assert.Equal(t, "1.0.0-rc.1+build.20191001", v.String())
+
+	for _, s := range []string{"", "1", "1.2", "1.2.3.4", "01.2.3", "1.-2.3", "a.b.c", "1.2.+3",
+		"1.0.0-", "1.0.0-rc..1", "1.0.0-01", "1.0.0+", "1.0.0-rc_1", "4.22.1F"} {
+		_, err := Parse(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("1.0.0-rc2")
+	assert.NoError(t, err)
+	assert.Equal(t, Version{Major: 1, Prerelease: "rc2"}, v)
+
+	v, err = ParseVersion("4.22.1F")
+	assert.NoError(t, err)
+	assert.Equal(t, Version{Major: 4, Minor: 22, Patch: 1, Vendor: "4.22.1F"}, v)
+	assert.Equal(t, "4.22.1F", v.String())
+
+	v, err = ParseVersion("7.0(3)I7(5)")
+	assert.NoError(t, err)
+	assert.Equal(t, Version{Major: 7, Vendor: "7.0(3)I7(5)"}, v)
+
+	v, err = ParseVersion("16.09")
+	assert.NoError(t, err)
+	assert.Equal(t, Version{Major: 16, Minor: 9, Vendor: "16.09"}, v)
+
+	for _, s := range []string{"", "abc", "v1.0.0", "1.2.3.4", "1..2", ".1"} {
+		_, err := ParseVersion(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3}
+	assert.Equal(t, 0, v.Compare(Version{Major: 1, Minor: 2, Patch: 3}))
+	assert.Equal(t, -1, v.Compare(Version{Major: 1, Minor: 10}))
+	assert.Equal(t, 1, v.Compare(Version{Major: 0, Minor: 9, Patch: 9}))
+	assert.Equal(t, -1, v.Compare(Version{Major: 1, Minor: 2, Patch: 4}))
+
+	ordered := []string{"1.0.0-0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.0a", "1.0.0b", "1.0.1F"}
+	for i := 1; i < len(ordered); i++ {
+		assert.Equal(t, -1, mustParse(t, ordered[i-1]).Compare(mustParse(t, ordered[i])), "%s < %s", ordered[i-1], ordered[i])
+		assert.Equal(t, 1, mustParse(t, ordered[i]).Compare(mustParse(t, ordered[i-1])), "%s > %s", ordered[i], ordered[i-1])
+	}
+	assert.Equal(t, 0, mustParse(t, "1.0.0+1").Compare(mustParse(t, "1.0.0+2")))
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		r        string
+		contains []string
+		excludes []string
+	}{
+		{r: "*", contains: []string{"0.0.0", "9.9.9"}},
+		{r: "1.x", contains: []string{"1.0.0", "1.9.9"}, excludes: []string{"0.9.9", "2.0.0"}},
+		{r: "1", contains: []string{"1.5.0"}, excludes: []string{"2.0.0"}},
+		{r: "1.2.*", contains: []string{"1.2.0", "1.2.7"}, excludes: []string{"1.3.0"}},
+		{r: "1.2.3", contains: []string{"1.2.3"}, excludes: []string{"1.2.4"}},
+		{r: ">=1.0.0 <2.0.0", contains: []string{"1.0.0", "1.99.0"}, excludes: []string{"0.1.0", "2.0.0"}},
+		{r: ">1.0.0 <=1.1.0", contains: []string{"1.0.1", "1.1.0"}, excludes: []string{"1.0.0", "1.1.1"}},
+		{r: "^1.2.0", contains: []string{"1.2.0", "1.9.0"}, excludes: []string{"1.1.0", "2.0.0"}},
+		{r: "^0.2.1", contains: []string{"0.2.1", "0.2.9"}, excludes: []string{"0.3.0"}},
+		{r: "~1.2.0", contains: []string{"1.2.5"}, excludes: []string{"1.3.0"}},
+		{r: "1.x || >=3.0.0", contains: []string{"1.1.0", "3.0.0"}, excludes: []string{"2.0.0"}},
+		{r: "1.x", contains: []string{"1.0.0+build", "1.2.0-rc.1", "1.22.1F"}, excludes: []string{"2.0.0-rc.1", "0.9.9F"}},
+		{r: "^1.2.0", excludes: []string{"1.2.0-rc.1", "2.0.0-0"}},
+		{r: ">=1.0.0-rc.1 <2.0.0", contains: []string{"1.0.0-rc.1", "1.0.0-rc.2", "1.0.0"}, excludes: []string{"1.0.0-beta", "2.0.0"}},
+		{r: "<4.22.0", contains: []string{"4.21.9F", "4.22.0-rc.1"}, excludes: []string{"4.22.0", "4.22.1F"}},
+	}
+	for _, test := range tests {
+		r, err := ParseRange(test.r)
+		assert.NoError(t, err, test.r)
+		assert.Equal(t, test.r, r.String())
+		for _, s := range test.contains {
+			assert.True(t, r.Contains(mustParse(t, s)), "%s contains %s", test.r, s)
+		}
+		for _, s := range test.excludes {
+			assert.False(t, r.Contains(mustParse(t, s)), "%s excludes %s", test.r, s)
+		}
+	}
+
+	for _, s := range []string{"", "||", ">=1.0", "1.x.2", "^1", "1.2.3.4", "<>1.0.0"} {
+		_, err := ParseRange(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func mustParse(t *testing.T, s string) Version {
+	v, err := ParseVersion(s)
+	assert.NoError(t, err)
+	return v
+}
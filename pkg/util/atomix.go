@@ -15,7 +15,15 @@
 package util
 
 import (
+	"context"
 	"github.com/atomix/atomix-go-client/pkg/client"
+	"github.com/atomix/atomix-go-local/pkg/atomix/local"
+	"github.com/atomix/atomix-go-node/pkg/atomix"
+	"github.com/atomix/atomix-go-node/pkg/atomix/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
 	"os"
 )
 
@@ -26,7 +34,8 @@ const (
 	atomixRaftGroup     = "ATOMIX_RAFT"
 )
 
-func getAtomixController() string {
+// GetAtomixController returns the address of the Atomix controller
+func GetAtomixController() string {
 	return os.Getenv(atomixControllerEnv)
 }
 
@@ -49,5 +58,35 @@ func GetAtomixClient() (*client.Client, error) {
 		client.WithNamespace(getAtomixNamespace()),
 		client.WithApplication(getAtomixApp()),
 	}
-	return client.NewClient(getAtomixController(), opts...)
+	return client.NewClient(GetAtomixController(), opts...)
+}
+
+// StartLocalNode starts a single in-memory Atomix node and returns a connection to it
+func StartLocalNode() (*atomix.Node, *grpc.ClientConn) {
+	lis := bufconn.Listen(1024 * 1024)
+	node := local.NewNode(lis, registry.Registry)
+	_ = node.Start()
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+
+	conn, err := grpc.DialContext(context.Background(), "local", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		panic("Failed to dial local node")
+	}
+	return node, conn
+}
+
+// NewNodeCloser returns an io.Closer stopping the given node
+func NewNodeCloser(node *atomix.Node) io.Closer {
+	return &nodeCloser{node}
+}
+
+type nodeCloser struct {
+	node *atomix.Node
+}
+
+func (c *nodeCloser) Close() error {
+	return c.node.Stop()
 }
@@ -0,0 +1,40 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package version provides the version and build information of the onos-topo binary.
+// The variables are set at build time with -ldflags, e.g.
+//
+//	go build -ldflags "-X github.com/onosproject/onos-topo/pkg/version.Version=v0.1.0" ./cmd/onos-topo
+package version
+
+import "runtime"
+
+// APIVersion is the version of the topology API served by this build
+const APIVersion = "v1"
+
+var (
+	// Version is the version of the binary
+	Version = "latest"
+
+	// Commit is the git commit from which the binary was built
+	Commit = "unknown"
+
+	// BuildDate is the date at which the binary was built
+	BuildDate = "unknown"
+)
+
+// GoVersion returns the version of Go with which the binary was built
+func GoVersion() string {
+	return runtime.Version()
+}